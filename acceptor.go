@@ -2,6 +2,7 @@ package simplefixgo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -9,9 +10,18 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/b2broker/simplefix-go/fix"
 	"github.com/b2broker/simplefix-go/utils"
 )
 
+// The standard header tags used to find the session of an accepted connection.
+const (
+	msgTypeTag      = "35"
+	senderCompIDTag = "49"
+	targetCompIDTag = "56"
+	logonMsgType    = "A"
+)
+
 // Sender is an interface implemented by any structure that can issue a SendingMessage.
 type Sender interface {
 	Send(message SendingMessage) error
@@ -57,6 +67,8 @@ type Acceptor struct {
 	size            int
 	handleNewClient func(handler AcceptorHandler)
 	writeTimeout    time.Duration
+	metrics         Metrics
+	maxMessageSize  int
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -69,6 +81,8 @@ func NewAcceptor(listener net.Listener, factory HandlerFactory, writeTimeout tim
 		listener:        listener,
 		handleNewClient: handleNewClient,
 		writeTimeout:    writeTimeout,
+		metrics:         NopMetrics{},
+		maxMessageSize:  DefaultMaxMessageSize,
 	}
 
 	s.ctx, s.cancel = context.WithCancel(context.Background())
@@ -76,6 +90,22 @@ func NewAcceptor(listener net.Listener, factory HandlerFactory, writeTimeout tim
 	return s
 }
 
+// SetMetrics sets a sink for the metrics of the accepted connections and their handlers.
+// Since sessions are unknown before logon, nothing is reported until the Logon of a client is accepted;
+// then the metrics are reported under MetricsSessionID of the sent Logon
+// and removed from the sink once the client is disconnected.
+// It could be called only before calling ListenAndServe.
+func (s *Acceptor) SetMetrics(metrics Metrics) {
+	s.metrics = metrics
+}
+
+// SetMaxMessageSize limits the size of incoming messages, the larger ones are discarded.
+// Zero disables the limit. DefaultMaxMessageSize is used by default.
+// It could be called only before calling ListenAndServe.
+func (s *Acceptor) SetMaxMessageSize(size int) {
+	s.maxMessageSize = size
+}
+
 // Close is called to cancel the Acceptor context and close a connection.
 func (s *Acceptor) Close() {
 	s.cancel()
@@ -118,7 +148,14 @@ func (s *Acceptor) serve(parentCtx context.Context, netConn net.Conn) {
 	defer cancel()
 
 	conn := NewConn(parentCtx, netConn, s.size, s.writeTimeout)
+	conn.SetMetrics(s.metrics, "")
+	conn.SetMaxMessageSize(s.maxMessageSize)
 	defer conn.Close()
+	defer func() {
+		if sessionID := conn.metrics.id(); sessionID != "" {
+			s.metrics.Remove(sessionID)
+		}
+	}()
 
 	cancelFun := func() {
 		conn.Close()
@@ -128,6 +165,11 @@ func (s *Acceptor) serve(parentCtx context.Context, netConn net.Conn) {
 	handler := s.factory.MakeHandler(ctx)
 	defer handler.CloseErrorChan()
 
+	// The handler could be given another sink by handleNewClient.
+	if h, ok := handler.(interface{ SetMetrics(Metrics, string) }); ok {
+		h.SetMetrics(s.metrics, "")
+	}
+
 	eg := errgroup.Group{}
 
 	eg.Go(func() error {
@@ -148,10 +190,24 @@ func (s *Acceptor) serve(parentCtx context.Context, netConn net.Conn) {
 		s.handleNewClient(handler)
 	}
 
+	// drain is closed when the handler is stopped after too many garbled messages,
+	// so that the Logout sent in response is written before the connection is closed.
+	drain := make(chan struct{})
+	drained := make(chan struct{})
+
 	eg.Go(func() error {
 		defer cancelFun()
 
-		return handler.Run()
+		err := handler.Run()
+		if errors.Is(err, ErrTooManyGarbledMessages) {
+			close(drain)
+			select {
+			case <-drained:
+			case <-ctx.Done():
+			}
+		}
+
+		return err
 	})
 
 	eg.Go(func() error {
@@ -162,15 +218,25 @@ func (s *Acceptor) serve(parentCtx context.Context, netConn net.Conn) {
 			case <-ctx.Done():
 				return nil
 
+			case <-drain:
+				defer close(drained)
+
+				return conn.writeQueued(handler.Outgoing())
+
 			case msg, ok := <-handler.Outgoing():
 				if !ok {
 					return nil
 				}
 
+				if conn.metrics.id() == "" {
+					s.setSessionID(conn, handler, msg)
+				}
+
 				err := conn.Write(msg)
 				if err != nil {
 					return err
 				}
+				conn.metrics.gauge(MetricOutboundQueueDepth, int64(len(handler.Outgoing())))
 			}
 		}
 	})
@@ -188,9 +254,37 @@ func (s *Acceptor) serve(parentCtx context.Context, netConn net.Conn) {
 					return nil
 				}
 				handler.ServeIncoming(msg)
+
+			case reason := <-conn.Garbled():
+				if h, ok := handler.(garbledServer); ok {
+					h.ServeGarbled(reason)
+				}
 			}
 		}
 	})
 
 	_ = eg.Wait()
 }
+
+// setSessionID assigns the session ID to the metrics of an accepted connection
+// once the Logon of the client is accepted, i.e. a Logon is sent in response.
+func (s *Acceptor) setSessionID(conn *Conn, handler AcceptorHandler, msg []byte) {
+	msgType, err := fix.ValueByTag(msg, msgTypeTag)
+	if err != nil || string(msgType) != logonMsgType {
+		return
+	}
+	senderCompID, err := fix.ValueByTag(msg, senderCompIDTag)
+	if err != nil {
+		return
+	}
+	targetCompID, err := fix.ValueByTag(msg, targetCompIDTag)
+	if err != nil {
+		return
+	}
+
+	sessionID := MetricsSessionID(string(senderCompID), string(targetCompID))
+	conn.metrics.setSessionID(sessionID)
+	if h, ok := handler.(interface{ setMetricsSessionID(string) }); ok {
+		h.setMetricsSessionID(sessionID)
+	}
+}
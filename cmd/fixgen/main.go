@@ -1,10 +1,12 @@
 package main
 
 import (
+	"encoding/xml"
 	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/b2broker/simplefix-go/generator"
 	"github.com/b2broker/simplefix-go/utils"
@@ -12,24 +14,59 @@ import (
 
 func main() {
 	var err error
+	var overlayPaths pathsFlag
 
 	outputDir := flag.String("o", "./fix44/", "output directory")
 	typesMappingPath := flag.String("t", "./source/types.xml", "path to XML file with types mapping")
 	sourceXMLPath := flag.String("s", "./source/fix44.xml", "path to main XML file")
+	selectedMessages := flag.String("m", "", "comma-separated names or types of the messages to generate, all by default")
+	selectedMessagesPath := flag.String("mf", "", "path to a file with the names or types of the messages to generate, one per line")
+	flag.Var(&overlayPaths, "overlay", "path to an XML file merged over the main one, could be repeated")
+	sessionOpts := flag.Bool("session-opts", false, "generate the SessionOpts function using the session package")
+	layout := flag.String("layout", "flat", "layout of the generated code: flat or packages, one package per message")
+	importPath := flag.String("import-path", "", "import path of the output directory for the packages layout, found using go.mod by default")
 
 	flag.Parse()
 
-	doc := &generator.Doc{}
-	if err = utils.ParseXML(*sourceXMLPath, doc); err != nil {
+	doc, err := readDoc(*sourceXMLPath)
+	if err != nil {
 		panic(fmt.Errorf("could not make Doc XML: %s", err))
 	}
 
+	for _, path := range overlayPaths {
+		overlay, err := readDoc(path)
+		if err != nil {
+			panic(fmt.Errorf("could not make Doc XML: %s", err))
+		}
+
+		if doc, err = doc.Merge(overlay); err != nil {
+			panic(fmt.Errorf("could not merge the overlay %s: %s", path, err))
+		}
+	}
+
 	config := &generator.Config{}
 	if err = utils.ParseXML(*typesMappingPath, config); err != nil {
 		panic(fmt.Errorf("could not make Doc XML: %s", err))
 	}
 
 	g := generator.NewGenerator(doc, config, filepath.Base(*outputDir))
+	g.SetSessionOpts(*sessionOpts)
+	g.SetImportPath(*importPath)
+
+	switch *layout {
+	case "flat":
+		g.SetLayout(generator.LayoutFlat)
+	case "packages":
+		g.SetLayout(generator.LayoutPackages)
+	default:
+		panic(fmt.Errorf("unknown layout: %s", *layout))
+	}
+
+	messages, err := selectMessages(*selectedMessages, *selectedMessagesPath)
+	if err != nil {
+		panic(fmt.Errorf("could not read the selected messages: %s", err))
+	}
+	g.SetMessages(messages)
 
 	err = os.MkdirAll(*outputDir, os.ModePerm)
 	if err != nil {
@@ -41,3 +78,67 @@ func main() {
 		panic(err)
 	}
 }
+
+// readDoc reads a QuickFIX-style XML file, or a FIX Orchestra repository file recognized by its root element.
+func readDoc(path string) (*generator.Doc, error) {
+	root := &struct{ XMLName xml.Name }{}
+	if err := utils.ParseXML(path, root); err != nil {
+		return nil, err
+	}
+
+	if root.XMLName.Local != generator.OrchestraRoot {
+		doc := &generator.Doc{}
+		if err := utils.ParseXML(path, doc); err != nil {
+			return nil, err
+		}
+
+		return doc, nil
+	}
+
+	repository := &generator.Orchestra{}
+	if err := utils.ParseXML(path, repository); err != nil {
+		return nil, err
+	}
+
+	return repository.Doc()
+}
+
+// selectMessages joins the messages listed in the flag and in the file.
+// Empty lines and the lines starting with # are skipped in the file.
+func selectMessages(list, path string) ([]string, error) {
+	var messages []string
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			messages = append(messages, name)
+		}
+	}
+
+	if path == "" {
+		return messages, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			messages = append(messages, line)
+		}
+	}
+
+	return messages, nil
+}
+
+// pathsFlag collects the values of a repeated flag.
+type pathsFlag []string
+
+func (f *pathsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *pathsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
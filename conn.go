@@ -1,9 +1,8 @@
 package simplefixgo
 
 import (
-	"bufio"
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"sync"
@@ -15,30 +14,32 @@ import (
 // ErrConnClosed handles connection errors.
 var ErrConnClosed = fmt.Errorf("the reader is closed")
 
-const (
-	endOfMsgTag = "10="
-)
-
 // Conn is a net.Conn wrapper that is used for handling split messages.
 type Conn struct {
-	reader chan []byte
-	writer chan []byte
-	conn   net.Conn
+	reader  chan []byte
+	writer  chan []byte
+	garbled chan error
+	conn    net.Conn
 
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	writeDeadline time.Duration
-	closeOnce     sync.Once
+	writeDeadline  time.Duration
+	maxMessageSize int
+	closeOnce      sync.Once
+
+	metrics sessionMetrics
 }
 
 // NewConn is called to create a new connection.
 func NewConn(ctx context.Context, conn net.Conn, msgBuffSize int, writeDeadline time.Duration) *Conn {
 	c := &Conn{
-		reader:        make(chan []byte, msgBuffSize),
-		writer:        make(chan []byte, msgBuffSize),
-		writeDeadline: writeDeadline,
-		conn:          conn,
+		reader:         make(chan []byte, msgBuffSize),
+		writer:         make(chan []byte, msgBuffSize),
+		garbled:        make(chan error, msgBuffSize),
+		writeDeadline:  writeDeadline,
+		maxMessageSize: DefaultMaxMessageSize,
+		conn:           conn,
 	}
 
 	c.ctx, c.cancel = context.WithCancel(ctx)
@@ -46,6 +47,20 @@ func NewConn(ctx context.Context, conn net.Conn, msgBuffSize int, writeDeadline
 	return c
 }
 
+// SetMetrics sets a sink for the connection metrics reported under the specified session ID.
+// Nothing is reported while the session ID is empty.
+// It could be called only before serving the connection.
+func (c *Conn) SetMetrics(metrics Metrics, sessionID string) {
+	c.metrics.set(metrics, sessionID)
+}
+
+// SetMaxMessageSize limits the size of incoming messages, the larger ones are discarded.
+// Zero disables the limit. DefaultMaxMessageSize is used by default.
+// It could be called only before serving the connection.
+func (c *Conn) SetMaxMessageSize(size int) {
+	c.maxMessageSize = size
+}
+
 // Close is called to cancel a connection context and close a connection.
 func (c *Conn) Close() {
 	c.closeOnce.Do(func() {
@@ -67,9 +82,23 @@ func (c *Conn) serve() error {
 
 func (c *Conn) runReader() error {
 	defer c.cancel()
-	r := bufio.NewReader(c.conn)
 
-	var msg []byte
+	f := newFramer(c.conn, c.maxMessageSize)
+	f.onRead = func(n int) {
+		c.metrics.add(MetricBytesRead, int64(n))
+	}
+	f.onDiscard = func(n int, reason error) {
+		c.metrics.add(MetricBytesDiscarded, int64(n))
+
+		// A message with an invalid BodyLength is discarded once its BeginString prefix is skipped.
+		if errors.Is(reason, ErrInvalidBodyLength) {
+			select {
+			case c.garbled <- reason:
+			case <-c.ctx.Done():
+			}
+		}
+	}
+
 	for {
 		select {
 		case <-c.ctx.Done():
@@ -77,15 +106,15 @@ func (c *Conn) runReader() error {
 		default:
 		}
 
-		buff, err := r.ReadBytes(byte(1))
+		msg, err := f.next()
 		if err != nil {
 			return fmt.Errorf("read error: %w", err)
 		}
 
-		msg = append(msg, buff...)
-		if len(buff) >= 3 && bytes.Equal(buff[0:3], []byte(endOfMsgTag)) {
-			c.reader <- msg
-			msg = []byte{}
+		select {
+		case c.reader <- msg:
+		case <-c.ctx.Done():
+			return nil
 		}
 	}
 }
@@ -95,6 +124,34 @@ func (c *Conn) Reader() <-chan []byte {
 	return c.reader
 }
 
+// garbledServer is implemented by the handlers counting the messages discarded by a connection.
+type garbledServer interface {
+	ServeGarbled(reason error)
+}
+
+// Garbled returns a channel of the reasons of discarding the incoming messages with an invalid BodyLength,
+// which could not be framed and handed over by Reader.
+func (c *Conn) Garbled() <-chan error {
+	return c.garbled
+}
+
+// writeQueued writes the messages remaining in the outgoing queue without waiting for new ones.
+func (c *Conn) writeQueued(out <-chan []byte) error {
+	for {
+		select {
+		case msg, ok := <-out:
+			if !ok {
+				return nil
+			}
+			if err := c.Write(msg); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+}
+
 // Write is called to send messages to an outgoing socket.
 func (c *Conn) Write(msg []byte) error {
 	select {
@@ -107,11 +164,14 @@ func (c *Conn) Write(msg []byte) error {
 		c.cancel()
 		return fmt.Errorf("set write deadline error: %w", err)
 	}
-	_, err := c.conn.Write(msg)
+	start := time.Now()
+	n, err := c.conn.Write(msg)
+	c.metrics.add(MetricBytesWritten, int64(n))
 	if err != nil {
 		c.cancel()
 		return fmt.Errorf("write error: %w", err)
 	}
+	c.metrics.observe(MetricWriteLatency, time.Since(start))
 
 	return nil
 }
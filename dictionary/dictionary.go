@@ -0,0 +1,250 @@
+// Package dictionary provides the FIX data dictionary loaded at runtime from the QuickFIX-style XML
+// which is used by the generator, so messages could be validated and parsed without generated code.
+package dictionary
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/b2broker/simplefix-go/generator"
+	"github.com/b2broker/simplefix-go/utils"
+)
+
+var (
+	ErrInvalidDictionary = errors.New("invalid dictionary")
+	ErrUnknownField      = errors.New("unknown field")
+	ErrUnknownComponent  = errors.New("unknown component")
+	ErrUnknownMessage    = errors.New("unknown message type")
+)
+
+// Dictionary describes the fields, components and messages of a FIX protocol version.
+type Dictionary struct {
+	BeginString string
+	ServicePack int
+
+	Header  *Component
+	Trailer *Component
+
+	fields         []*Field
+	fieldsByTag    map[string]*Field
+	fieldsByName   map[string]*Field
+	components     map[string]*Component
+	messages       []*Message
+	messagesByType map[string]*Message
+	messagesByName map[string]*Message
+}
+
+// Load reads a dictionary from an XML file, e.g. source/fix44.xml.
+func Load(path string) (*Dictionary, error) {
+	doc := &generator.Doc{}
+	if err := utils.ParseXML(path, doc); err != nil {
+		return nil, err
+	}
+
+	return New(doc)
+}
+
+// Parse reads a dictionary from XML data.
+func Parse(r io.Reader) (*Dictionary, error) {
+	doc := &generator.Doc{}
+	if err := xml.NewDecoder(r).Decode(doc); err != nil {
+		return nil, fmt.Errorf("could not unmarshal the XML: %w", err)
+	}
+
+	return New(doc)
+}
+
+// New creates a dictionary from a document read by the generator.
+func New(doc *generator.Doc) (*Dictionary, error) {
+	d := &Dictionary{
+		BeginString:    fmt.Sprintf("%s.%s.%s", doc.Type, doc.Major, doc.Minor),
+		ServicePack:    doc.ServicePack,
+		fieldsByTag:    make(map[string]*Field),
+		fieldsByName:   make(map[string]*Field),
+		components:     make(map[string]*Component),
+		messagesByType: make(map[string]*Message),
+		messagesByName: make(map[string]*Message),
+	}
+
+	for _, f := range doc.Fields {
+		field := newField(f)
+		if _, ok := d.fieldsByTag[field.Tag]; ok {
+			return nil, fmt.Errorf("%w: the field %s is defined more than once", ErrInvalidDictionary, field.Tag)
+		}
+		if _, ok := d.fieldsByName[field.Name]; ok {
+			return nil, fmt.Errorf("%w: the field %s is defined more than once", ErrInvalidDictionary, field.Name)
+		}
+
+		d.fields = append(d.fields, field)
+		d.fieldsByTag[field.Tag] = field
+		d.fieldsByName[field.Name] = field
+	}
+
+	// The components are created before resolving their members since they might refer to each other.
+	for _, c := range doc.Components {
+		if _, ok := d.components[c.Name]; ok {
+			return nil, fmt.Errorf("%w: the component %s is defined more than once", ErrInvalidDictionary, c.Name)
+		}
+		d.components[c.Name] = &Component{Name: c.Name}
+	}
+	for _, c := range doc.Components {
+		members, err := d.members(c.Members)
+		if err != nil {
+			return nil, fmt.Errorf("could not make the component %s: %w", c.Name, err)
+		}
+		d.components[c.Name].Members = members
+	}
+	for _, component := range d.components {
+		if err := component.index(nil); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if d.Header, err = d.section("header", doc.Header); err != nil {
+		return nil, err
+	}
+	if d.Trailer, err = d.section("trailer", doc.Trailer); err != nil {
+		return nil, err
+	}
+
+	for _, m := range doc.Messages {
+		members, err := d.members(m.Members)
+		if err != nil {
+			return nil, fmt.Errorf("could not make the message %s: %w", m.Name, err)
+		}
+
+		msg := &Message{
+			Name:     m.Name,
+			MsgType:  m.MsgType,
+			Category: m.MsgCat,
+			Members:  members,
+		}
+		if err = msg.layout.index(members, nil); err != nil {
+			return nil, fmt.Errorf("could not make the message %s: %w", m.Name, err)
+		}
+		if _, ok := d.messagesByType[msg.MsgType]; ok {
+			return nil, fmt.Errorf("%w: the message type %s is defined more than once", ErrInvalidDictionary, msg.MsgType)
+		}
+
+		d.messages = append(d.messages, msg)
+		d.messagesByType[msg.MsgType] = msg
+		d.messagesByName[msg.Name] = msg
+	}
+
+	return d, nil
+}
+
+func (d *Dictionary) section(name string, c *generator.Component) (*Component, error) {
+	component := &Component{Name: name}
+	if c == nil {
+		return nil, fmt.Errorf("%w: the %s is missing", ErrInvalidDictionary, name)
+	}
+
+	members, err := d.members(c.Members)
+	if err != nil {
+		return nil, fmt.Errorf("could not make the %s: %w", name, err)
+	}
+	component.Members = members
+
+	if err = component.index(nil); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
+
+func (d *Dictionary) members(items []*generator.ComponentMember) ([]*Member, error) {
+	members := make([]*Member, 0, len(items))
+
+	for _, item := range items {
+		member := &Member{
+			Name:     item.Name,
+			Required: item.Required == "Y",
+		}
+
+		switch item.XMLName.Local {
+		case generator.FieldItem:
+			member.Kind = FieldMember
+			field, ok := d.fieldsByName[item.Name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownField, item.Name)
+			}
+			member.Field = field
+
+		case generator.GroupItem:
+			member.Kind = GroupMember
+			field, ok := d.fieldsByName[item.Name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownField, item.Name)
+			}
+			member.Field = field
+
+			groupMembers, err := d.members(item.Members)
+			if err != nil {
+				return nil, fmt.Errorf("could not make the group %s: %w", item.Name, err)
+			}
+			if len(groupMembers) == 0 {
+				return nil, fmt.Errorf("%w: the group %s is empty", ErrInvalidDictionary, item.Name)
+			}
+			member.Members = groupMembers
+
+		case generator.ComponentItem:
+			member.Kind = ComponentMember
+			component, ok := d.components[item.Name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownComponent, item.Name)
+			}
+			member.Component = component
+
+		default:
+			return nil, fmt.Errorf("%w: unexpected member type %s of %s", ErrInvalidDictionary, item.XMLName.Local, item.Name)
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// FieldByTag returns a field by its tag.
+func (d *Dictionary) FieldByTag(tag string) (*Field, bool) {
+	field, ok := d.fieldsByTag[tag]
+	return field, ok
+}
+
+// FieldByName returns a field by its name, e.g. ClOrdID.
+func (d *Dictionary) FieldByName(name string) (*Field, bool) {
+	field, ok := d.fieldsByName[name]
+	return field, ok
+}
+
+// Fields returns all fields in the order of their definition.
+func (d *Dictionary) Fields() []*Field {
+	return d.fields
+}
+
+// Component returns a component by its name.
+func (d *Dictionary) Component(name string) (*Component, bool) {
+	component, ok := d.components[name]
+	return component, ok
+}
+
+// Message returns a message by its MsgType value.
+func (d *Dictionary) Message(msgType string) (*Message, bool) {
+	msg, ok := d.messagesByType[msgType]
+	return msg, ok
+}
+
+// MessageByName returns a message by its name, e.g. NewOrderSingle.
+func (d *Dictionary) MessageByName(name string) (*Message, bool) {
+	msg, ok := d.messagesByName[name]
+	return msg, ok
+}
+
+// Messages returns all messages in the order of their definition.
+func (d *Dictionary) Messages() []*Message {
+	return d.messages
+}
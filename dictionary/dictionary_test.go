@@ -0,0 +1,204 @@
+package dictionary
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+func TestLoad(t *testing.T) {
+	d, err := Load("../source/fix44.xml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if d.BeginString != "FIX.4.4" {
+		t.Fatalf("unexpected BeginString: %s", d.BeginString)
+	}
+
+	field, ok := d.FieldByTag("35")
+	if !ok || field.Name != "MsgType" {
+		t.Fatalf("the MsgType field is not found")
+	}
+	if enum, ok := field.Enum("A"); !ok || enum.Description == "" {
+		t.Fatalf("the MsgType enum is not found")
+	}
+	if _, ok = field.Enum("unknown"); ok {
+		t.Fatalf("an unexpected enum is found")
+	}
+
+	if !d.Header.HasField("49") || d.Header.HasField("98") || !d.Trailer.HasField("10") {
+		t.Fatalf("unexpected header or trailer fields")
+	}
+
+	logon, ok := d.Message("A")
+	if !ok || logon.Name != "Logon" || logon.Category != "admin" {
+		t.Fatalf("the Logon message is not found")
+	}
+	if msg, ok := d.MessageByName("Logon"); !ok || msg != logon {
+		t.Fatalf("the Logon message is not found by its name")
+	}
+	if !logon.Members[0].Required || logon.Members[0].Field.Name != "EncryptMethod" {
+		t.Fatalf("unexpected first member of Logon: %s", logon.Members[0].Name)
+	}
+	if !logon.HasField("98") || !logon.HasField("372") || logon.HasField("35") {
+		t.Fatalf("unexpected fields of Logon")
+	}
+
+	group, ok := logon.Group("384")
+	if !ok || group.Kind != GroupMember || group.Delimiter() != "372" || !group.HasField("385") {
+		t.Fatalf("unexpected NoMsgTypes group")
+	}
+
+	request, ok := d.Message("V")
+	if !ok {
+		t.Fatalf("the MarketDataRequest message is not found")
+	}
+	// The Symbol field belongs to the Instrument component of the NoRelatedSym group.
+	relatedSym, ok := request.Group("146")
+	if !ok || relatedSym.Delimiter() != "55" || !relatedSym.HasField("55") || !request.HasField("55") {
+		t.Fatalf("unexpected NoRelatedSym group")
+	}
+	if _, ok = request.Group("711"); !ok {
+		t.Fatalf("the nested NoUnderlyings group is not found")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	const sections = `<header><field name='BeginString' required='Y'/></header><trailer><field name='CheckSum' required='Y'/></trailer>`
+	const fields = `<fields>
+		<field number='8' name='BeginString' type='STRING'/>
+		<field number='10' name='CheckSum' type='STRING'/>
+		<field number='11' name='ClOrdID' type='STRING'/>
+	</fields>`
+
+	testCases := map[string]struct {
+		xml string
+		err error
+	}{
+		"unknown field": {
+			xml: `<fix type='FIX' major='4' minor='4'>` + sections + `
+				<messages><message name='Order' msgtype='D' msgcat='app'><field name='Symbol' required='Y'/></message></messages>
+				<components/>` + fields + `</fix>`,
+			err: ErrUnknownField,
+		},
+		"unknown component": {
+			xml: `<fix type='FIX' major='4' minor='4'>` + sections + `
+				<messages><message name='Order' msgtype='D' msgcat='app'><component name='Instrument' required='Y'/></message></messages>
+				<components/>` + fields + `</fix>`,
+			err: ErrUnknownComponent,
+		},
+		"recursive component": {
+			xml: `<fix type='FIX' major='4' minor='4'>` + sections + `
+				<messages/>
+				<components>
+					<component name='A'><field name='ClOrdID' required='N'/><component name='B' required='N'/></component>
+					<component name='B'><component name='A' required='N'/></component>
+				</components>` + fields + `</fix>`,
+			err: ErrInvalidDictionary,
+		},
+	}
+
+	for name, testCase := range testCases {
+		_, err := Parse(strings.NewReader(testCase.xml))
+		if !errors.Is(err, testCase.err) {
+			t.Fatalf("unexpected error in case '%s': %v", name, err)
+		}
+	}
+}
+
+func TestParseMessage(t *testing.T) {
+	d, err := Load("../source/fix44.xml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	msg, err := d.ParseMessage(makeMessage("35=V|49=sender|56=target|34=1|52=20210706-19:06:12.838|" +
+		"262=req|263=1|264=0|267=1|269=0|146=2|55=EURUSD|711=1|311=EUR|55=GBPUSD|"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !msg.Header.Has(49) || !msg.Body.Has(262) || !msg.Trailer.Has(10) {
+		t.Fatalf("unexpected sections: %s", msg)
+	}
+
+	symbols, err := msg.Body.GetGroup(146)
+	if err != nil || len(symbols) != 2 {
+		t.Fatalf("unexpected NoRelatedSym entries: %v, %v", symbols, err)
+	}
+	underlyings, err := symbols[0].GetGroup(711)
+	if err != nil || len(underlyings) != 1 {
+		t.Fatalf("unexpected NoUnderlyings entries: %v, %v", underlyings, err)
+	}
+	if v, _ := symbols[1].GetString(55); v != "GBPUSD" {
+		t.Fatalf("unexpected Symbol: %s", v)
+	}
+
+	if _, err = d.ParseMessage(makeMessage("35=ZZ|")); !errors.Is(err, ErrUnknownMessage) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMessageJSON(t *testing.T) {
+	d, err := Load("../source/fix44.xml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	msg, err := d.ParseMessage(makeMessage("35=V|49=sender|56=target|34=1|52=20210706-19:06:12.838|" +
+		"262=req|263=1|264=0|267=1|269=0|146=2|55=EURUSD|711=1|311=EUR|55=GBPUSD|5001=custom|"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data, err := d.MessageToJSON(msg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"Header":{"BeginString":"FIX.4.4","MsgType":"V","SenderCompID":"sender","TargetCompID":"target",` +
+		`"MsgSeqNum":"1","SendingTime":"20210706-19:06:12.838"},"Body":{"MDReqID":"req","SubscriptionRequestType":"1",` +
+		`"MarketDepth":"0","NoMDEntryTypes":[{"MDEntryType":"0"}],"NoRelatedSym":[{"Symbol":"EURUSD",` +
+		`"NoUnderlyings":[{"UnderlyingSymbol":"EUR"}]},{"Symbol":"GBPUSD"}],"5001":"custom"},"Trailer":{}}`
+	if string(data) != expected {
+		t.Fatalf("unexpected JSON: %s", data)
+	}
+
+	decoded, err := d.MessageFromJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	original, _ := msg.ToBytes()
+	restored, _ := decoded.ToBytes()
+	if string(original) != string(restored) {
+		t.Fatalf("unexpected message: %s", decoded)
+	}
+
+	msg, err = d.ParseMessage(makeMessage("35=A|49=sender|56=target|34=1|52=20210706-19:06:12.838|98=0|108=30|95=3|96=a|b|"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if data, err = d.MessageToJSON(msg); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.Contains(string(data), `"RawDataLength":"3","RawData":"YQFi"`) {
+		t.Fatalf("the data field is not encoded in base64: %s", data)
+	}
+	if decoded, err = d.MessageFromJSON(data); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if value, _ := decoded.Body.GetString(96); value != "a\x01b" {
+		t.Fatalf("unexpected data: %q", value)
+	}
+
+	if _, err = d.MessageFromJSON([]byte(`{"Body":{"Unknown":"1"}}`)); !errors.Is(err, ErrUnknownField) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err = d.MessageFromJSON([]byte(`{"Body":{"Symbol":true}}`)); !errors.Is(err, fix.ErrInvalidJSON) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
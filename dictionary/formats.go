@@ -0,0 +1,175 @@
+package dictionary
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var errInvalidFormat = errors.New("invalid format")
+
+// checkFormat checks whether a value matches a FIX data type.
+// The values of unknown types, e.g. STRING or DATA, are not checked.
+func checkFormat(fieldType string, value []byte) error {
+	var ok bool
+
+	switch fieldType {
+	case "INT", "LENGTH", "SEQNUM", "NUMINGROUP", "TAGNUM", "DAYOFMONTH":
+		ok = isInt(value, fieldType == "INT")
+	case "FLOAT", "QTY", "PRICE", "PRICEOFFSET", "AMT", "PERCENTAGE":
+		ok = isFloat(value)
+	case "CHAR":
+		ok = len(value) == 1
+	case "BOOLEAN":
+		ok = len(value) == 1 && (value[0] == 'Y' || value[0] == 'N')
+	case "UTCTIMESTAMP", "TZTIMESTAMP":
+		ok = len(value) >= 17 && isDate(value[:8]) && value[8] == '-' && isTime(value[9:], fieldType == "TZTIMESTAMP")
+	case "UTCDATEONLY", "UTCDATE", "LOCALMKTDATE", "DATE":
+		ok = isDate(value)
+	case "UTCTIMEONLY", "TIME", "TZTIMEONLY":
+		ok = isTime(value, fieldType == "TZTIMEONLY")
+	case "MONTHYEAR":
+		ok = isMonthYear(value)
+	default:
+		return nil
+	}
+
+	if !ok {
+		return fmt.Errorf("%w of %s", errInvalidFormat, fieldType)
+	}
+
+	return nil
+}
+
+// isMultipleValue returns true if the values of the type are space-separated lists.
+func isMultipleValue(fieldType string) bool {
+	switch fieldType {
+	case "MULTIPLEVALUESTRING", "MULTIPLESTRINGVALUE", "MULTIPLECHARVALUE":
+		return true
+	}
+
+	return false
+}
+
+func isDigits(value []byte) bool {
+	if len(value) == 0 {
+		return false
+	}
+
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isInt(value []byte, signed bool) bool {
+	if signed && len(value) > 0 && value[0] == '-' {
+		value = value[1:]
+	}
+
+	return isDigits(value)
+}
+
+func isFloat(value []byte) bool {
+	if len(value) > 0 && value[0] == '-' {
+		value = value[1:]
+	}
+
+	intPart, fracPart, found := strings.Cut(string(value), ".")
+	if !found {
+		return isDigits(value)
+	}
+
+	return (intPart != "" || fracPart != "") &&
+		(intPart == "" || isDigits([]byte(intPart))) &&
+		(fracPart == "" || isDigits([]byte(fracPart)))
+}
+
+// isDate checks the YYYYMMDD format.
+func isDate(value []byte) bool {
+	if len(value) != 8 || !isDigits(value) {
+		return false
+	}
+
+	month := atoi(value[4:6])
+	day := atoi(value[6:8])
+
+	return month >= 1 && month <= 12 && day >= 1 && day <= 31
+}
+
+// isTime checks the HH:MM:SS format with optional fractional seconds and, if tz is true, a time zone offset.
+func isTime(value []byte, tz bool) bool {
+	if tz {
+		value = trimTimeZone(value)
+	}
+
+	if len(value) < 8 || value[2] != ':' || value[5] != ':' ||
+		!isDigits(value[:2]) || !isDigits(value[3:5]) || !isDigits(value[6:8]) {
+		return false
+	}
+	// Leap seconds are allowed.
+	if atoi(value[:2]) > 23 || atoi(value[3:5]) > 59 || atoi(value[6:8]) > 60 {
+		return false
+	}
+
+	fraction := value[8:]
+	if len(fraction) == 0 {
+		return true
+	}
+
+	return fraction[0] == '.' && isDigits(fraction[1:]) && len(fraction) <= 10
+}
+
+// trimTimeZone removes the Z, ±hh or ±hh:mm suffix.
+func trimTimeZone(value []byte) []byte {
+	if len(value) > 0 && value[len(value)-1] == 'Z' {
+		return value[:len(value)-1]
+	}
+
+	for _, size := range []int{6, 3} {
+		if len(value) > size {
+			sign := value[len(value)-size]
+			if sign == '+' || sign == '-' {
+				return value[:len(value)-size]
+			}
+		}
+	}
+
+	return value
+}
+
+// isMonthYear checks the YYYYMM, YYYYMMDD and YYYYMMwN formats.
+func isMonthYear(value []byte) bool {
+	if len(value) < 6 || !isDigits(value[:6]) {
+		return false
+	}
+
+	month := atoi(value[4:6])
+	if month < 1 || month > 12 {
+		return false
+	}
+
+	switch suffix := value[6:]; len(suffix) {
+	case 0:
+		return true
+	case 2:
+		if suffix[0] == 'w' {
+			return suffix[1] >= '1' && suffix[1] <= '5'
+		}
+		return isDate(value)
+	}
+
+	return false
+}
+
+func atoi(digits []byte) int {
+	var n int
+	for _, c := range digits {
+		n = n*10 + int(c-'0')
+	}
+
+	return n
+}
@@ -0,0 +1,226 @@
+package dictionary
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// typeData is the type of the data fields, whose values are encoded in base64.
+const typeData = "DATA"
+
+// FieldNames returns the names of the fields by their tags, which are used by fix.Message.ToJSON and FromJSON.
+func (d *Dictionary) FieldNames() fix.FieldNames {
+	names := make(fix.FieldNames, len(d.fields))
+	for _, field := range d.fields {
+		names[field.Tag] = field.Name
+	}
+
+	return names
+}
+
+// MessageToJSON returns the message in the FIX JSON encoding of the FIX Trading Community:
+// the Header, Body and Trailer objects contain the fields by their names, the values are strings,
+// and the entries of the groups are the arrays of objects named after their NumInGroup fields.
+// The fields unknown to the dictionary are named by their tags. The BodyLength and CheckSum fields are omitted.
+func (d *Dictionary) MessageToJSON(msg *fix.RawMessage) ([]byte, error) {
+	buf := &bytes.Buffer{}
+
+	_, _ = buf.WriteString(`{"Header":`)
+	d.writeJSONFields(buf, msg.Header)
+	_, _ = buf.WriteString(`,"Body":`)
+	d.writeJSONFields(buf, msg.Body)
+	_, _ = buf.WriteString(`,"Trailer":`)
+	d.writeJSONFields(buf, msg.Trailer)
+	_ = buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// MessageFromJSON reads a message in the FIX JSON encoding, see MessageToJSON.
+// The fields are kept in the order of the JSON objects and the keys are either the names
+// of the fields defined in the dictionary or the tags.
+func (d *Dictionary) MessageFromJSON(data []byte) (*fix.RawMessage, error) {
+	var m struct {
+		Header  json.RawMessage `json:"Header"`
+		Body    json.RawMessage `json:"Body"`
+		Trailer json.RawMessage `json:"Trailer"`
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("%w: %s", fix.ErrInvalidJSON, err)
+	}
+
+	msg := &fix.RawMessage{}
+	for _, section := range []struct {
+		data   json.RawMessage
+		fields **fix.FieldMap
+	}{{m.Header, &msg.Header}, {m.Body, &msg.Body}, {m.Trailer, &msg.Trailer}} {
+		*section.fields = fix.NewFieldMap()
+		if len(section.data) == 0 || string(section.data) == "null" {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(section.data))
+		dec.UseNumber()
+		if err := d.readJSONFields(dec, *section.fields); err != nil {
+			return nil, err
+		}
+	}
+
+	return msg, nil
+}
+
+func (d *Dictionary) writeJSONFields(buf *bytes.Buffer, m *fix.FieldMap) {
+	var fields []*fix.RawField
+	if m != nil {
+		fields = m.Fields()
+	}
+
+	_ = buf.WriteByte('{')
+
+	var next bool
+	for _, field := range fields {
+		switch field.Tag {
+		case fix.TagBodyLength, fix.TagCheckSum:
+			continue
+		}
+
+		if next {
+			_ = buf.WriteByte(',')
+		}
+		next = true
+
+		tag := strconv.Itoa(field.Tag)
+		name, data := tag, false
+		if f, ok := d.FieldByTag(tag); ok {
+			name, data = f.Name, f.Type == typeData
+		}
+
+		key, _ := json.Marshal(name)
+		_, _ = buf.Write(key)
+		_ = buf.WriteByte(':')
+
+		if field.Group == nil {
+			v := string(field.Value)
+			if data {
+				v = base64.StdEncoding.EncodeToString(field.Value)
+			}
+
+			value, _ := json.Marshal(v)
+			_, _ = buf.Write(value)
+			continue
+		}
+
+		_ = buf.WriteByte('[')
+		for i, entry := range field.Group {
+			if i > 0 {
+				_ = buf.WriteByte(',')
+			}
+			d.writeJSONFields(buf, entry)
+		}
+		_ = buf.WriteByte(']')
+	}
+
+	_ = buf.WriteByte('}')
+}
+
+// readJSONFields reads an object into the fields, the arrays of objects are read as the group entries.
+func (d *Dictionary) readJSONFields(dec *json.Decoder, m *fix.FieldMap) error {
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("%w: %s", fix.ErrInvalidJSON, err)
+		}
+
+		key, _ := token.(string)
+		tag, data, err := d.jsonTag(key)
+		if err != nil {
+			return err
+		}
+
+		if token, err = dec.Token(); err != nil {
+			return fmt.Errorf("%w: %s", fix.ErrInvalidJSON, err)
+		}
+
+		switch value := token.(type) {
+		case string:
+			if !data {
+				m.SetString(tag, value)
+				continue
+			}
+
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return fmt.Errorf("%w: %s: %s", fix.ErrInvalidJSON, key, err)
+			}
+			m.SetBytes(tag, decoded)
+
+		case json.Number:
+			m.SetString(tag, value.String())
+
+		case json.Delim:
+			if value != '[' {
+				return fmt.Errorf("%w: %s: a string or an array of objects is expected", fix.ErrInvalidJSON, key)
+			}
+
+			var entries []*fix.FieldMap
+			for dec.More() {
+				entry := fix.NewFieldMap()
+				if err = d.readJSONFields(dec, entry); err != nil {
+					return err
+				}
+				entries = append(entries, entry)
+			}
+			if err = expectJSONDelim(dec, ']'); err != nil {
+				return err
+			}
+
+			m.SetGroup(tag, entries...)
+
+		default:
+			return fmt.Errorf("%w: %s: a string or an array of objects is expected", fix.ErrInvalidJSON, key)
+		}
+	}
+
+	return expectJSONDelim(dec, '}')
+}
+
+// jsonTag returns the tag of a field by its name or by the tag itself,
+// and whether it is a data field, whose value is encoded in base64.
+func (d *Dictionary) jsonTag(key string) (int, bool, error) {
+	field, ok := d.FieldByName(key)
+	if !ok {
+		field, ok = d.FieldByTag(key)
+	}
+	if ok {
+		if tag := atoiTag(field.Tag); tag > 0 {
+			return tag, field.Type == typeData, nil
+		}
+	}
+
+	if tag, err := strconv.Atoi(key); err == nil && tag > 0 {
+		return tag, false, nil
+	}
+
+	return 0, false, fmt.Errorf("%w: %s", ErrUnknownField, key)
+}
+
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %s", fix.ErrInvalidJSON, err)
+	}
+	if token != delim {
+		return fmt.Errorf("%w: %q is expected", fix.ErrInvalidJSON, delim)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package dictionary
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// ParseMessage parses a message of any type defined in the dictionary, including its repeating groups.
+func (d *Dictionary) ParseMessage(data []byte) (*fix.RawMessage, error) {
+	var msgType string
+	fix.EachField(data, func(tag string, value []byte) bool {
+		if tag == tagMsgType {
+			msgType = string(value)
+			return false
+		}
+		return true
+	})
+
+	layout, err := d.MessageLayout(msgType)
+	if err != nil {
+		return nil, err
+	}
+
+	return fix.ParseRawMessage(data, layout)
+}
+
+// MessageLayout returns the layout of a message type required for parsing it as a fix.RawMessage.
+func (d *Dictionary) MessageLayout(msgType string) (*fix.MessageLayout, error) {
+	msg, ok := d.Message(msgType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMessage, msgType)
+	}
+
+	msgLayout := &fix.MessageLayout{
+		HeaderTags:  make(map[int]bool),
+		TrailerTags: make(map[int]bool),
+		Groups:      make(map[int]*fix.GroupTemplate),
+	}
+
+	for tag := range d.Header.fields {
+		if !msg.HasField(tag) {
+			msgLayout.HeaderTags[atoiTag(tag)] = true
+		}
+	}
+	for tag := range d.Trailer.fields {
+		if !msg.HasField(tag) {
+			msgLayout.TrailerTags[atoiTag(tag)] = true
+		}
+	}
+
+	for _, l := range []*layout{&d.Header.layout, &msg.layout, &d.Trailer.layout} {
+		for tag, member := range l.own {
+			if member.Kind == GroupMember {
+				msgLayout.Groups[atoiTag(tag)] = groupTemplate(member.Member)
+			}
+		}
+	}
+
+	return msgLayout, nil
+}
+
+func groupTemplate(group *Member) *fix.GroupTemplate {
+	template := &fix.GroupTemplate{
+		Tags:   make([]int, len(group.own)),
+		Groups: make(map[int]*fix.GroupTemplate),
+	}
+
+	for tag, member := range group.own {
+		template.Tags[member.position] = atoiTag(tag)
+		if member.Kind == GroupMember {
+			template.Groups[atoiTag(tag)] = groupTemplate(member.Member)
+		}
+	}
+
+	return template
+}
+
+// atoiTag converts a tag of the dictionary, which is zero if the tag is not numeric.
+func atoiTag(tag string) int {
+	tagID, _ := strconv.Atoi(tag)
+	return tagID
+}
@@ -0,0 +1,218 @@
+package dictionary
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/b2broker/simplefix-go/generator"
+)
+
+// Field describes a FIX field.
+type Field struct {
+	Tag  string
+	Name string
+	// Type is the FIX data type, e.g. STRING, PRICE or NUMINGROUP.
+	Type string
+	// Enums are the allowed values of the field, if they are restricted.
+	Enums []*Enum
+
+	enums map[string]*Enum
+}
+
+// Enum is an allowed value of a field.
+type Enum struct {
+	Value       string
+	Description string
+}
+
+func newField(f *generator.Field) *Field {
+	field := &Field{
+		Tag:   f.Number,
+		Name:  f.Name,
+		Type:  strings.ToUpper(f.Type),
+		enums: make(map[string]*Enum, len(f.Values)),
+	}
+
+	for _, v := range f.Values {
+		enum := &Enum{Value: v.Enum, Description: v.Description}
+		field.Enums = append(field.Enums, enum)
+		field.enums[enum.Value] = enum
+	}
+
+	return field
+}
+
+// HasEnums returns true if the values of the field are restricted.
+func (f *Field) HasEnums() bool {
+	return len(f.Enums) > 0
+}
+
+// Enum returns an allowed value of the field.
+func (f *Field) Enum(value string) (*Enum, bool) {
+	enum, ok := f.enums[value]
+	return enum, ok
+}
+
+// MemberKind is a type of message member.
+type MemberKind int
+
+const (
+	FieldMember MemberKind = iota
+	GroupMember
+	ComponentMember
+)
+
+// Member is a field, a repeating group or a component of a message or a component.
+type Member struct {
+	Kind     MemberKind
+	Name     string
+	Required bool
+
+	// Field is the field of a field member or the NumInGroup field of a group.
+	Field *Field
+	// Component is the referenced component of a component member.
+	Component *Component
+	// Members are the members of each group entry.
+	Members []*Member
+
+	layout
+}
+
+// Tag returns the tag of a field or the NumInGroup tag of a group.
+// It returns an empty string for components.
+func (m *Member) Tag() string {
+	if m.Field == nil {
+		return ""
+	}
+
+	return m.Field.Tag
+}
+
+// Items returns the members of a group entry or a component.
+func (m *Member) Items() []*Member {
+	if m.Kind == ComponentMember {
+		return m.Component.Members
+	}
+
+	return m.Members
+}
+
+// Delimiter returns the tag of the first field of group entries.
+// It returns an empty string if the member is not a group.
+func (m *Member) Delimiter() string {
+	if m.Kind != GroupMember {
+		return ""
+	}
+
+	members := m.Members
+	for len(members) > 0 {
+		first := members[0]
+		if first.Kind != ComponentMember {
+			return first.Tag()
+		}
+		members = first.Component.Members
+	}
+
+	return ""
+}
+
+// Component is a named set of members shared by messages.
+// The header and the trailer are described as components as well.
+type Component struct {
+	Name    string
+	Members []*Member
+
+	layout
+}
+
+func (c *Component) index(path []string) error {
+	return c.layout.index(c.Members, append(path, c.Name))
+}
+
+// Message describes the body of a message.
+type Message struct {
+	Name     string
+	MsgType  string
+	Category string
+	Members  []*Member
+
+	layout
+}
+
+// layout indexes the fields and groups of a set of members including the nested ones.
+type layout struct {
+	fields map[string]*Field
+	groups map[string]*Member
+
+	// own contains the fields and groups which are not nested in groups, along with their positions.
+	own map[string]ownMember
+}
+
+type ownMember struct {
+	*Member
+	position int
+}
+
+func (l *layout) index(members []*Member, path []string) error {
+	l.fields = make(map[string]*Field)
+	l.groups = make(map[string]*Member)
+	l.own = make(map[string]ownMember)
+
+	return l.add(members, path, true)
+}
+
+func (l *layout) add(members []*Member, path []string, own bool) error {
+	for _, member := range members {
+		if own && member.Kind != ComponentMember {
+			if _, ok := l.own[member.Field.Tag]; !ok {
+				l.own[member.Field.Tag] = ownMember{Member: member, position: len(l.own)}
+			}
+		}
+
+		switch member.Kind {
+		case FieldMember:
+			l.fields[member.Field.Tag] = member.Field
+
+		case GroupMember:
+			l.fields[member.Field.Tag] = member.Field
+			if _, ok := l.groups[member.Field.Tag]; !ok {
+				l.groups[member.Field.Tag] = member
+			}
+
+			if member.fields == nil {
+				if err := member.layout.index(member.Members, path); err != nil {
+					return err
+				}
+			}
+			if err := l.add(member.Members, path, false); err != nil {
+				return err
+			}
+
+		case ComponentMember:
+			for _, name := range path {
+				if name == member.Component.Name {
+					return fmt.Errorf("%w: the component %s contains itself", ErrInvalidDictionary, name)
+				}
+			}
+
+			if err := l.add(member.Component.Members, append(path, member.Component.Name), own); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// HasField returns true if the field is defined within the members, including components and groups.
+func (l *layout) HasField(tag string) bool {
+	_, ok := l.fields[tag]
+	return ok
+}
+
+// Group returns a repeating group defined within the members by its NumInGroup tag.
+// If several groups share the tag, the first one is returned.
+func (l *layout) Group(noTag string) (*Member, bool) {
+	group, ok := l.groups[noTag]
+	return group, ok
+}
@@ -0,0 +1,427 @@
+package dictionary
+
+import (
+	"strconv"
+
+	"github.com/b2broker/simplefix-go/fix"
+	"github.com/b2broker/simplefix-go/fix/encoding"
+	"github.com/b2broker/simplefix-go/session/messages"
+)
+
+const (
+	tagBeginString = "8"
+	tagBodyLength  = "9"
+	tagMsgType     = "35"
+	tagCheckSum    = "10"
+)
+
+// TagRange is an inclusive range of tags.
+type TagRange struct {
+	From, To int
+}
+
+// Contains returns true if the tag belongs to the range.
+func (r TagRange) Contains(tag int) bool {
+	return tag >= r.From && tag <= r.To
+}
+
+// DefaultUserDefinedTags are the tag ranges reserved for user-defined fields.
+var DefaultUserDefinedTags = []TagRange{{From: 5000, To: 9999}, {From: 20000, To: 39999}}
+
+// ValidatorSettings enables the checks of a Validator.
+type ValidatorSettings struct {
+	// CheckRequiredFields checks the presence of the required fields of the messages,
+	// their components and group entries.
+	CheckRequiredFields bool
+
+	// CheckEnums checks that the values of the fields with enums are allowed.
+	CheckEnums bool
+
+	// CheckDataFormat checks that the values match the data types of the fields and are not empty.
+	CheckDataFormat bool
+
+	// CheckFieldsOrder checks that the header is followed by the body and the trailer,
+	// the message starts with the BeginString, BodyLength and MsgType fields and ends with the CheckSum field,
+	// and the fields of group entries follow the order of the dictionary.
+	CheckFieldsOrder bool
+
+	// CheckDuplicateFields checks that the fields appear only once in the message or in a group entry.
+	CheckDuplicateFields bool
+
+	// CheckUnknownFields checks that the fields are defined in the dictionary and for the message type.
+	CheckUnknownFields bool
+
+	// CheckUserDefinedFields applies the CheckUnknownFields check to the fields in the UserDefinedTags ranges.
+	CheckUserDefinedFields bool
+
+	// UserDefinedTags are the tag ranges of the user-defined fields.
+	UserDefinedTags []TagRange
+}
+
+// DefaultValidatorSettings returns the settings enabling all checks except for the checks of the user-defined fields.
+func DefaultValidatorSettings() ValidatorSettings {
+	return ValidatorSettings{
+		CheckRequiredFields:  true,
+		CheckEnums:           true,
+		CheckDataFormat:      true,
+		CheckFieldsOrder:     true,
+		CheckDuplicateFields: true,
+		CheckUnknownFields:   true,
+		UserDefinedTags:      DefaultUserDefinedTags,
+	}
+}
+
+// Validator checks messages against a dictionary.
+// It implements encoding.RawValidator, so it could be used by encoding.DefaultUnmarshaller.
+// The errors are returned as *encoding.Error.
+type Validator struct {
+	dict     *Dictionary
+	settings ValidatorSettings
+}
+
+// NewValidator creates a new Validator.
+func NewValidator(dict *Dictionary, settings ValidatorSettings) *Validator {
+	return &Validator{dict: dict, settings: settings}
+}
+
+// Do validates a message built or unmarshalled by a builder.
+func (v *Validator) Do(msg messages.Builder) error {
+	data, err := msg.ToBytes()
+	if err != nil {
+		return err
+	}
+
+	return v.Validate(data)
+}
+
+// DoRaw validates the raw data of a message before unmarshalling it.
+func (v *Validator) DoRaw(_ messages.Builder, data []byte) error {
+	return v.Validate(data)
+}
+
+// Validate checks the raw data of a message.
+func (v *Validator) Validate(data []byte) error {
+	var fields []rawField
+	fix.EachField(data, func(tag string, value []byte) bool {
+		fields = append(fields, rawField{tag: tag, value: value})
+		return true
+	})
+
+	s := &scan{v: v, fields: fields}
+
+	return s.message()
+}
+
+type rawField struct {
+	tag   string
+	value []byte
+}
+
+// scan validates the fields of a message one by one.
+type scan struct {
+	v      *Validator
+	fields []rawField
+	pos    int
+}
+
+// Sections of a message in the required order.
+const (
+	headerSection = iota
+	bodySection
+	trailerSection
+)
+
+func (s *scan) message() error {
+	settings := s.v.settings
+	dict := s.v.dict
+
+	var msgType string
+	for _, f := range s.fields {
+		if f.tag == tagMsgType {
+			msgType = string(f.value)
+			break
+		}
+	}
+	if msgType == "" {
+		return encoding.NewError(encoding.ErrRequiredTagMissing, tagMsgType, nil)
+	}
+	msg, ok := dict.Message(msgType)
+	if !ok {
+		return encoding.NewError(encoding.ErrInvalidMsgType, tagMsgType, nil)
+	}
+
+	if settings.CheckFieldsOrder {
+		if err := s.checkEnvelopeOrder(); err != nil {
+			return err
+		}
+	}
+
+	sections := [...]*layout{
+		headerSection:  &dict.Header.layout,
+		bodySection:    &msg.layout,
+		trailerSection: &dict.Trailer.layout,
+	}
+	seen := [...]map[string]bool{{}, {}, {}}
+
+	section := headerSection
+	for s.pos < len(s.fields) {
+		f := s.fields[s.pos]
+
+		current := s.section(f.tag, msg)
+		if current < section && settings.CheckFieldsOrder {
+			return encoding.NewError(encoding.ErrTagSpecifiedOutOfOrder, f.tag, nil)
+		}
+		if current > section {
+			section = current
+		}
+
+		member, ok := sections[current].own[f.tag]
+		if !ok {
+			if err := s.unknownField(f.tag); err != nil {
+				return err
+			}
+			s.pos++
+			continue
+		}
+
+		if err := s.field(member.Member, seen[current]); err != nil {
+			return err
+		}
+	}
+
+	if settings.CheckRequiredFields {
+		if err := checkRequired(dict.Header.Members, seen[headerSection]); err != nil {
+			return err
+		}
+		if err := checkRequired(msg.Members, seen[bodySection]); err != nil {
+			return err
+		}
+		if err := checkRequired(dict.Trailer.Members, seen[trailerSection]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkEnvelopeOrder checks the positions of the BeginString, BodyLength, MsgType and CheckSum fields.
+func (s *scan) checkEnvelopeOrder() error {
+	for i, tag := range []string{tagBeginString, tagBodyLength, tagMsgType} {
+		if i >= len(s.fields) {
+			return encoding.NewError(encoding.ErrRequiredTagMissing, tag, nil)
+		}
+		if s.fields[i].tag != tag {
+			return encoding.NewError(encoding.ErrTagSpecifiedOutOfOrder, s.fields[i].tag, nil)
+		}
+	}
+
+	for i, f := range s.fields {
+		if f.tag == tagCheckSum && i != len(s.fields)-1 {
+			return encoding.NewError(encoding.ErrTagSpecifiedOutOfOrder, f.tag, nil)
+		}
+	}
+
+	return nil
+}
+
+// section returns the section which the tag belongs to.
+// The fields which are not defined for the header and the trailer belong to the body.
+func (s *scan) section(tag string, msg *Message) int {
+	switch {
+	case s.v.dict.Header.HasField(tag) && !msg.HasField(tag):
+		return headerSection
+	case s.v.dict.Trailer.HasField(tag) && !msg.HasField(tag):
+		return trailerSection
+	default:
+		return bodySection
+	}
+}
+
+// unknownField checks a field which is not defined for the message type.
+func (s *scan) unknownField(tag string) error {
+	settings := s.v.settings
+
+	tagID, err := strconv.Atoi(tag)
+	if err != nil || tagID <= 0 {
+		return encoding.NewError(encoding.ErrInvalidTagNumber, tag, nil)
+	}
+
+	if !settings.CheckUnknownFields {
+		return nil
+	}
+	if !settings.CheckUserDefinedFields {
+		for _, tagRange := range settings.UserDefinedTags {
+			if tagRange.Contains(tagID) {
+				return nil
+			}
+		}
+	}
+
+	if _, ok := s.v.dict.FieldByTag(tag); !ok {
+		return encoding.NewError(encoding.ErrUndefinedTag, tag, nil)
+	}
+
+	return encoding.NewError(encoding.ErrTagNotDefinedForMessageType, tag, nil)
+}
+
+// field checks the field at the current position.
+// The entries of a repeating group are checked as well if the field is a NumInGroup field.
+func (s *scan) field(member *Member, seen map[string]bool) error {
+	f := s.fields[s.pos]
+
+	if seen[f.tag] && s.v.settings.CheckDuplicateFields {
+		return encoding.NewError(encoding.ErrTagAppearsMoreThanOnce, f.tag, nil)
+	}
+	seen[f.tag] = true
+
+	if err := s.value(member.Field, f.value); err != nil {
+		return err
+	}
+	s.pos++
+
+	if member.Kind == GroupMember {
+		return s.group(member, f.value)
+	}
+
+	return nil
+}
+
+// value checks the data format and the enums of a field value.
+func (s *scan) value(field *Field, value []byte) error {
+	settings := s.v.settings
+
+	if settings.CheckDataFormat {
+		if len(value) == 0 {
+			return encoding.NewError(encoding.ErrTagSpecifiedWithoutValue, field.Tag, nil)
+		}
+		if err := checkFormat(field.Type, value); err != nil {
+			return encoding.NewError(encoding.ErrIncorrectDataFormat, field.Tag, err)
+		}
+	}
+
+	if settings.CheckEnums && field.HasEnums() {
+		values := [][]byte{value}
+		if isMultipleValue(field.Type) {
+			values = splitValues(value)
+		}
+
+		for _, v := range values {
+			if _, ok := field.Enum(string(v)); !ok {
+				return encoding.NewError(encoding.ErrIncorrectValue, field.Tag, nil)
+			}
+		}
+	}
+
+	return nil
+}
+
+// group checks the entries of a repeating group following its NumInGroup field.
+func (s *scan) group(group *Member, countValue []byte) error {
+	settings := s.v.settings
+	noTag := group.Tag()
+	delimiter := group.Delimiter()
+
+	count, err := strconv.Atoi(string(countValue))
+	if err != nil {
+		return encoding.NewError(encoding.ErrIncorrectDataFormat, noTag, err)
+	}
+
+	var entries int
+	for s.pos < len(s.fields) {
+		f := s.fields[s.pos]
+		if _, ok := group.own[f.tag]; !ok {
+			break
+		}
+		// Without the order check an entry may start with any of its fields,
+		// the next entry then starts with the second delimiter.
+		if f.tag != delimiter && settings.CheckFieldsOrder {
+			return encoding.NewError(encoding.ErrGroupFieldsOutOfOrder, f.tag, nil)
+		}
+
+		seen := make(map[string]bool)
+		position := -1
+		for s.pos < len(s.fields) {
+			f = s.fields[s.pos]
+			member, ok := group.own[f.tag]
+			if !ok || (f.tag == delimiter && seen[delimiter]) {
+				break
+			}
+
+			if member.position < position && settings.CheckFieldsOrder {
+				return encoding.NewError(encoding.ErrGroupFieldsOutOfOrder, f.tag, nil)
+			}
+			position = member.position
+
+			if err = s.field(member.Member, seen); err != nil {
+				return err
+			}
+		}
+
+		if settings.CheckRequiredFields {
+			if err = checkRequired(group.Members, seen); err != nil {
+				return err
+			}
+		}
+		entries++
+	}
+
+	if entries != count {
+		return encoding.NewError(encoding.ErrIncorrectNumInGroupCount, noTag, nil)
+	}
+
+	return nil
+}
+
+// checkRequired checks that the required members are present.
+// The required members of an optional component are checked only if any of its fields is present.
+func checkRequired(members []*Member, seen map[string]bool) error {
+	for _, member := range members {
+		switch member.Kind {
+		case FieldMember, GroupMember:
+			if member.Required && !seen[member.Field.Tag] {
+				return encoding.NewError(encoding.ErrRequiredTagMissing, member.Field.Tag, nil)
+			}
+
+		case ComponentMember:
+			if !member.Required && !anySeen(member.Component.Members, seen) {
+				continue
+			}
+			if err := checkRequired(member.Component.Members, seen); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func anySeen(members []*Member, seen map[string]bool) bool {
+	for _, member := range members {
+		if member.Kind == ComponentMember {
+			if anySeen(member.Component.Members, seen) {
+				return true
+			}
+			continue
+		}
+		if seen[member.Field.Tag] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func splitValues(value []byte) [][]byte {
+	var values [][]byte
+	start := 0
+	for i := 0; i <= len(value); i++ {
+		if i == len(value) || value[i] == ' ' {
+			if i > start {
+				values = append(values, value[start:i])
+			}
+			start = i + 1
+		}
+	}
+
+	return values
+}
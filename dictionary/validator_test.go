@@ -0,0 +1,206 @@
+package dictionary
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/b2broker/simplefix-go/fix/encoding"
+	fixgen "github.com/b2broker/simplefix-go/tests/fix44"
+)
+
+const (
+	testHeader  = "8=FIX.4.4|9=0|35=A|49=sender|56=target|34=1|52=20210706-19:06:12.838|"
+	testTrailer = "10=000|"
+)
+
+func withDelimiter(msg string) []byte {
+	return []byte(strings.ReplaceAll(msg, "|", "\x01"))
+}
+
+// makeMessage returns a message with the specified body, a valid BodyLength and a CheckSum.
+func makeMessage(body string) []byte {
+	body = strings.ReplaceAll(body, "|", "\x01")
+	msg := fmt.Sprintf("8=FIX.4.4\x019=%d\x01%s", len(body), body)
+
+	var sum int
+	for i := 0; i < len(msg); i++ {
+		sum += int(msg[i])
+	}
+
+	return []byte(fmt.Sprintf("%s10=%03d\x01", msg, sum%256))
+}
+
+func TestValidator(t *testing.T) {
+	d, err := Load("../source/fix44.xml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		msg      string
+		settings func(*ValidatorSettings)
+		reason   error
+		tag      string
+	}{
+		"valid message": {
+			msg: testHeader + "98=0|108=30|384=2|372=D|385=S|372=8|385=R|" + testTrailer,
+		},
+		"required body field": {
+			msg:    testHeader + "98=0|" + testTrailer,
+			reason: encoding.ErrRequiredTagMissing,
+			tag:    "108",
+		},
+		"required header field": {
+			msg:    strings.Replace(testHeader, "52=20210706-19:06:12.838|", "", 1) + "98=0|108=30|" + testTrailer,
+			reason: encoding.ErrRequiredTagMissing,
+			tag:    "52",
+		},
+		"invalid enum": {
+			msg:    testHeader + "98=9|108=30|" + testTrailer,
+			reason: encoding.ErrIncorrectValue,
+			tag:    "98",
+		},
+		"invalid enum in a group": {
+			msg:    testHeader + "98=0|108=30|384=1|372=D|385=X|" + testTrailer,
+			reason: encoding.ErrIncorrectValue,
+			tag:    "385",
+		},
+		"incorrect data format": {
+			msg:    testHeader + "98=0|108=x|" + testTrailer,
+			reason: encoding.ErrIncorrectDataFormat,
+			tag:    "108",
+		},
+		"incorrect timestamp": {
+			msg:    strings.Replace(testHeader, "20210706-19:06:12.838", "20210706-25:06:12", 1) + "98=0|108=30|" + testTrailer,
+			reason: encoding.ErrIncorrectDataFormat,
+			tag:    "52",
+		},
+		"tag without a value": {
+			msg:    testHeader + "98=0|108=|" + testTrailer,
+			reason: encoding.ErrTagSpecifiedWithoutValue,
+			tag:    "108",
+		},
+		"header field in the body": {
+			msg:    testHeader + "98=0|108=30|115=other|" + testTrailer,
+			reason: encoding.ErrTagSpecifiedOutOfOrder,
+			tag:    "115",
+		},
+		"header field in the body without the order check": {
+			msg:      testHeader + "98=0|108=30|115=other|" + testTrailer,
+			settings: func(s *ValidatorSettings) { s.CheckFieldsOrder = false },
+		},
+		"MsgType is not the third field": {
+			msg:    strings.Replace(testHeader, "35=A|49=sender|", "49=sender|35=A|", 1) + "98=0|108=30|" + testTrailer,
+			reason: encoding.ErrTagSpecifiedOutOfOrder,
+			tag:    "49",
+		},
+		"duplicate field": {
+			msg:    testHeader + "98=0|108=30|108=30|" + testTrailer,
+			reason: encoding.ErrTagAppearsMoreThanOnce,
+			tag:    "108",
+		},
+		"duplicate field in a group entry": {
+			msg:      testHeader + "98=0|108=30|384=1|372=D|385=S|385=S|" + testTrailer,
+			settings: func(s *ValidatorSettings) { s.CheckFieldsOrder = false },
+			reason:   encoding.ErrTagAppearsMoreThanOnce,
+			tag:      "385",
+		},
+		"undefined tag": {
+			msg:    testHeader + "98=0|108=30|100000=x|" + testTrailer,
+			reason: encoding.ErrUndefinedTag,
+			tag:    "100000",
+		},
+		"tag not defined for the message type": {
+			msg:    testHeader + "98=0|108=30|262=req|" + testTrailer,
+			reason: encoding.ErrTagNotDefinedForMessageType,
+			tag:    "262",
+		},
+		"unknown fields are allowed": {
+			msg:      testHeader + "98=0|108=30|262=req|" + testTrailer,
+			settings: func(s *ValidatorSettings) { s.CheckUnknownFields = false },
+		},
+		"user-defined field": {
+			msg: testHeader + "98=0|108=30|5001=x|" + testTrailer,
+		},
+		"checked user-defined field": {
+			msg:      testHeader + "98=0|108=30|5001=x|" + testTrailer,
+			settings: func(s *ValidatorSettings) { s.CheckUserDefinedFields = true },
+			reason:   encoding.ErrUndefinedTag,
+			tag:      "5001",
+		},
+		"invalid tag number": {
+			msg:    testHeader + "98=0|108=30|x=1|" + testTrailer,
+			reason: encoding.ErrInvalidTagNumber,
+			tag:    "x",
+		},
+		"invalid MsgType": {
+			msg:    strings.Replace(testHeader, "35=A", "35=ZZ", 1) + testTrailer,
+			reason: encoding.ErrInvalidMsgType,
+			tag:    "35",
+		},
+		"incorrect group count": {
+			msg:    testHeader + "98=0|108=30|384=3|372=D|385=S|372=8|385=R|" + testTrailer,
+			reason: encoding.ErrIncorrectNumInGroupCount,
+			tag:    "384",
+		},
+		"group delimiter is not the first field": {
+			msg:    testHeader + "98=0|108=30|384=1|385=S|372=D|" + testTrailer,
+			reason: encoding.ErrGroupFieldsOutOfOrder,
+			tag:    "385",
+		},
+		"group delimiter is not the first field without the order check": {
+			msg:      testHeader + "98=0|108=30|384=2|385=S|372=D|372=8|385=R|" + testTrailer,
+			settings: func(s *ValidatorSettings) { s.CheckFieldsOrder = false },
+		},
+		"CheckSum is not the last field": {
+			msg:    testHeader + "98=0|10=000|108=30|",
+			reason: encoding.ErrTagSpecifiedOutOfOrder,
+			tag:    "10",
+		},
+	}
+
+	for name, testCase := range testCases {
+		settings := DefaultValidatorSettings()
+		if testCase.settings != nil {
+			testCase.settings(&settings)
+		}
+
+		err := NewValidator(d, settings).Validate(withDelimiter(testCase.msg))
+		if testCase.reason == nil {
+			if err != nil {
+				t.Fatalf("unexpected error in case '%s': %s", name, err)
+			}
+			continue
+		}
+
+		var fixErr *encoding.Error
+		if !errors.As(err, &fixErr) || !errors.Is(err, testCase.reason) || fixErr.Tag != testCase.tag {
+			t.Fatalf("unexpected error in case '%s': %v", name, err)
+		}
+	}
+}
+
+func TestValidatorUnmarshal(t *testing.T) {
+	d, err := Load("../source/fix44.xml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	u := encoding.DefaultUnmarshaller{Validator: NewValidator(d, DefaultValidatorSettings()), Strict: true}
+
+	logon := fixgen.Logon{}.New()
+	err = u.Unmarshal(logon, makeMessage("35=A|49=sender|56=target|34=1|52=20210706-19:06:12.838|98=0|108=30|5001=x|"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if logon.HeartBtInt() != 30 {
+		t.Fatalf("unexpected HeartBtInt: %d", logon.HeartBtInt())
+	}
+
+	err = u.Unmarshal(fixgen.Logon{}.New(), makeMessage("35=A|49=sender|56=target|34=1|52=20210706-19:06:12.838|98=9|108=30|"))
+	if !errors.Is(err, encoding.ErrIncorrectValue) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -6,4 +6,7 @@ var (
 	ErrNotEnoughMessages = errors.New("not enough messages in the storage")
 	ErrInvalidBoundaries = errors.New("invalid boundaries")
 	ErrInvalidSequence   = errors.New("unexpected sequence index")
+	ErrMessagesTrimmed   = errors.New("the messages were trimmed by the storage retention policy")
+
+	ErrTooManyGarbledMessages = errors.New("too many garbled messages received")
 )
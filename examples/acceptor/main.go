@@ -16,8 +16,8 @@ import (
 	fixgen "github.com/b2broker/simplefix-go/tests/fix44"
 )
 
-func mustConvToInt(s string) int {
-	i, err := strconv.Atoi(s)
+func mustConvToInt[T ~string](s T) int {
+	i, err := strconv.Atoi(string(s))
 	if err != nil {
 		panic(err)
 	}
@@ -44,7 +44,7 @@ var pseudoGeneratedOpts = session.Opts{
 		EncryptedMethod: mustConvToInt(fixgen.FieldEncryptMethod),
 	},
 	AllowedEncryptedMethods: map[string]struct{}{
-		fixgen.EnumEncryptMethodNoneother: {},
+		string(fixgen.EnumEncryptMethodNoneother): {},
 	},
 	SessionErrorCodes: &messages.SessionErrorCodes{
 		InvalidTagNumber:            mustConvToInt(fixgen.EnumSessionRejectReasonInvalidtagnumber),
@@ -57,6 +57,11 @@ var pseudoGeneratedOpts = session.Opts{
 		DecryptionProblem:           mustConvToInt(fixgen.EnumSessionRejectReasonDecryptionproblem),
 		SignatureProblem:            mustConvToInt(fixgen.EnumSessionRejectReasonSignatureproblem),
 		CompIDProblem:               mustConvToInt(fixgen.EnumSessionRejectReasonCompidproblem),
+		TagAppearsMoreThanOnce:      mustConvToInt(fixgen.EnumSessionRejectReasonTagappearsmorethanonce),
+		IncorrectNumInGroupCount:    mustConvToInt(fixgen.EnumSessionRejectReasonIncorrectnumingroupcountforrepeatinggroup),
+		InvalidMsgType:              mustConvToInt(fixgen.EnumSessionRejectReasonInvalidmsgtype),
+		TagSpecifiedOutOfOrder:      mustConvToInt(fixgen.EnumSessionRejectReasonTagspecifiedoutofrequiredorder),
+		GroupFieldsOutOfOrder:       mustConvToInt(fixgen.EnumSessionRejectReasonRepeatinggroupfieldsoutoforder),
 		Other:                       mustConvToInt(fixgen.EnumSessionRejectReasonOther),
 	},
 }
@@ -18,8 +18,8 @@ import (
 	"github.com/b2broker/simplefix-go/utils"
 )
 
-func mustConvToInt(s string) int {
-	i, err := strconv.Atoi(s)
+func mustConvToInt[T ~string](s T) int {
+	i, err := strconv.Atoi(string(s))
 	if err != nil {
 		panic(err)
 	}
@@ -45,7 +45,7 @@ var pseudoGeneratedOpts = session.Opts{
 		EncryptedMethod: mustConvToInt(fixgen.FieldEncryptMethod),
 	},
 	AllowedEncryptedMethods: map[string]struct{}{
-		fixgen.EnumEncryptMethodNoneother: {},
+		string(fixgen.EnumEncryptMethodNoneother): {},
 	},
 	SessionErrorCodes: &messages.SessionErrorCodes{
 		InvalidTagNumber:            mustConvToInt(fixgen.EnumSessionRejectReasonInvalidtagnumber),
@@ -58,6 +58,11 @@ var pseudoGeneratedOpts = session.Opts{
 		DecryptionProblem:           mustConvToInt(fixgen.EnumSessionRejectReasonDecryptionproblem),
 		SignatureProblem:            mustConvToInt(fixgen.EnumSessionRejectReasonSignatureproblem),
 		CompIDProblem:               mustConvToInt(fixgen.EnumSessionRejectReasonCompidproblem),
+		TagAppearsMoreThanOnce:      mustConvToInt(fixgen.EnumSessionRejectReasonTagappearsmorethanonce),
+		IncorrectNumInGroupCount:    mustConvToInt(fixgen.EnumSessionRejectReasonIncorrectnumingroupcountforrepeatinggroup),
+		InvalidMsgType:              mustConvToInt(fixgen.EnumSessionRejectReasonInvalidmsgtype),
+		TagSpecifiedOutOfOrder:      mustConvToInt(fixgen.EnumSessionRejectReasonTagspecifiedoutofrequiredorder),
+		GroupFieldsOutOfOrder:       mustConvToInt(fixgen.EnumSessionRejectReasonRepeatinggroupfieldsoutoforder),
 		Other:                       mustConvToInt(fixgen.EnumSessionRejectReasonOther),
 	},
 }
@@ -87,7 +92,7 @@ func main() {
 			TargetCompID:  "Server",
 			SenderCompID:  "Client",
 			HeartBtInt:    5,
-			EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+			EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 			Password:      "password",
 			Username:      "login",
 		},
@@ -0,0 +1,218 @@
+package fix
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// Data is a value of a data field, which might contain SOH characters.
+// The field specifying its length is filled when a message is prepared.
+type Data struct {
+	value     []byte
+	valid     bool
+	lengthTag string
+}
+
+// NewData creates a new instance of a Data object.
+func NewData(v []byte) *Data {
+	return &Data{value: v, valid: true}
+}
+
+// NewDataField creates an empty value of a data field whose length is specified by the field with lengthTag.
+// It is required for the data fields which are not defined by the FIX standard.
+func NewDataField(lengthTag string) *Data {
+	return &Data{lengthTag: lengthTag}
+}
+
+// LengthTag returns the tag of the field specifying the length of the value.
+func (v *Data) LengthTag() (string, bool) {
+	if v.lengthTag != "" {
+		return v.lengthTag, true
+	}
+
+	return "", false
+}
+
+func (v *Data) ToBytes() []byte {
+	if !v.valid || len(v.value) == 0 {
+		return nil
+	}
+	return v.value
+}
+
+func (v *Data) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid || len(v.value) == 0 {
+		return false
+	}
+	_, _ = writer.Write(v.value)
+	return true
+}
+
+// FromBytes copies the value, so it does not refer to the message data.
+func (v *Data) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	v.value = append([]byte{}, d...)
+	v.valid = true
+	return nil
+}
+
+func (v *Data) IsNull() bool {
+	return !v.valid
+}
+
+func (v *Data) IsEmpty() bool {
+	return !v.valid || len(v.value) == 0
+}
+
+func (v *Data) Value() interface{} {
+	return v.value
+}
+
+// Set assigns the field value stored as a byte array or a string.
+func (v *Data) Set(d interface{}) error {
+	switch res := d.(type) {
+	case nil:
+		v.valid = false
+	case []byte:
+		v.value, v.valid = res, true
+	case string:
+		v.value, v.valid = []byte(res), true
+	default:
+		return fmt.Errorf("could not convert %s to %s", d, "Data")
+	}
+
+	return nil
+}
+
+func (v *Data) String() string {
+	return string(v.value)
+}
+
+// setDataLengths assigns the lengths of the data values to the length fields of the same components or group entries.
+func setDataLengths(items Items) error {
+	for _, item := range items {
+		switch el := item.(type) {
+		case *KeyValue:
+			data, ok := el.Value.(*Data)
+			if !ok {
+				continue
+			}
+			lengthTag, ok := data.LengthTag()
+			if !ok {
+				lengthTag, ok = DataLengthTag(el.Key)
+			}
+			if !ok {
+				return fmt.Errorf("the length field of the data field %s is unknown", el.Key)
+			}
+
+			length := findKeyValue(items, lengthTag)
+			if length == nil {
+				if data.IsEmpty() {
+					continue
+				}
+				return fmt.Errorf("%w: %s: the length of the data field %s", ErrFieldNotFound, lengthTag, el.Key)
+			}
+
+			var err error
+			if data.IsEmpty() {
+				err = length.Value.Set(nil)
+			} else {
+				err = length.Value.Set(len(data.value))
+			}
+			if err != nil {
+				return fmt.Errorf("could not set the length of the data field %s: %w", el.Key, err)
+			}
+
+		case *Component:
+			if el == nil {
+				continue
+			}
+			if err := setDataLengths(el.items); err != nil {
+				return err
+			}
+
+		case *Group:
+			if el == nil {
+				continue
+			}
+			for _, entry := range el.items {
+				if err := setDataLengths(entry); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func findKeyValue(items Items, key string) *KeyValue {
+	for _, item := range items {
+		if kv, ok := item.(*KeyValue); ok && kv.Key == key {
+			return kv
+		}
+	}
+
+	return nil
+}
+
+// DataLengthTags returns the tags of the length fields by the tags of the data fields, which are parsed
+// using their length. It includes the standard data fields and the data fields of the items created by NewDataField.
+func DataLengthTags(items Items) map[int]int {
+	var tags map[int]int
+	eachDataField(items, func(tag, lengthTag string) {
+		tagID, err := strconv.Atoi(tag)
+		if err != nil {
+			return
+		}
+		lengthTagID, err := strconv.Atoi(lengthTag)
+		if err != nil || dataLengthTagIDs[tagID] == lengthTagID {
+			return
+		}
+
+		if tags == nil {
+			tags = make(map[int]int, len(dataLengthTagIDs)+1)
+			for t, l := range dataLengthTagIDs {
+				tags[t] = l
+			}
+		}
+		tags[tagID] = lengthTagID
+	})
+
+	if tags == nil {
+		return dataLengthTagIDs
+	}
+
+	return tags
+}
+
+// eachDataField calls fn for each data field of the items with a length tag specified by NewDataField.
+func eachDataField(items Items, fn func(tag, lengthTag string)) {
+	for _, item := range items {
+		switch el := item.(type) {
+		case *KeyValue:
+			if data, ok := el.Value.(*Data); ok && data.lengthTag != "" {
+				fn(el.Key, data.lengthTag)
+			}
+
+		case *Component:
+			if el != nil {
+				eachDataField(el.items, fn)
+			}
+
+		case *Group:
+			if el == nil {
+				continue
+			}
+			eachDataField(el.template, fn)
+			for _, entry := range el.items {
+				eachDataField(entry, fn)
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package fix
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/b2broker/simplefix-go/fix/buffer"
+)
+
+func TestDataLength(t *testing.T) {
+	newMessage := func(data []byte) *Message {
+		return NewMessage("8", "9", "10", "35", "FIX.4.4", "A").
+			SetHeader(NewComponent(NewKeyValue("49", NewString("sender")))).
+			SetBody(
+				NewKeyValue("95", &Int{}),
+				NewKeyValue("96", NewData(data)),
+				NewGroup("384", NewKeyValue("354", &Int{}), NewKeyValue("355", &Data{})).
+					AddEntry(Items{NewKeyValue("354", &Int{}), NewKeyValue("355", NewData([]byte("x\x01")))}),
+			).
+			SetTrailer(NewComponent())
+	}
+
+	data := []byte("a\x01b=c")
+	expect := "35=A\x0149=sender\x0195=5\x0196=a\x01b=c\x01384=1\x01354=2\x01355=x\x01\x01"
+
+	msg, err := newMessage(data).ToBytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Contains(msg, []byte(expect)) {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+
+	buffered, err := newMessage(data).ToBytesBuffered(buffer.NewMessageByteBuffers(100))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Equal(msg, buffered) {
+		t.Fatalf("unexpected buffered message: %q", buffered)
+	}
+
+	var values []string
+	EachField(msg, func(tag string, value []byte) bool {
+		if tag == "96" || tag == "355" {
+			values = append(values, string(value))
+		}
+		return true
+	})
+	if len(values) != 2 || values[0] != string(data) || values[1] != "x\x01" {
+		t.Fatalf("unexpected values: %q", values)
+	}
+
+	msg, err = newMessage(nil).ToBytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if bytes.Contains(msg, []byte("\x0195=")) {
+		t.Fatalf("the length of empty data is specified: %q", msg)
+	}
+}
+
+func TestDataField(t *testing.T) {
+	msg := []byte("8=FIX.4.4\x0150001=3\x0150002=a\x01b\x0110=000\x01")
+
+	// The value is split by the SOH character unless the data field is known.
+	if _, err := Tokenize(msg, nil); !errors.Is(err, ErrInvalidTag) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	items := Items{NewKeyValue("50001", &Int{}), NewKeyValue("50002", NewDataField("50001"))}
+	lengthTags := DataLengthTags(items)
+	if lengthTags[50002] != 50001 || lengthTags[96] != 95 {
+		t.Fatalf("unexpected length tags: %v", lengthTags)
+	}
+	if _, ok := DataLengthTags(nil)[50002]; ok {
+		t.Fatalf("the standard data fields are modified")
+	}
+
+	tokens, err := TokenizeWith(msg, nil, lengthTags)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(tokens) != 4 || string(msg[tokens[2].Start:tokens[2].End]) != "a\x01b" {
+		t.Fatalf("unexpected fields: %v", tokens)
+	}
+
+	data := NewDataField("50001")
+	if err = data.Set("a\x01b"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res, err := NewMessage("8", "9", "10", "35", "FIX.4.4", "A").
+		SetHeader(NewComponent()).
+		SetBody(NewKeyValue("50001", &Int{}), NewKeyValue("50002", data)).
+		SetTrailer(NewComponent()).
+		ToBytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Contains(res, []byte("\x0150001=3\x0150002=a\x01b\x01")) {
+		t.Fatalf("unexpected message: %q", res)
+	}
+
+	if lengthTag, ok := NewKeyValue("50002", data).AsTemplate().Value.(*Data).LengthTag(); !ok || lengthTag != "50001" {
+		t.Fatalf("the length tag is not copied to the template: %s", lengthTag)
+	}
+}
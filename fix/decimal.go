@@ -0,0 +1,515 @@
+package fix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+)
+
+var ErrInvalidDecimal = errors.New("invalid decimal")
+
+// maxInt64Digits is the number of decimal digits which always fit into int64.
+const maxInt64Digits = 18
+
+// pow10s are the powers of 10 fitting into int64.
+var pow10s = func() [maxInt64Digits + 1]int64 {
+	var res [maxInt64Digits + 1]int64
+	res[0] = 1
+	for i := 1; i < len(res); i++ {
+		res[i] = res[i-1] * 10
+	}
+
+	return res
+}()
+
+// Decimal is a fixed-point number used for prices, quantities and amounts without the precision loss of floats.
+// It is stored as an integer coefficient and the number of digits after the decimal point, so 1.50 is 150 with the scale 2.
+// The coefficient is an int64, while the greater coefficients are stored as big integers.
+//
+// The digits are written exactly as they are read, including the trailing zeros of the fractional part,
+// use Normalize to drop them from a value.
+//
+// The arithmetic methods return new values and never modify the operands.
+// The zero value is a null field value equal to 0.
+type Decimal struct {
+	coef int64
+	// big is the coefficient if it does not fit into int64, otherwise it is nil.
+	big   *big.Int
+	scale int32
+	valid bool
+}
+
+// NewDecimal creates a decimal equal to coef * 10^-scale, e.g. NewDecimal(150, 2) is 1.50.
+func NewDecimal(coef int64, scale int32) Decimal {
+	if scale < 0 {
+		return newBigDecimal(new(big.Int).Mul(big.NewInt(coef), pow10(-scale)), 0)
+	}
+
+	return Decimal{coef: coef, scale: scale, valid: true}
+}
+
+// newBigDecimal creates a decimal keeping the coefficient as int64 if it fits.
+func newBigDecimal(coef *big.Int, scale int32) Decimal {
+	if coef.IsInt64() {
+		return Decimal{coef: coef.Int64(), scale: scale, valid: true}
+	}
+
+	return Decimal{big: coef, scale: scale, valid: true}
+}
+
+// NewDecimalFromFloat creates a decimal from the shortest decimal representation of a float.
+func NewDecimalFromFloat(f float64) (Decimal, error) {
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// ParseDecimal parses a decimal in the FIX format, e.g. -123.4500.
+func ParseDecimal(s string) (Decimal, error) {
+	var d Decimal
+	if err := d.FromBytes([]byte(s)); err != nil {
+		return Decimal{}, err
+	}
+
+	return d, nil
+}
+
+// MustParseDecimal is ParseDecimal which panics on errors. It is intended for constants.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// bigInt returns the coefficient as a big integer, which should not be modified.
+func (v Decimal) bigInt() *big.Int {
+	if v.big != nil {
+		return v.big
+	}
+
+	return big.NewInt(v.coef)
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// mulInt64 returns a * b unless it overflows.
+func mulInt64(a, b int64) (int64, bool) {
+	hi, lo := bits.Mul64(absInt64(a), absInt64(b))
+	if hi != 0 || lo > math.MaxInt64 {
+		return 0, false
+	}
+	if (a < 0) != (b < 0) {
+		return -int64(lo), true
+	}
+
+	return int64(lo), true
+}
+
+// absInt64 returns |x| as uint64, so it does not overflow for math.MinInt64.
+func absInt64(x int64) uint64 {
+	if x < 0 {
+		return -uint64(x)
+	}
+
+	return uint64(x)
+}
+
+// rescaled returns the int64 coefficient for a greater scale unless it overflows.
+func (v Decimal) rescaled(scale int32) (int64, bool) {
+	if v.big != nil || scale-v.scale > maxInt64Digits {
+		return 0, false
+	}
+
+	return mulInt64(v.coef, pow10s[scale-v.scale])
+}
+
+// rescale returns the big coefficient for a greater scale.
+func (v Decimal) rescale(scale int32) *big.Int {
+	if scale == v.scale {
+		return v.bigInt()
+	}
+
+	return new(big.Int).Mul(v.bigInt(), pow10(scale-v.scale))
+}
+
+// Scale returns the number of digits after the decimal point.
+func (v Decimal) Scale() int32 {
+	return v.scale
+}
+
+// Add returns v + d.
+func (v Decimal) Add(d Decimal) Decimal {
+	scale := max(v.scale, d.scale)
+
+	a, ok1 := v.rescaled(scale)
+	b, ok2 := d.rescaled(scale)
+	if sum := a + b; ok1 && ok2 && (sum > a) == (b > 0) {
+		return Decimal{coef: sum, scale: scale, valid: true}
+	}
+
+	return newBigDecimal(new(big.Int).Add(v.rescale(scale), d.rescale(scale)), scale)
+}
+
+// Sub returns v - d.
+func (v Decimal) Sub(d Decimal) Decimal {
+	scale := max(v.scale, d.scale)
+
+	a, ok1 := v.rescaled(scale)
+	b, ok2 := d.rescaled(scale)
+	if diff := a - b; ok1 && ok2 && (diff < a) == (b > 0) {
+		return Decimal{coef: diff, scale: scale, valid: true}
+	}
+
+	return newBigDecimal(new(big.Int).Sub(v.rescale(scale), d.rescale(scale)), scale)
+}
+
+// Mul returns v * d. The scale of the result is the sum of the scales.
+func (v Decimal) Mul(d Decimal) Decimal {
+	scale := v.scale + d.scale
+	if v.big == nil && d.big == nil {
+		if coef, ok := mulInt64(v.coef, d.coef); ok {
+			return Decimal{coef: coef, scale: scale, valid: true}
+		}
+	}
+
+	return newBigDecimal(new(big.Int).Mul(v.bigInt(), d.bigInt()), scale)
+}
+
+// Div returns v / d rounded half away from zero to the specified scale.
+// It panics if d is zero.
+func (v Decimal) Div(d Decimal, scale int32) Decimal {
+	if d.Sign() == 0 {
+		panic("fix: division of decimal by zero")
+	}
+
+	// v.coef * 10^(scale + d.scale - v.scale) / d.coef has the required scale. An extra digit is used for rounding.
+	num := v.bigInt()
+	shift := scale + d.scale - v.scale + 1
+	if shift >= 0 {
+		num = new(big.Int).Mul(num, pow10(shift))
+	}
+	den := d.bigInt()
+	if shift < 0 {
+		den = new(big.Int).Mul(den, pow10(-shift))
+	}
+
+	quo := new(big.Int).Quo(num, den)
+
+	return newBigDecimal(roundLastDigit(quo), scale)
+}
+
+// roundLastDigit drops the last digit rounding half away from zero.
+func roundLastDigit(x *big.Int) *big.Int {
+	quo, rem := new(big.Int).QuoRem(x, big.NewInt(10), new(big.Int))
+	if rem.CmpAbs(big.NewInt(5)) >= 0 {
+		quo.Add(quo, big.NewInt(int64(x.Sign())))
+	}
+
+	return quo
+}
+
+// Round returns the decimal rounded half away from zero to the specified scale.
+// The scale is not increased, use Rescale for that.
+func (v Decimal) Round(scale int32) Decimal {
+	if scale >= v.scale {
+		return v.withValid()
+	}
+
+	if v.big == nil && v.scale-scale <= maxInt64Digits {
+		div := pow10s[v.scale-scale]
+		quo, rem := v.coef/div, v.coef%div
+		if absInt64(rem) >= uint64(div/2) {
+			if v.coef < 0 {
+				quo--
+			} else {
+				quo++
+			}
+		}
+
+		return Decimal{coef: quo, scale: scale, valid: true}
+	}
+
+	coef := new(big.Int).Quo(v.bigInt(), pow10(v.scale-scale-1))
+
+	return newBigDecimal(roundLastDigit(coef), scale)
+}
+
+// Truncate returns the decimal with the digits beyond the specified scale dropped.
+func (v Decimal) Truncate(scale int32) Decimal {
+	if scale >= v.scale {
+		return v.withValid()
+	}
+
+	if v.big == nil && v.scale-scale <= maxInt64Digits {
+		return Decimal{coef: v.coef / pow10s[v.scale-scale], scale: scale, valid: true}
+	}
+
+	return newBigDecimal(new(big.Int).Quo(v.bigInt(), pow10(v.scale-scale)), scale)
+}
+
+// Rescale returns the decimal with the specified scale, it is rounded if the scale is decreased.
+func (v Decimal) Rescale(scale int32) Decimal {
+	if scale <= v.scale {
+		return v.Round(scale)
+	}
+
+	if coef, ok := v.rescaled(scale); ok {
+		return Decimal{coef: coef, scale: scale, valid: true}
+	}
+
+	return newBigDecimal(v.rescale(scale), scale)
+}
+
+// Normalize returns the decimal without the trailing zeros of the fractional part,
+// so it is written as 1.5 instead of 1.500.
+func (v Decimal) Normalize() Decimal {
+	if v.big != nil {
+		coef := new(big.Int).Set(v.big)
+		scale := v.scale
+
+		ten := big.NewInt(10)
+		quo, rem := new(big.Int), new(big.Int)
+		for scale > 0 {
+			quo.QuoRem(coef, ten, rem)
+			if rem.Sign() != 0 {
+				break
+			}
+			coef.Set(quo)
+			scale--
+		}
+
+		return newBigDecimal(coef, scale)
+	}
+
+	coef, scale := v.coef, v.scale
+	for scale > 0 && coef%10 == 0 {
+		coef /= 10
+		scale--
+	}
+	if coef == 0 {
+		scale = 0
+	}
+
+	return Decimal{coef: coef, scale: scale, valid: true}
+}
+
+// Neg returns -v.
+func (v Decimal) Neg() Decimal {
+	if v.big == nil && v.coef != math.MinInt64 {
+		return Decimal{coef: -v.coef, scale: v.scale, valid: true}
+	}
+
+	return newBigDecimal(new(big.Int).Neg(v.bigInt()), v.scale)
+}
+
+// Abs returns |v|.
+func (v Decimal) Abs() Decimal {
+	if v.Sign() < 0 {
+		return v.Neg()
+	}
+
+	return v.withValid()
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of v.
+func (v Decimal) Sign() int {
+	switch {
+	case v.big != nil:
+		return v.big.Sign()
+	case v.coef < 0:
+		return -1
+	case v.coef > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsZero returns true if v is equal to 0.
+func (v Decimal) IsZero() bool {
+	return v.Sign() == 0
+}
+
+// Cmp compares the values regardless of their scales and returns -1, 0 or 1.
+func (v Decimal) Cmp(d Decimal) int {
+	scale := max(v.scale, d.scale)
+
+	a, ok1 := v.rescaled(scale)
+	b, ok2 := d.rescaled(scale)
+	if ok1 && ok2 {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	return v.rescale(scale).Cmp(d.rescale(scale))
+}
+
+// Equal returns true if the values are equal regardless of their scales, e.g. 1.5 is equal to 1.50.
+func (v Decimal) Equal(d Decimal) bool {
+	return v.Cmp(d) == 0
+}
+
+// Float64 returns the nearest float.
+func (v Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(v.String(), 64)
+	return f
+}
+
+func (v Decimal) withValid() Decimal {
+	v.valid = true
+	return v
+}
+
+// String returns the decimal in the FIX format with all digits of its scale.
+func (v Decimal) String() string {
+	var digits string
+	if v.big != nil {
+		digits = new(big.Int).Abs(v.big).String()
+	} else {
+		digits = strconv.FormatUint(absInt64(v.coef), 10)
+	}
+
+	if v.scale > 0 {
+		if pad := int(v.scale) + 1 - len(digits); pad > 0 {
+			digits = string(bytes.Repeat([]byte{'0'}, pad)) + digits
+		}
+		point := len(digits) - int(v.scale)
+		digits = digits[:point] + "." + digits[point:]
+	}
+
+	if v.Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+func (v *Decimal) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	return []byte(v.String())
+}
+
+func (v *Decimal) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	_, _ = writer.WriteString(v.String())
+	return true
+}
+
+func (v *Decimal) IsNull() bool {
+	return !v.valid
+}
+
+func (v *Decimal) IsEmpty() bool {
+	return !v.valid
+}
+
+func (v *Decimal) Value() interface{} {
+	return *v
+}
+
+// Set assigns the field value stored as a Decimal, an integer, a float or a string.
+func (v *Decimal) Set(d interface{}) error {
+	switch res := d.(type) {
+	case nil:
+		*v = Decimal{}
+	case Decimal:
+		*v = res.withValid()
+	case *Decimal:
+		*v = res.withValid()
+	case int:
+		*v = NewDecimal(int64(res), 0)
+	case int64:
+		*v = NewDecimal(res, 0)
+	case float64:
+		dec, err := NewDecimalFromFloat(res)
+		if err != nil {
+			return err
+		}
+		*v = dec
+	case string:
+		return v.FromBytes([]byte(res))
+	default:
+		return fmt.Errorf("could not convert %s to %s", d, "Decimal")
+	}
+
+	return nil
+}
+
+// FromBytes parses the value keeping all digits including the trailing zeros.
+func (v *Decimal) FromBytes(d []byte) error {
+	if d == nil {
+		*v = Decimal{}
+		return nil
+	}
+
+	digits := d
+	negative := len(digits) > 0 && digits[0] == '-'
+	if negative {
+		digits = digits[1:]
+	}
+
+	var (
+		coef     int64
+		scale    int32
+		point    bool
+		count    int
+		overflow bool
+	)
+	for _, c := range digits {
+		switch {
+		case c == '.' && !point:
+			point = true
+		case c >= '0' && c <= '9':
+			count++
+			if point {
+				scale++
+			}
+			if count > maxInt64Digits {
+				overflow = true
+			} else {
+				coef = coef*10 + int64(c-'0')
+			}
+		default:
+			return fmt.Errorf("%w: %s", ErrInvalidDecimal, d)
+		}
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidDecimal, d)
+	}
+
+	if !overflow {
+		if negative {
+			coef = -coef
+		}
+		*v = Decimal{coef: coef, scale: scale, valid: true}
+		return nil
+	}
+
+	value, ok := new(big.Int).SetString(string(bytes.Replace(digits, []byte{'.'}, nil, 1)), 10)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidDecimal, d)
+	}
+	if negative {
+		value.Neg(value)
+	}
+
+	*v = newBigDecimal(value, scale)
+	return nil
+}
@@ -0,0 +1,176 @@
+package fix
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestDecimalRoundTrip(t *testing.T) {
+	for _, value := range []string{
+		"0", "1", "-1", "1.500", "0.000", "-0.05", "123456789.123456789",
+		"12345678901234567890.12345678901234567890", "-0.00000000000000000001", "007.10",
+	} {
+		var d Decimal
+		if err := d.FromBytes([]byte(value)); err != nil {
+			t.Fatalf("unexpected error for %s: %s", value, err)
+		}
+
+		expected := value
+		if value == "007.10" {
+			expected = "7.10"
+		}
+		if !bytes.Equal(d.ToBytes(), []byte(expected)) {
+			t.Fatalf("unexpected bytes for %s: %s", value, d.ToBytes())
+		}
+	}
+
+	for _, invalid := range []string{"", "-", ".", "1.2.3", "1e5", "+1", "1,5", " 1"} {
+		var d Decimal
+		if err := d.FromBytes([]byte(invalid)); !errors.Is(err, ErrInvalidDecimal) {
+			t.Fatalf("unexpected error for %q: %v", invalid, err)
+		}
+	}
+}
+
+func TestDecimalTrailingZeros(t *testing.T) {
+	for value, expected := range map[string]string{"1.500": "1.5", "2.000": "2", "-0.10": "-0.1", "30": "30"} {
+		d := MustParseDecimal(value)
+		if s := d.Normalize().String(); s != expected {
+			t.Fatalf("unexpected value for %s: %s", value, s)
+		}
+		if s := d.String(); s != value {
+			t.Fatalf("the trailing zeros of %s are lost: %s", value, s)
+		}
+	}
+}
+
+func TestDecimalOverflow(t *testing.T) {
+	dec := MustParseDecimal
+
+	cases := []struct {
+		name     string
+		result   Decimal
+		expected string
+	}{
+		{"add", NewDecimal(math.MaxInt64, 0).Add(dec("1")), "9223372036854775808"},
+		{"sub", NewDecimal(math.MinInt64, 0).Sub(dec("1")), "-9223372036854775809"},
+		{"mul", dec("9223372036854775807").Mul(dec("-2.0")), "-18446744073709551614.0"},
+		{"rescale", dec("922337203685477580.7").Rescale(5), "922337203685477580.70000"},
+		{"neg", NewDecimal(math.MinInt64, 0).Neg(), "9223372036854775808"},
+		{"back to int64", dec("92233720368547758070").Sub(dec("92233720368547758060")), "10"},
+		{"round", dec("123456789012345678901.5").Round(0), "123456789012345678902"},
+		{"truncate", dec("-123456789012345678901.59").Truncate(1), "-123456789012345678901.5"},
+		{"normalize", dec("123456789012345678901.500").Normalize(), "123456789012345678901.5"},
+	}
+
+	for _, c := range cases {
+		if s := c.result.String(); s != c.expected {
+			t.Fatalf("%s: unexpected result: %s", c.name, s)
+		}
+	}
+
+	if dec("92233720368547758070").Cmp(NewDecimal(math.MaxInt64, 0)) != 1 || !dec("10.0").Equal(dec("92233720368547758070").Sub(dec("92233720368547758060"))) {
+		t.Fatalf("unexpected comparison")
+	}
+	if d := dec("0000000000000000000001.5"); d.big != nil || d.String() != "1.5" {
+		t.Fatalf("the coefficient should fit into int64: %s", d)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	dec := MustParseDecimal
+
+	cases := []struct {
+		name     string
+		result   Decimal
+		expected string
+	}{
+		{"add", dec("0.1").Add(dec("0.2")), "0.3"},
+		{"add scales", dec("1.5").Add(dec("0.25")), "1.75"},
+		{"sub", dec("1").Sub(dec("1.01")), "-0.01"},
+		{"mul", dec("1.5").Mul(dec("-0.2")), "-0.30"},
+		{"div", dec("10").Div(dec("3"), 4), "3.3333"},
+		{"div round up", dec("2").Div(dec("3"), 2), "0.67"},
+		{"div negative", dec("-1").Div(dec("8"), 2), "-0.13"},
+		{"div large scale", dec("1.23456").Div(dec("0.001"), 1), "1234.6"},
+		{"round", dec("1.245").Round(2), "1.25"},
+		{"round negative", dec("-1.245").Round(2), "-1.25"},
+		{"round greater scale", dec("1.2").Round(3), "1.2"},
+		{"truncate", dec("-1.249").Truncate(1), "-1.2"},
+		{"rescale", dec("1.2").Rescale(3), "1.200"},
+		{"normalize", dec("1.2300").Normalize(), "1.23"},
+		{"normalize zero", dec("0.000").Normalize(), "0"},
+		{"neg", dec("1.50").Neg(), "-1.50"},
+		{"abs", dec("-1.50").Abs(), "1.50"},
+		{"new", NewDecimal(150, 2), "1.50"},
+		{"new negative scale", NewDecimal(15, -2), "1500"},
+		{"zero value", Decimal{}.Add(dec("1")), "1"},
+	}
+
+	for _, c := range cases {
+		if s := c.result.String(); s != c.expected {
+			t.Fatalf("%s: unexpected result: %s", c.name, s)
+		}
+	}
+
+	if !dec("1.5").Equal(dec("1.500")) || dec("1.5").Cmp(dec("1.49")) != 1 || dec("-2").Cmp(dec("1")) != -1 {
+		t.Fatalf("unexpected comparison")
+	}
+	if !dec("0.00").IsZero() || dec("-0.1").Sign() != -1 {
+		t.Fatalf("unexpected sign")
+	}
+	if f := dec("1234.5").Float64(); f != 1234.5 {
+		t.Fatalf("unexpected float: %v", f)
+	}
+
+	a := dec("1.5")
+	_ = a.Add(dec("1")).Mul(dec("2"))
+	if a.String() != "1.5" {
+		t.Fatalf("the operand is modified: %s", a)
+	}
+}
+
+func TestDecimalSet(t *testing.T) {
+	d := &Decimal{}
+	if !d.IsNull() || d.ToBytes() != nil {
+		t.Fatalf("the zero value should be null")
+	}
+
+	for value, expected := range map[interface{}]string{
+		"0.10":                  "0.10",
+		0.1:                     "0.1",
+		42:                      "42",
+		int64(-7):               "-7",
+		MustParseDecimal("1.0"): "1.0",
+	} {
+		if err := d.Set(value); err != nil {
+			t.Fatalf("unexpected error for %v: %s", value, err)
+		}
+		if s := string(d.ToBytes()); s != expected {
+			t.Fatalf("unexpected value for %v: %s", value, s)
+		}
+	}
+
+	if err := d.Set(nil); err != nil || !d.IsNull() {
+		t.Fatalf("the value should be null: %v", err)
+	}
+	if err := d.Set("abc"); !errors.Is(err, ErrInvalidDecimal) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := d.Set(true); err == nil {
+		t.Fatalf("an error is expected")
+	}
+
+	kv := NewKeyValue("44", &Decimal{})
+	if err := kv.FromBytes([]byte("101.2500")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if v := kv.Load().Value().(Decimal); v.String() != "101.2500" || v.Scale() != 4 {
+		t.Fatalf("unexpected value: %s", v)
+	}
+	if _, ok := kv.AsTemplate().Value.(*Decimal); !ok {
+		t.Fatalf("unexpected template value: %T", kv.AsTemplate().Value)
+	}
+}
@@ -0,0 +1,67 @@
+package encoding
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// The reasons of unmarshalling failures. Each of them corresponds to a SessionRejectReason.
+var (
+	ErrInvalidTagNumber            = errors.New("invalid tag number")
+	ErrRequiredTagMissing          = errors.New("required tag missing")
+	ErrTagNotDefinedForMessageType = errors.New("tag not defined for this message type")
+	ErrUndefinedTag                = errors.New("undefined tag")
+	ErrTagSpecifiedWithoutValue    = errors.New("tag specified without a value")
+	ErrIncorrectValue              = errors.New("value is incorrect (out of range) for this tag")
+	ErrIncorrectDataFormat         = errors.New("incorrect data format for value")
+	ErrTagAppearsMoreThanOnce      = errors.New("tag appears more than once")
+	ErrIncorrectNumInGroupCount    = errors.New("incorrect NumInGroup count for repeating group")
+	ErrInvalidMsgType              = errors.New("invalid MsgType")
+	ErrTagSpecifiedOutOfOrder      = errors.New("tag specified out of required order")
+	ErrGroupFieldsOutOfOrder       = errors.New("repeating group fields out of order")
+)
+
+// Error is returned when a message could not be unmarshalled because of a particular tag.
+// It matches both its Reason and the underlying error with errors.Is.
+type Error struct {
+	// Reason is one of the reason errors, e.g. ErrRequiredTagMissing.
+	Reason error
+
+	// Tag is the tag causing the failure.
+	Tag string
+
+	// Err is an optional underlying error.
+	Err error
+}
+
+// NewError creates an error caused by a tag. The underlying error is optional.
+func NewError(reason error, tag string, err error) *Error {
+	return &Error{Reason: reason, Tag: tag, Err: err}
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %s", e.Reason, e.Tag, e.Err)
+	}
+
+	return fmt.Sprintf("%s: %s", e.Reason, e.Tag)
+}
+
+func (e *Error) Unwrap() []error {
+	if e.Err != nil {
+		return []error{e.Reason, e.Err}
+	}
+
+	return []error{e.Reason}
+}
+
+// TagID returns the tag as a number or zero if it is not numeric.
+func (e *Error) TagID() int {
+	tag, err := strconv.Atoi(e.Tag)
+	if err != nil {
+		return 0
+	}
+
+	return tag
+}
@@ -0,0 +1,158 @@
+package encoding
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// groupLayout describes the fields of the entries of a repeating group.
+type groupLayout struct {
+	noTag string
+	// tags are the tags of the entry fields including the fields of components
+	// and the NumInGroup fields of nested groups, but not the fields of their entries.
+	tags map[int]bool
+	// groups are the nested groups by their NumInGroup tags.
+	groups  map[int]*fix.Group
+	layouts map[int]*groupLayout
+}
+
+func newGroupLayout(group *fix.Group) *groupLayout {
+	layout := &groupLayout{
+		noTag:  group.NoTag(),
+		tags:   make(map[int]bool),
+		groups: make(map[int]*fix.Group),
+	}
+	collectLayout(group.AsTemplate(), layout.tags, layout.groups)
+
+	return layout
+}
+
+// nested returns the layout of a nested group, which is made once it is required.
+func (l *groupLayout) nested(noTag int) (*groupLayout, bool) {
+	group, ok := l.groups[noTag]
+	if !ok {
+		return nil, false
+	}
+
+	if l.layouts == nil {
+		l.layouts = make(map[int]*groupLayout)
+	}
+	layout, ok := l.layouts[noTag]
+	if !ok {
+		layout = newGroupLayout(group)
+		l.layouts[noTag] = layout
+	}
+
+	return layout, true
+}
+
+// collectLayout adds the tags and the groups of the items and their components.
+func collectLayout(items fix.Items, tags map[int]bool, groups map[int]*fix.Group) {
+	for _, item := range items {
+		switch el := item.(type) {
+		case *fix.KeyValue:
+			if tag, err := strconv.Atoi(el.Key); err == nil {
+				tags[tag] = true
+			}
+
+		case *fix.Group:
+			if tag, err := strconv.Atoi(el.NoTag()); err == nil {
+				tags[tag] = true
+				groups[tag] = el
+			}
+
+		case *fix.Component:
+			collectLayout(el.Items(), tags, groups)
+		}
+	}
+}
+
+// indexGroups locates the entries of the repeating groups of the items in a single pass over the fields.
+// The fields outside groups might appear in any order, while the entries are expected
+// to follow their NumInGroup field.
+func (s *state) indexGroups(items fix.Items) error {
+	root := &groupLayout{tags: make(map[int]bool), groups: make(map[int]*fix.Group)}
+	collectLayout(items, root.tags, root.groups)
+	if len(root.groups) == 0 {
+		return nil
+	}
+
+	for i := 0; i < len(s.tokens); {
+		layout, ok := root.nested(s.tokens[i].Tag)
+		if !ok {
+			i++
+			continue
+		}
+
+		var err error
+		if i, err = s.indexGroup(layout, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexGroup locates the entries of a group by the index of its NumInGroup field
+// and returns the index of the first field following the group.
+//
+// Each entry starts with the field following the NumInGroup field and lasts
+// while the fields belong to the group and do not repeat, so the optional fields might be absent.
+// The entries of nested groups are located recursively.
+func (s *state) indexGroup(layout *groupLayout, noIndex int) (int, error) {
+	noKv := fix.NewKeyValue(layout.noTag, &fix.Int{})
+	if err := s.setValue(noIndex, noKv); err != nil {
+		return 0, fmt.Errorf("could not unmarshal group: %w", err)
+	}
+	cnt := noKv.Value.Value().(int)
+	if cnt < 0 {
+		return 0, NewError(ErrIncorrectNumInGroupCount, layout.noTag, fmt.Errorf("negative items count: %d", cnt))
+	}
+
+	entries := make([]int, 0, min(cnt, len(s.tokens))+1)
+	pos := noIndex + 1
+
+	// The first field of an entry delimits the entries, it could not be a nested group.
+	// The fields following an empty group belong to its parent.
+	if cnt > 0 && pos < len(s.tokens) && layout.tags[s.tokens[pos].Tag] && layout.groups[s.tokens[pos].Tag] == nil {
+		delimiter := s.tokens[pos].Tag
+
+		for pos < len(s.tokens) && s.tokens[pos].Tag == delimiter {
+			entryStart := pos
+			entries = append(entries, pos)
+			s.owners[pos] = noIndex
+			pos++
+
+			for pos < len(s.tokens) {
+				tag := s.tokens[pos].Tag
+				// The repeated field belongs to the parent of the group.
+				if tag == delimiter || !layout.tags[tag] || s.find(tag, noIndex, entryStart, pos) != -1 {
+					break
+				}
+				s.owners[pos] = noIndex
+
+				nested, ok := layout.nested(tag)
+				if !ok {
+					pos++
+					continue
+				}
+
+				var err error
+				if pos, err = s.indexGroup(nested, pos); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+
+	if len(entries) != cnt {
+		return 0, NewError(ErrIncorrectNumInGroupCount, layout.noTag,
+			fmt.Errorf("wrong items count: %d != %d", cnt, len(entries)))
+	}
+
+	s.entries[noIndex] = append(entries, pos)
+
+	return pos, nil
+}
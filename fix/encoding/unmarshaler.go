@@ -2,8 +2,12 @@ package encoding
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
+	"sync"
 
 	"github.com/b2broker/simplefix-go/fix"
 	"github.com/b2broker/simplefix-go/session/messages"
@@ -13,9 +17,22 @@ type Validator interface {
 	Do(msg messages.Builder) error
 }
 
+// RawValidator is a Validator checking the message data before unmarshalling,
+// so it could detect issues which are lost after unmarshalling, e.g. the order and duplicates of the fields.
+// The DefaultUnmarshaller calls DoRaw instead of Do and skips its own checks of the undefined and duplicate tags.
+type RawValidator interface {
+	Validator
+	DoRaw(msg messages.Builder, data []byte) error
+}
+
 type DefaultUnmarshaller struct {
 	Validator Validator
 	Strict    bool
+
+	// CheckUndefinedTags rejects the tags which are not defined for the message type and the tags
+	// appearing more than once outside repeating groups. It is disabled by default,
+	// so the custom tags of the venues are ignored.
+	CheckUndefinedTags bool
 }
 
 func NewDefaultUnmarshaller(strict bool) *DefaultUnmarshaller {
@@ -23,15 +40,35 @@ func NewDefaultUnmarshaller(strict bool) *DefaultUnmarshaller {
 }
 
 func (u DefaultUnmarshaller) Unmarshal(msg messages.Builder, d []byte) error {
-	if err := validateRaw(msg, d, u.Strict); err != nil {
+	s, err := newState(msg.Items(), d, u.Strict)
+	if err != nil {
 		return err
 	}
+	defer s.release()
 
-	err := unmarshalItems(msg.Items(), d, u.Strict)
-	if err != nil {
+	if err = s.validateRaw(msg); err != nil {
+		return err
+	}
+
+	rawValidator, ok := u.Validator.(RawValidator)
+	if ok {
+		if err = rawValidator.DoRaw(msg, d); err != nil {
+			return err
+		}
+	} else if u.CheckUndefinedTags {
+		if err = s.checkTags(msg.Items()); err != nil {
+			return err
+		}
+	}
+
+	if err = s.unmarshalItems(msg.Items()); err != nil {
 		return err
 	}
 
+	if ok {
+		return nil
+	}
+
 	return u.Validator.Do(msg)
 }
 
@@ -44,125 +81,202 @@ func Unmarshal(msg messages.Builder, d []byte) error {
 // unmarshalItems parses the FIX message data stored as a byte array
 // and writes it into the Items object.
 func unmarshalItems(msg fix.Items, data []byte, strict bool) error {
-	s := newState(data, strict)
+	s, err := newState(msg, data, strict)
+	if err != nil {
+		return err
+	}
+	defer s.release()
 
-	for _, item := range msg {
-		err := s.unmarshal(s.data, item)
-		if err != nil {
-			return fmt.Errorf("could not unmarshal items: %s", err)
-		}
+	return s.unmarshalItems(msg)
+}
+
+// checkTags looks for the tags which are not defined for the message type
+// and for the tags appearing more than once outside repeating groups.
+func checkTags(msg fix.Items, data []byte) error {
+	s, err := newState(msg, data, true)
+	if err != nil {
+		return err
 	}
+	defer s.release()
 
-	return nil
+	return s.checkTags(msg)
 }
 
+// state holds the fields of a message which is tokenized once,
+// so the items are unmarshalled without scanning and copying the data again.
 type state struct {
 	data   []byte
 	strict bool
+	tokens []fix.Token
+
+	// owners are the indexes of the NumInGroup fields of the group entries containing the fields,
+	// or -1 for the fields outside groups.
+	owners []int
+	// entries are the indexes of the group entries by the indexes of their NumInGroup fields.
+	// The last index is the end of the group.
+	entries map[int][]int
+	// positions are the ascending indexes of the fields by their tags.
+	positions map[int][]int
+	// tags are the tags indexed in positions by the current message.
+	tags []int
 }
 
-func newState(data []byte, strict bool) *state {
-	return &state{data: data, strict: strict}
+var statePool = sync.Pool{
+	New: func() any {
+		return &state{
+			tokens:    make([]fix.Token, 0, 64),
+			owners:    make([]int, 0, 64),
+			entries:   make(map[int][]int),
+			positions: make(map[int][]int),
+			tags:      make([]int, 0, 64),
+		}
+	},
 }
 
-// scanKeyValue parses the message data related to key-value pairs
-// and writes it into KeyValue objects.
-func (s *state) scanKeyValue(data []byte, el *fix.KeyValue) error {
-	q := bytes.Join([][]byte{[]byte(el.Key), {'='}}, nil)
-	var keyIndex int
-	if bytes.Equal(data[:len(q)], q) {
-		keyIndex = 0
-	} else {
-		ks := bytes.Join([][]byte{fix.Delimiter, []byte(el.Key), {'='}}, nil)
-		keyIndex = bytes.Index(data, ks)
-		if keyIndex == -1 {
-			return nil
+// newState tokenizes the data, locating the values of the data fields of the msg template by their length.
+func newState(msg fix.Items, data []byte, strict bool) (*state, error) {
+	s := statePool.Get().(*state)
+	s.data = data
+	s.strict = strict
+
+	var err error
+	s.tokens, err = fix.TokenizeWith(data, s.tokens[:0], fix.DataLengthTags(msg))
+	if err != nil {
+		s.release()
+		return nil, NewError(ErrInvalidTagNumber, "", err)
+	}
+
+	s.owners = s.owners[:0]
+	for i, token := range s.tokens {
+		s.owners = append(s.owners, -1)
+
+		indexes := s.positions[token.Tag]
+		if len(indexes) == 0 {
+			s.tags = append(s.tags, token.Tag)
 		}
-		keyIndex++ // An SOH character that is used to delimit key-value groups.
+		s.positions[token.Tag] = append(indexes, i)
 	}
 
-	from := keyIndex + len(q)
+	return s, nil
+}
 
-	d := data[from:]
+// release returns the state to the pool. It should not be used after that.
+func (s *state) release() {
+	s.data = nil
+	clear(s.entries)
+	// Only the indexes of the current message are truncated, so the state is reset in proportion to its size
+	// and the memory of the indexes is reused by the next message.
+	for _, tag := range s.tags {
+		s.positions[tag] = s.positions[tag][:0]
+	}
+	s.tags = s.tags[:0]
+	statePool.Put(s)
+}
 
-	end := bytes.Index(d, []byte{1})
-	if end == -1 {
-		end = len(d)
+// find returns the index of the first field with the tag between the from and to indexes, or -1.
+// Only the fields belonging to the owner are considered, so the fields of nested groups are skipped.
+func (s *state) find(tag, owner, from, to int) int {
+	indexes := s.positions[tag]
+	for _, i := range indexes[sort.SearchInts(indexes, from):] {
+		if i >= to {
+			break
+		}
+		if s.owners[i] == owner {
+			return i
+		}
 	}
-	v := d[:end]
-	err := el.FromBytes(v)
-	if err != nil {
-		return fmt.Errorf("could not unmarshal element %s into %s: %s", el.Key, string(v), err)
+
+	return -1
+}
+
+// value returns the value of the field by its index without copying it.
+func (s *state) value(i int) []byte {
+	return s.data[s.tokens[i].Start:s.tokens[i].End]
+}
+
+func (s *state) unmarshalItems(msg fix.Items) error {
+	if err := s.indexGroups(msg); err != nil {
+		return fmt.Errorf("could not unmarshal items: %w", err)
+	}
+
+	for _, item := range msg {
+		err := s.unmarshal(-1, 0, len(s.tokens), item)
+		if err != nil {
+			return fmt.Errorf("could not unmarshal items: %w", err)
+		}
 	}
 
 	return nil
 }
 
-// splitGroup splits message parts which are recognized to be separate groups
-// to create individual group items. The function distinguishes repeated parts and detects
-// identical tags without repeating key-value groups.
-func splitGroup(line []byte, firstTag []byte) (array [][]byte) {
-	ok := true
-	var index int
-	for ok {
-		next := bytes.Index(line[1:], firstTag)
-		if next == -1 {
-			index = len(line)
-			ok = false
-		} else {
-			index = next + 1
+// unmarshalKeyValue looks for the field of the owner between the from and to indexes
+// and writes its value into a KeyValue object.
+func (s *state) unmarshalKeyValue(owner, from, to int, el *fix.KeyValue) error {
+	tag, err := strconv.Atoi(el.Key)
+	if err != nil {
+		return nil
+	}
+	i := s.find(tag, owner, from, to)
+	if i == -1 {
+		return nil
+	}
+
+	return s.setValue(i, el)
+}
+
+// setValue writes the value of the field by its index into a KeyValue object.
+func (s *state) setValue(i int, el *fix.KeyValue) error {
+	v := s.value(i)
+	if len(v) == 0 && s.strict {
+		return NewError(ErrTagSpecifiedWithoutValue, el.Key, nil)
+	}
+
+	err := el.FromBytes(v)
+	if errors.Is(err, fix.ErrInvalidEnumValue) {
+		// The undefined values are kept unless the strict mode is on.
+		if !s.strict {
+			return nil
 		}
-		array = append(array, line[:index])
-		line = line[next+1:]
+		return NewError(ErrIncorrectValue, el.Key, err)
+	}
+	if err != nil && fix.IsRedacted(el.Key) {
+		// The conversion error might contain the value as well.
+		return NewError(ErrIncorrectDataFormat, el.Key,
+			fmt.Errorf("could not unmarshal element %s into %s", el.Key, fix.RedactedValue))
+	}
+	if err != nil {
+		return NewError(ErrIncorrectDataFormat, el.Key,
+			fmt.Errorf("could not unmarshal element %s into %s: %w", el.Key, string(v), err))
 	}
-	return array
+
+	return nil
 }
 
-// unmarshal traverses through a fixItem and parses its byte data,
-// which is then assigned to the fixItem. A fixItem is a constructed FIX message (or its portion)
+// unmarshal traverses through a fixItem and parses the fields of the owner between the from and to indexes,
+// which are then assigned to the fixItem. A fixItem is a constructed FIX message (or its portion)
 // with assigned KeyValue, Component and Group items.
-func (s *state) unmarshal(data []byte, fixItem fix.Item) error {
+func (s *state) unmarshal(owner, from, to int, fixItem fix.Item) error {
 	switch el := fixItem.(type) {
 	case *fix.KeyValue:
-		return s.scanKeyValue(data, el)
+		return s.unmarshalKeyValue(owner, from, to, el)
 
 	case *fix.Group:
-		noTag := el.NoTag()
-
-		noKv := fix.NewKeyValue(noTag, &fix.Int{})
-		err := s.unmarshal(data, noKv)
+		tag, err := strconv.Atoi(el.NoTag())
 		if err != nil {
-			return fmt.Errorf("could not unmarshal group: %s", err)
-		}
-
-		cnt := noKv.Value.Value().(int)
-		startNoTag := bytes.Index(data, append([]byte(noKv.Key), '='))
-		if startNoTag == -1 {
 			return nil
 		}
-
-		startFirstFieldTag := bytes.Index(data[startNoTag:], fix.Delimiter)
-		arrayString := data[startNoTag+startFirstFieldTag:]
-		endFirstFieldTag := bytes.Index(arrayString, []byte{'='})
-
-		firstTag := arrayString[:endFirstFieldTag+1]
-		arrayItems := splitGroup(arrayString, firstTag)
-
-		if len(arrayItems) == 0 {
-			return fmt.Errorf("no elements found in the array")
-		}
-
-		if len(arrayItems) != cnt {
-			return fmt.Errorf("wrong items count: %d != %d", cnt, len(arrayItems))
+		noIndex := s.find(tag, owner, from, to)
+		if noIndex == -1 {
+			return nil
 		}
 
-		for i := 0; i < cnt; i++ {
+		entries := s.entries[noIndex]
+		for i := 0; i+1 < len(entries); i++ {
 			entry := el.AsTemplate()
-
 			for _, item := range entry {
-				err = s.unmarshal(arrayItems[i], item)
+				err = s.unmarshal(noIndex, entries[i], entries[i+1], item)
 				if err != nil {
-					return fmt.Errorf("could not unmarshal group item: %s", err)
+					return fmt.Errorf("could not unmarshal group item: %w", err)
 				}
 			}
 			el.AddEntry(entry)
@@ -171,9 +285,9 @@ func (s *state) unmarshal(data []byte, fixItem fix.Item) error {
 	case *fix.Component:
 		component := el.Items()
 		for _, item := range component {
-			err := s.unmarshal(data, item)
+			err := s.unmarshal(owner, from, to, item)
 			if err != nil {
-				return fmt.Errorf("could not unmarshal component: %s", err)
+				return fmt.Errorf("could not unmarshal component: %w", err)
 			}
 		}
 
@@ -184,44 +298,121 @@ func (s *state) unmarshal(data []byte, fixItem fix.Item) error {
 	return nil
 }
 
-func validateRaw(msg messages.Builder, d []byte, strict bool) error {
-	bs := fix.NewKeyValue(msg.BeginStringTag(), fix.NewRaw(nil))
-	bl := fix.NewKeyValue(msg.BodyLengthTag(), fix.NewRaw(nil))
-	cs := fix.NewKeyValue(msg.CheckSumTag(), fix.NewRaw(nil))
+func (s *state) checkTags(msg fix.Items) error {
+	// The values show whether the tags belong to repeating groups and might appear more than once.
+	tags := make(map[int]bool)
+	collectTags(msg, tags, false)
 
-	if err := unmarshalItems(fix.Items{bs, bl, cs}, d, strict); err != nil {
-		return err
+	seen := make(map[int]bool, len(s.tokens))
+	for _, token := range s.tokens {
+		repeatable, ok := tags[token.Tag]
+		if !ok {
+			return NewError(ErrTagNotDefinedForMessageType, strconv.Itoa(token.Tag), nil)
+		}
+		if seen[token.Tag] && !repeatable {
+			return NewError(ErrTagAppearsMoreThanOnce, strconv.Itoa(token.Tag), nil)
+		}
+		seen[token.Tag] = true
+	}
+
+	return nil
+}
+
+// collectTags adds the tags of the items, their components and groups to the map.
+func collectTags(items fix.Items, tags map[int]bool, inGroup bool) {
+	for _, item := range items {
+		switch el := item.(type) {
+		case *fix.KeyValue:
+			if tag, err := strconv.Atoi(el.Key); err == nil {
+				tags[tag] = tags[tag] || inGroup
+			}
+
+		case *fix.Group:
+			if tag, err := strconv.Atoi(el.NoTag()); err == nil {
+				tags[tag] = tags[tag] || inGroup
+			}
+			collectTags(el.AsTemplate(), tags, true)
+
+		case *fix.Component:
+			collectTags(el.Items(), tags, inGroup)
+		}
+	}
+}
+
+// field returns the index and the value of the first field with the tag.
+// The empty values are not allowed in the strict mode.
+func (s *state) field(key string) (int, []byte, error) {
+	tag, err := strconv.Atoi(key)
+	if err != nil {
+		return -1, nil, nil
+	}
+	i := s.find(tag, -1, 0, len(s.tokens))
+	if i == -1 {
+		return -1, nil, nil
+	}
+
+	v := s.value(i)
+	if len(v) == 0 && s.strict {
+		return -1, nil, NewError(ErrTagSpecifiedWithoutValue, key, nil)
+	}
+
+	return i, v, nil
+}
+
+// fieldLength returns the length of the field with its tag and value not including the delimiter.
+func fieldLength(key string, value []byte) int {
+	if len(value) == 0 {
+		return 0
+	}
+
+	return len(key) + 1 + len(value)
+}
+
+func (s *state) validateRaw(msg messages.Builder) error {
+	_, bs, err := s.field(msg.BeginStringTag())
+	if err != nil {
+		return fmt.Errorf("could not unmarshal items: %w", err)
+	}
+	_, bl, err := s.field(msg.BodyLengthTag())
+	if err != nil {
+		return fmt.Errorf("could not unmarshal items: %w", err)
+	}
+	_, cs, err := s.field(msg.CheckSumTag())
+	if err != nil {
+		return fmt.Errorf("could not unmarshal items: %w", err)
 	}
 
 	blVal := fix.NewInt(0)
-	if err := blVal.FromBytes(bl.Load().ToBytes()); err != nil {
-		return fmt.Errorf("invalid body length: %w", err)
+	if err = blVal.FromBytes(bl); err != nil {
+		return NewError(ErrIncorrectDataFormat, msg.BodyLengthTag(), fmt.Errorf("%w: %w", fix.ErrInvalidBodyLength, err))
 	}
 	if blVal.IsNull() {
-		return fmt.Errorf("invalid body length: value is empty")
+		return NewError(ErrTagSpecifiedWithoutValue, msg.BodyLengthTag(), fix.ErrInvalidBodyLength)
 	}
 	bodyLength := blVal.Value().(int)
 
-	offset := len(bs.ToBytes()) + 1 // extra delimiter
-	offset += len(bl.ToBytes()) + 1 // extra delimiter
-	length := len(d) - offset
-	length -= len(cs.ToBytes()) + 1 // extra delimiter
+	offset := fieldLength(msg.BeginStringTag(), bs) + 1 // extra delimiter
+	offset += fieldLength(msg.BodyLengthTag(), bl) + 1  // extra delimiter
+	length := len(s.data) - offset
+	length -= fieldLength(msg.CheckSumTag(), cs) + 1 // extra delimiter
 
 	if length != bodyLength {
-		return fmt.Errorf("an invalid body length; specified: %d, required: %d",
+		return NewError(ErrIncorrectValue, msg.BodyLengthTag(), fmt.Errorf("%w; specified: %d, required: %d",
+			fix.ErrInvalidBodyLength,
 			bodyLength,
 			length,
-		)
+		))
 	}
 
-	checkSum := fix.CalcCheckSumOptimized(d[:offset+length-1])
+	checkSum := fix.CalcCheckSumOptimized(s.data[:offset+length-1])
 
-	if !bytes.Equal(cs.Load().ToBytes(), checkSum) {
-		return fmt.Errorf(
-			"an invalid checksum; specified: %s, required: %s",
-			string(cs.Load().ToBytes()),
+	if !bytes.Equal(cs, checkSum) {
+		return NewError(ErrIncorrectValue, msg.CheckSumTag(), fmt.Errorf(
+			"%w; specified: %s, required: %s",
+			fix.ErrInvalidCheckSum,
+			string(cs),
 			string(checkSum),
-		)
+		))
 	}
 
 	return nil
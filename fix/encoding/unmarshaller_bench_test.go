@@ -0,0 +1,78 @@
+package encoding
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/b2broker/simplefix-go/fix"
+	fixgen "github.com/b2broker/simplefix-go/tests/fix44"
+)
+
+// benchmarkMessage returns a MarketDataIncrementalRefresh message with the specified number of entries.
+func benchmarkMessage(entries int) []byte {
+	var body strings.Builder
+	body.WriteString("35=X\x0149=sender\x0156=target\x0134=1\x0152=20210706-19:06:12.838\x01262=req\x01")
+	body.WriteString("268=" + strconv.Itoa(entries) + "\x01")
+	for i := 0; i < entries; i++ {
+		fmt.Fprintf(&body, "279=0\x01269=%d\x01278=ID%d\x0155=EUR/USD\x01270=1.%04d\x0115=EUR\x01271=1000000\x01"+
+			"272=20210706\x01273=19:06:12.838\x01", i%2, i, i)
+	}
+
+	msg := "8=FIX.4.4\x019=" + strconv.Itoa(body.Len()) + "\x01" + body.String()
+	return []byte(msg + "10=" + string(fix.CalcCheckSumOptimized([]byte(msg[:len(msg)-1]))) + "\x01")
+}
+
+// BenchmarkUnmarshal only uses the API available before the tokenizing unmarshaller,
+// so the file could be copied to an earlier revision to compare the results with benchstat.
+func BenchmarkUnmarshal(b *testing.B) {
+	for _, entries := range []int{1, 10, 100} {
+		data := benchmarkMessage(entries)
+
+		msg := fixgen.NewMarketDataIncrementalRefresh()
+		if err := Unmarshal(msg, data); err != nil {
+			b.Fatalf("unexpected error: %s", err)
+		}
+		if msg.MDEntriesGrp().Entries()[entries-1].MDEntryID() != "ID"+strconv.Itoa(entries-1) {
+			b.Fatalf("unexpected message: %s", showDelimiter(msg.Items().ToBytes()))
+		}
+
+		b.Run(fmt.Sprintf("items/%d", entries), func(b *testing.B) {
+			benchmarkUnmarshal(b, data, func(msg *fixgen.MarketDataIncrementalRefresh) error {
+				return unmarshalItems(msg.Items(), data, true)
+			})
+		})
+
+		b.Run(fmt.Sprintf("validated/%d", entries), func(b *testing.B) {
+			benchmarkUnmarshal(b, data, func(msg *fixgen.MarketDataIncrementalRefresh) error {
+				return Unmarshal(msg, data)
+			})
+		})
+	}
+}
+
+// benchmarkUnmarshal times the unmarshalling of the data into the messages,
+// which are made in batches with the timer stopped, so their construction is not measured.
+func benchmarkUnmarshal(b *testing.B, data []byte, unmarshal func(msg *fixgen.MarketDataIncrementalRefresh) error) {
+	const batch = 1000
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+
+	msgs := make([]*fixgen.MarketDataIncrementalRefresh, 0, batch)
+	for i := 0; i < b.N; i += len(msgs) {
+		b.StopTimer()
+		msgs = msgs[:0]
+		for j := i; j < b.N && len(msgs) < batch; j++ {
+			msgs = append(msgs, fixgen.NewMarketDataIncrementalRefresh())
+		}
+		b.StartTimer()
+
+		for _, msg := range msgs {
+			if err := unmarshal(msg); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
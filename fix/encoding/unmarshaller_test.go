@@ -2,8 +2,14 @@ package encoding
 
 import (
 	"bytes"
-	"github.com/b2broker/simplefix-go/fix"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/b2broker/simplefix-go/fix"
+	fixgen "github.com/b2broker/simplefix-go/tests/fix44"
 )
 
 const visibleDelimiter = "|"
@@ -135,3 +141,332 @@ func TestUnmarshalItems(t *testing.T) {
 func showDelimiter(in []byte) []byte {
 	return bytes.ReplaceAll(in, fix.Delimiter, []byte(visibleDelimiter))
 }
+
+func TestUnmarshalItemsRedactedError(t *testing.T) {
+	raw := []byte("8=FIX.4.4\x0135=A\x01554=secret\x0110=000\x01")
+
+	err := unmarshalItems(fix.Items{
+		&fix.KeyValue{Key: "8", Value: &fix.String{}},
+		&fix.KeyValue{Key: "35", Value: &fix.String{}},
+		&fix.KeyValue{Key: "554", Value: &fix.Int{}},
+		&fix.KeyValue{Key: "10", Value: &fix.String{}},
+	}, raw, false)
+	if err == nil {
+		t.Fatalf("an error is expected")
+	}
+	if bytes.Contains([]byte(err.Error()), []byte("secret")) {
+		t.Fatalf("the password is not redacted: %s", err)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	newItems := func() fix.Items {
+		return fix.Items{
+			&fix.KeyValue{Key: "8", Value: &fix.String{}},
+			&fix.KeyValue{Key: "35", Value: &fix.String{}},
+			&fix.KeyValue{Key: "34", Value: &fix.Int{}},
+			fix.NewGroup("384", &fix.KeyValue{Key: "372", Value: &fix.String{}}),
+			&fix.KeyValue{Key: "10", Value: &fix.String{}},
+		}
+	}
+
+	testCases := map[string]struct {
+		raw    string
+		reason error
+		tag    string
+	}{
+		"incorrect data format": {
+			raw:    "8=FIX.4.4\x0135=A\x0134=x\x0110=000\x01",
+			reason: ErrIncorrectDataFormat,
+			tag:    "34",
+		},
+		"tag specified without a value": {
+			raw:    "8=FIX.4.4\x0135=A\x0134=\x0110=000\x01",
+			reason: ErrTagSpecifiedWithoutValue,
+			tag:    "34",
+		},
+		"incorrect group count": {
+			raw:    "8=FIX.4.4\x0135=A\x0134=1\x01384=3\x01372=D\x01372=8\x0110=000\x01",
+			reason: ErrIncorrectNumInGroupCount,
+			tag:    "384",
+		},
+		"tag not defined for the message type": {
+			raw:    "8=FIX.4.4\x0135=A\x0134=1\x0158=text\x0110=000\x01",
+			reason: ErrTagNotDefinedForMessageType,
+			tag:    "58",
+		},
+		"tag appears more than once": {
+			raw:    "8=FIX.4.4\x0135=A\x0134=1\x0134=2\x0110=000\x01",
+			reason: ErrTagAppearsMoreThanOnce,
+			tag:    "34",
+		},
+	}
+
+	for name, testCase := range testCases {
+		items := newItems()
+		err := checkTags(items, []byte(testCase.raw))
+		if err == nil {
+			err = unmarshalItems(items, []byte(testCase.raw), true)
+		}
+
+		var fixErr *Error
+		if !errors.As(err, &fixErr) {
+			t.Fatalf("unexpected error in case '%s': %v", name, err)
+		}
+		if !errors.Is(err, testCase.reason) || fixErr.Tag != testCase.tag {
+			t.Fatalf("unexpected error in case '%s': %s", name, err)
+		}
+	}
+
+	raw := []byte("8=FIX.4.4\x0135=A\x0134=1\x01384=2\x01372=D\x01372=8\x0110=000\x01")
+	if err := checkTags(newItems(), raw); err != nil {
+		t.Fatalf("unexpected error for repeated group members: %s", err)
+	}
+}
+
+func TestUnmarshalGroups(t *testing.T) {
+	// The items contain the Text field both outside and inside the groups,
+	// the nested groups are followed by the fields of their parent entries.
+	newItems := func() fix.Items {
+		return fix.Items{
+			&fix.KeyValue{Key: "35", Value: &fix.String{}},
+			fix.NewGroup("1000",
+				&fix.KeyValue{Key: "1001", Value: &fix.String{}},
+				fix.NewGroup("1002",
+					&fix.KeyValue{Key: "1003", Value: &fix.String{}},
+					fix.NewComponent(
+						fix.NewGroup("1004",
+							&fix.KeyValue{Key: "1005", Value: &fix.String{}},
+							&fix.KeyValue{Key: "58", Value: &fix.String{}},
+						),
+					),
+					&fix.KeyValue{Key: "1006", Value: &fix.String{}},
+				),
+				&fix.KeyValue{Key: "58", Value: &fix.String{}},
+			),
+			&fix.KeyValue{Key: "58", Value: &fix.String{}},
+			&fix.KeyValue{Key: "10", Value: &fix.String{}},
+		}
+	}
+
+	testCases := map[string]struct {
+		raw    string
+		expect string
+	}{
+		"flat": {
+			raw:    "35=A|1000=2|1001=a|58=x|1001=b|58=y|58=top|10=000|",
+			expect: "35=A|1000=2|1001=a|58=x|1001=b|58=y|58=top|10=000",
+		},
+		"nested": {
+			raw: "35=A|1000=2|1001=a|1002=2|1003=b|1004=2|1005=c|58=x|1005=d|1006=e|1003=f|58=y|" +
+				"1001=g|1002=1|1003=h|1004=1|1005=i|58=top|10=000|",
+			expect: "35=A|1000=2|1001=a|1002=2|1003=b|1004=2|1005=c|58=x|1005=d|1006=e|1003=f|58=y|" +
+				"1001=g|1002=1|1003=h|1004=1|1005=i|58=top|10=000",
+		},
+		"sparse entries": {
+			raw:    "35=A|1000=3|1001=a|1001=b|1002=1|1003=c|1001=d|58=x|10=000|",
+			expect: "35=A|1000=3|1001=a|1001=b|1002=1|1003=c|1001=d|58=x|10=000",
+		},
+		"values containing tags": {
+			raw:    "35=A|1000=2|1001=1001=a|58=1001=b|1001=c|58=top|10=000|",
+			expect: "35=A|1000=2|1001=1001=a|58=1001=b|1001=c|58=top|10=000",
+		},
+		"fields outside groups in any order": {
+			raw:    "35=A|58=top|1000=1|1001=a|10=000|",
+			expect: "35=A|1000=1|1001=a|58=top|10=000",
+		},
+		"empty group": {
+			raw:    "35=A|1000=0|58=top|10=000|",
+			expect: "35=A|58=top|10=000",
+		},
+	}
+
+	for name, testCase := range testCases {
+		items := newItems()
+		raw := bytes.ReplaceAll([]byte(testCase.raw), []byte(visibleDelimiter), fix.Delimiter)
+		if err := unmarshalItems(items, raw, true); err != nil {
+			t.Fatalf("unexpected error in case '%s': %s", name, err)
+		}
+
+		res := showDelimiter(items.ToBytes())
+		if string(res) != testCase.expect {
+			t.Fatalf("unexpected result in case '%s': %s", name, res)
+		}
+	}
+
+	for name, raw := range map[string]string{
+		"missing entries":        "35=A|1000=3|1001=a|1001=b|58=top|10=000|",
+		"extra entries":          "35=A|1000=1|1001=a|1001=b|10=000|",
+		"missing nested entries": "35=A|1000=1|1001=a|1002=2|1003=b|10=000|",
+		"unknown entry fields":   "35=A|1000=1|1003=a|10=000|",
+		"negative count":         "35=A|1000=-1|10=000|",
+	} {
+		err := unmarshalItems(newItems(), bytes.ReplaceAll([]byte(raw), []byte(visibleDelimiter), fix.Delimiter), true)
+		if !errors.Is(err, ErrIncorrectNumInGroupCount) {
+			t.Fatalf("unexpected error in case '%s': %v", name, err)
+		}
+	}
+}
+
+func TestUnmarshalNestedGroups(t *testing.T) {
+	body := "35=V|49=sender|56=target|34=1|52=20210706-19:06:12.838|262=req|263=1|264=0|" +
+		"267=2|269=0|269=1|146=3|55=EUR/USD|454=2|455=a|456=1|455=b|456=2|55=USD/JPY|55=GBP/USD|454=1|455=c|"
+	body = string(bytes.ReplaceAll([]byte(body), []byte(visibleDelimiter), fix.Delimiter))
+	data := "8=FIX.4.4\x019=" + strconv.Itoa(len(body)) + "\x01" + body
+	data += "10=" + string(fix.CalcCheckSumOptimized([]byte(data[:len(data)-1]))) + "\x01"
+
+	msg := fixgen.NewMarketDataRequest()
+	if err := Unmarshal(msg, []byte(data)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var symbols, altIDs []string
+	for _, entry := range msg.RelatedSymGrp().Entries() {
+		symbols = append(symbols, entry.Instrument().Symbol())
+		for _, altID := range entry.Instrument().SecurityAltIDGrp().Entries() {
+			altIDs = append(altIDs, entry.Instrument().Symbol()+":"+altID.SecurityAltID())
+		}
+	}
+	if len(msg.MDEntryTypesGrp().Entries()) != 2 ||
+		fmt.Sprint(symbols) != "[EUR/USD USD/JPY GBP/USD]" ||
+		fmt.Sprint(altIDs) != "[EUR/USD:a EUR/USD:b GBP/USD:c]" {
+		t.Fatalf("unexpected message: %s", showDelimiter(msg.Items().ToBytes()))
+	}
+}
+
+func TestUnmarshalDataFields(t *testing.T) {
+	// The RawData field is parsed using its length, though it is generated as a string by default.
+	logon := fixgen.CreateLogon("0", 30).SetRawDataLength(6).SetRawData("a\x0110=b")
+	logon.HeaderBuilder().SetFieldSenderCompID("sender").SetFieldTargetCompID("target").
+		SetFieldMsgSeqNum(1).SetFieldSendingTime("20210706-19:06:12.838")
+
+	data, err := logon.ToBytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Contains(data, []byte("\x0195=6\x0196=a\x0110=b\x01")) {
+		t.Fatalf("unexpected message: %q", data)
+	}
+
+	res := fixgen.NewLogon()
+	if err = Unmarshal(res, data); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res.RawData() != "a\x0110=b" || res.RawDataLength() != 6 {
+		t.Fatalf("unexpected raw data: %q", res.RawData())
+	}
+}
+
+func TestUnmarshalEnums(t *testing.T) {
+	logon := fixgen.CreateLogon("9", 30)
+	logon.HeaderBuilder().SetFieldSenderCompID("sender").SetFieldTargetCompID("target").
+		SetFieldMsgSeqNum(1).SetFieldSendingTime("20210706-19:06:12.838")
+
+	data, err := logon.ToBytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res := fixgen.NewLogon()
+	if err = NewDefaultUnmarshaller(true).Unmarshal(res, data); !errors.Is(err, ErrIncorrectValue) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res = fixgen.NewLogon()
+	if err = NewDefaultUnmarshaller(false).Unmarshal(res, data); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res.EncryptMethod() != "9" || res.EncryptMethod().IsValid() {
+		t.Fatalf("unexpected value: %s", res.EncryptMethod())
+	}
+
+	logon.SetEncryptMethod(fixgen.EnumEncryptMethodNoneother)
+	if data, err = logon.ToBytes(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res = fixgen.NewLogon()
+	if err = NewDefaultUnmarshaller(true).Unmarshal(res, data); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res.EncryptMethod() != fixgen.EnumEncryptMethodNoneother || res.EncryptMethod().String() != "NONEOTHER" {
+		t.Fatalf("unexpected value: %s", res.EncryptMethod())
+	}
+}
+
+func makeTestHeartbeat(t *testing.T, body ...fix.Item) []byte {
+	heartbeat := fixgen.CreateHeartbeat()
+	heartbeat.HeaderBuilder().SetFieldSenderCompID("sender").SetFieldTargetCompID("target").
+		SetFieldMsgSeqNum(1).SetFieldSendingTime("20210706-19:06:12.838")
+	heartbeat.SetBody(append(heartbeat.Body(), body...)...)
+
+	data, err := heartbeat.ToBytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return data
+}
+
+func TestUnmarshalIntegrityErrors(t *testing.T) {
+	data := makeTestHeartbeat(t)
+	bodyLength := string(data[bytes.Index(data, []byte("\x019=")) : bytes.Index(data, []byte("\x0135="))+1])
+	checkSum := string(data[len(data)-7:])
+
+	testCases := map[string]struct {
+		raw    string
+		reason error
+		tag    string
+		err    error
+	}{
+		"incorrect body length": {
+			raw:    strings.Replace(string(data), bodyLength, "\x019=1\x01", 1),
+			reason: ErrIncorrectValue,
+			tag:    "9",
+			err:    fix.ErrInvalidBodyLength,
+		},
+		"body length of an incorrect data format": {
+			raw:    strings.Replace(string(data), bodyLength, "\x019=x\x01", 1),
+			reason: ErrIncorrectDataFormat,
+			tag:    "9",
+			err:    fix.ErrInvalidBodyLength,
+		},
+		"incorrect checksum": {
+			raw:    strings.Replace(string(data), checkSum, "10=000\x01", 1),
+			reason: ErrIncorrectValue,
+			tag:    "10",
+			err:    fix.ErrInvalidCheckSum,
+		},
+	}
+
+	for name, testCase := range testCases {
+		err := Unmarshal(fixgen.NewHeartbeat(), []byte(testCase.raw))
+
+		var fixErr *Error
+		if !errors.As(err, &fixErr) {
+			t.Fatalf("unexpected error in case '%s': %v", name, err)
+		}
+		if !errors.Is(err, testCase.reason) || !errors.Is(err, testCase.err) || fixErr.Tag != testCase.tag {
+			t.Fatalf("unexpected error in case '%s': %s", name, err)
+		}
+	}
+}
+
+func TestUnmarshalCustomTags(t *testing.T) {
+	data := makeTestHeartbeat(t, fix.NewKeyValue("5001", fix.NewString("venue")))
+
+	// The custom tags of the venues are ignored in the strict mode.
+	if err := NewDefaultUnmarshaller(true).Unmarshal(fixgen.NewHeartbeat(), data); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := Unmarshal(fixgen.NewHeartbeat(), data); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	u := NewDefaultUnmarshaller(true)
+	u.CheckUndefinedTags = true
+	var unmarshalErr *Error
+	err := u.Unmarshal(fixgen.NewHeartbeat(), data)
+	if !errors.Is(err, ErrTagNotDefinedForMessageType) || !errors.As(err, &unmarshalErr) || unmarshalErr.Tag != "5001" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
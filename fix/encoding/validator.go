@@ -1,7 +1,7 @@
 package encoding
 
 import (
-	"fmt"
+	"github.com/b2broker/simplefix-go/fix"
 	"github.com/b2broker/simplefix-go/session/messages"
 )
 
@@ -13,17 +13,28 @@ func (v DefaultValidator) Do(msg messages.Builder) error {
 
 func (DefaultValidator) checkRequiredFields(msg messages.Builder) error {
 	if msg.BeginString().Value.IsNull() {
-		return fmt.Errorf("the required field value is empty: BeginString")
+		return NewError(ErrRequiredTagMissing, msg.BeginStringTag(), nil)
 	}
 	if msg.BodyLength() == 0 {
-		return fmt.Errorf("the required field value is empty: BodyLength")
+		return NewError(ErrRequiredTagMissing, msg.BodyLengthTag(), nil)
 	}
 	if msg.MsgType() == "" {
-		return fmt.Errorf("the required field value is empty: MsgType")
+		return NewError(ErrRequiredTagMissing, msgTypeTag(msg), nil)
 	}
 	if msg.CheckSum() == "" {
-		return fmt.Errorf("the required field value is empty: CheckSum")
+		return NewError(ErrRequiredTagMissing, msg.CheckSumTag(), nil)
 	}
 
 	return nil
 }
+
+// msgTypeTag returns the tag of the MsgType field, which follows the BeginString and BodyLength fields.
+func msgTypeTag(msg messages.Builder) string {
+	if items := msg.Items(); len(items) > 2 {
+		if kv, ok := items[2].(*fix.KeyValue); ok {
+			return kv.Key
+		}
+	}
+
+	return "35"
+}
@@ -0,0 +1,106 @@
+package fix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidEnumValue = errors.New("invalid enum value")
+
+// EnumType is implemented by the enums of generated code, which are named string types with constants.
+type EnumType interface {
+	~string
+	IsValid() bool
+}
+
+// Enum is the value of the fields with enumerated values, e.g. Enum[fix44.EnumTimeInForce].
+//
+// FromBytes returns ErrInvalidEnumValue for the values which are not defined for the field,
+// but the value is still assigned, so it is up to the caller whether to reject it.
+type Enum[T EnumType] struct {
+	value T
+	valid bool
+}
+
+func NewEnum[T EnumType](value T) *Enum[T] {
+	return &Enum[T]{value: value, valid: true}
+}
+
+// Set assigns the field value stored as the enum type or as a string.
+func (v *Enum[T]) Set(d interface{}) error {
+	switch res := d.(type) {
+	case nil:
+		v.valid = false
+	case T:
+		v.value, v.valid = res, true
+	case string:
+		v.value, v.valid = T(res), true
+	default:
+		return fmt.Errorf("could not convert %s to %T", d, v.value)
+	}
+
+	return nil
+}
+
+func (v *Enum[T]) ToBytes() []byte {
+	if !v.valid || v.value == "" {
+		return nil
+	}
+	return []byte(v.value)
+}
+
+func (v *Enum[T]) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid || v.value == "" {
+		return false
+	}
+	_, _ = writer.WriteString(string(v.value))
+	return true
+}
+
+func (v *Enum[T]) IsNull() bool {
+	return !v.valid
+}
+func (v *Enum[T]) IsEmpty() bool {
+	return !v.valid || v.value == ""
+}
+
+func (v *Enum[T]) Value() interface{} {
+	return v.value
+}
+
+func (v *Enum[T]) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	v.value, v.valid = T(d), true
+	if !v.value.IsValid() {
+		return fmt.Errorf("%w: %s", ErrInvalidEnumValue, d)
+	}
+
+	return nil
+}
+
+// String returns the value as it is written, while the String method of the enum type returns its description.
+func (v *Enum[T]) String() string {
+	return string(v.value)
+}
+
+func (v *Enum[T]) template() Value {
+	return &Enum[T]{}
+}
+
+// IsValidMultipleValue checks the space-separated values of MultipleValueString fields one by one.
+func IsValidMultipleValue(value string, isValid func(string) bool) bool {
+	values := strings.Fields(value)
+	for _, v := range values {
+		if !isValid(v) {
+			return false
+		}
+	}
+
+	return len(values) > 0
+}
@@ -0,0 +1,50 @@
+package fix
+
+import (
+	"errors"
+	"testing"
+)
+
+type testSide string
+
+func (v testSide) IsValid() bool {
+	return v == "1" || v == "2"
+}
+
+func TestEnum(t *testing.T) {
+	v := &Enum[testSide]{}
+	if !v.IsNull() || v.ToBytes() != nil {
+		t.Fatalf("the zero value should be null")
+	}
+
+	if err := v.Set(testSide("1")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if v.Value().(testSide) != "1" || string(v.ToBytes()) != "1" {
+		t.Fatalf("unexpected value: %v", v.Value())
+	}
+	if err := v.Set("2"); err != nil || v.Value().(testSide) != "2" {
+		t.Fatalf("unexpected value: %v, %v", v.Value(), err)
+	}
+	if err := v.Set(2); err == nil {
+		t.Fatalf("an error is expected")
+	}
+
+	if err := v.FromBytes([]byte("3")); !errors.Is(err, ErrInvalidEnumValue) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Value().(testSide) != "3" {
+		t.Fatalf("the invalid value should be kept: %v", v.Value())
+	}
+
+	template := NewKeyValue("54", v).AsTemplate().Value
+	if _, ok := template.(*Enum[testSide]); !ok || !template.IsNull() {
+		t.Fatalf("unexpected template: %T", template)
+	}
+
+	for value, expected := range map[string]bool{"1": true, "1 2": true, "1  2": true, "": false, "1 3": false} {
+		if IsValidMultipleValue(value, func(v string) bool { return testSide(v).IsValid() }) != expected {
+			t.Fatalf("unexpected validity of %q", value)
+		}
+	}
+}
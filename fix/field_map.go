@@ -0,0 +1,269 @@
+package fix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	ErrFieldNotFound     = errors.New("field not found")
+	ErrInvalidFieldValue = errors.New("invalid field value")
+)
+
+// RawField is a field of a FieldMap.
+// The entries of a repeating group are stored along with its NumInGroup field.
+type RawField struct {
+	Tag   int
+	Value []byte
+	Group []*FieldMap
+}
+
+// FieldMap is an ordered set of fields indexed by their tags.
+// It is used to handle messages without generated code.
+type FieldMap struct {
+	fields []*RawField
+	index  map[int]int
+}
+
+// NewFieldMap creates an empty FieldMap.
+func NewFieldMap() *FieldMap {
+	return &FieldMap{index: make(map[int]int)}
+}
+
+// Len returns the number of fields not including the fields of group entries.
+func (m *FieldMap) Len() int {
+	return len(m.fields)
+}
+
+// Fields returns the fields in their order.
+func (m *FieldMap) Fields() []*RawField {
+	return m.fields
+}
+
+// Has returns true if the field is present.
+func (m *FieldMap) Has(tag int) bool {
+	_, ok := m.index[tag]
+	return ok
+}
+
+// Get returns the value of a field. If the field appears more than once, the first value is returned.
+func (m *FieldMap) Get(tag int) ([]byte, bool) {
+	i, ok := m.index[tag]
+	if !ok {
+		return nil, false
+	}
+
+	return m.fields[i].Value, true
+}
+
+// GetBytes returns the value of a field or ErrFieldNotFound.
+func (m *FieldMap) GetBytes(tag int) ([]byte, error) {
+	value, ok := m.Get(tag)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrFieldNotFound, tag)
+	}
+
+	return value, nil
+}
+
+// GetString returns the value of a field as a string.
+func (m *FieldMap) GetString(tag int) (string, error) {
+	value, err := m.GetBytes(tag)
+	if err != nil {
+		return "", err
+	}
+
+	return string(value), nil
+}
+
+// GetInt returns the value of a field as an integer.
+func (m *FieldMap) GetInt(tag int) (int, error) {
+	value, err := m.GetBytes(tag)
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := bytesToInt(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %d: %s", ErrInvalidFieldValue, tag, err)
+	}
+
+	return v, nil
+}
+
+// GetFloat returns the value of a field as a float.
+func (m *FieldMap) GetFloat(tag int) (float64, error) {
+	value, err := m.GetBytes(tag)
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := bytesToFloat(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %d: %s", ErrInvalidFieldValue, tag, err)
+	}
+
+	return v, nil
+}
+
+// GetDecimal returns the value of a field as a decimal keeping all its digits.
+func (m *FieldMap) GetDecimal(tag int) (Decimal, error) {
+	value, err := m.GetBytes(tag)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	var v Decimal
+	if err = v.FromBytes(value); err != nil {
+		return Decimal{}, fmt.Errorf("%w: %d: %s", ErrInvalidFieldValue, tag, err)
+	}
+
+	return v, nil
+}
+
+// GetBool returns the value of a field as a boolean.
+func (m *FieldMap) GetBool(tag int) (bool, error) {
+	value, err := m.GetBytes(tag)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case bytes.Equal(value, trueByte):
+		return true, nil
+	case bytes.Equal(value, falseByte):
+		return false, nil
+	}
+
+	return false, fmt.Errorf("%w: %d: a boolean is expected", ErrInvalidFieldValue, tag)
+}
+
+// GetGroup returns the entries of a repeating group by its NumInGroup tag.
+// The entries are available only if the message was parsed with the template of the group or they were set by SetGroup.
+func (m *FieldMap) GetGroup(noTag int) ([]*FieldMap, error) {
+	i, ok := m.index[noTag]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrFieldNotFound, noTag)
+	}
+
+	return m.fields[i].Group, nil
+}
+
+// SetBytes sets the value of a field. A new field is added to the end.
+func (m *FieldMap) SetBytes(tag int, value []byte) *FieldMap {
+	if i, ok := m.index[tag]; ok {
+		m.fields[i].Value = value
+		m.fields[i].Group = nil
+		return m
+	}
+
+	m.index[tag] = len(m.fields)
+	m.fields = append(m.fields, &RawField{Tag: tag, Value: value})
+
+	return m
+}
+
+// SetString sets the value of a field to a string.
+func (m *FieldMap) SetString(tag int, value string) *FieldMap {
+	return m.SetBytes(tag, []byte(value))
+}
+
+// SetInt sets the value of a field to an integer.
+func (m *FieldMap) SetInt(tag int, value int) *FieldMap {
+	return m.SetBytes(tag, intToBytes(value))
+}
+
+// SetFloat sets the value of a field to a float.
+func (m *FieldMap) SetFloat(tag int, value float64) *FieldMap {
+	return m.SetBytes(tag, floatToBytes(value))
+}
+
+// SetDecimal sets the value of a field to a decimal.
+func (m *FieldMap) SetDecimal(tag int, value Decimal) *FieldMap {
+	return m.SetBytes(tag, []byte(value.String()))
+}
+
+// SetBool sets the value of a field to a boolean.
+func (m *FieldMap) SetBool(tag int, value bool) *FieldMap {
+	if value {
+		return m.SetBytes(tag, trueByte)
+	}
+
+	return m.SetBytes(tag, falseByte)
+}
+
+// SetGroup sets the entries of a repeating group and the value of its NumInGroup field.
+func (m *FieldMap) SetGroup(noTag int, entries ...*FieldMap) *FieldMap {
+	m.SetInt(noTag, len(entries))
+	m.fields[m.index[noTag]].Group = entries
+
+	return m
+}
+
+// Remove deletes all occurrences of a field.
+func (m *FieldMap) Remove(tag int) *FieldMap {
+	if _, ok := m.index[tag]; !ok {
+		return m
+	}
+
+	fields := m.fields[:0]
+	for _, field := range m.fields {
+		if field.Tag != tag {
+			fields = append(fields, field)
+		}
+	}
+	m.fields = fields
+	m.reindex()
+
+	return m
+}
+
+func (m *FieldMap) add(field *RawField) {
+	if _, ok := m.index[field.Tag]; !ok {
+		m.index[field.Tag] = len(m.fields)
+	}
+	m.fields = append(m.fields, field)
+}
+
+func (m *FieldMap) reindex() {
+	m.index = make(map[int]int, len(m.fields))
+	for i, field := range m.fields {
+		if _, ok := m.index[field.Tag]; !ok {
+			m.index[field.Tag] = i
+		}
+	}
+}
+
+// WriteBytes writes the fields along with the entries of repeating groups.
+// Each field is followed by the delimiter.
+func (m *FieldMap) WriteBytes(writer *bytes.Buffer) {
+	for _, field := range m.fields {
+		writeRawField(writer, field.Tag, field.Value)
+
+		for _, entry := range field.Group {
+			entry.WriteBytes(writer)
+		}
+	}
+}
+
+// ToBytes returns the fields in the FIX format.
+func (m *FieldMap) ToBytes() []byte {
+	var buf bytes.Buffer
+	m.WriteBytes(&buf)
+
+	return buf.Bytes()
+}
+
+// String returns a string representation of the fields with the sensitive values redacted.
+func (m *FieldMap) String() string {
+	return string(bytes.ReplaceAll(Redact(m.ToBytes()), Delimiter, []byte{'|'}))
+}
+
+func writeRawField(writer *bytes.Buffer, tag int, value []byte) {
+	_, _ = writer.Write(strconv.AppendInt(writer.AvailableBuffer(), int64(tag), 10))
+	_ = writer.WriteByte('=')
+	_, _ = writer.Write(value)
+	_ = writer.WriteByte(DelimiterChar)
+}
@@ -0,0 +1,57 @@
+package fix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFieldMap(t *testing.T) {
+	m := NewFieldMap().
+		SetString(55, "EURUSD").
+		SetInt(38, 100).
+		SetFloat(44, 1.25).
+		SetBool(43, true)
+
+	if v, err := m.GetString(55); err != nil || v != "EURUSD" {
+		t.Fatalf("unexpected Symbol: %s, %v", v, err)
+	}
+	if v, err := m.GetInt(38); err != nil || v != 100 {
+		t.Fatalf("unexpected OrderQty: %d, %v", v, err)
+	}
+	if v, err := m.GetFloat(44); err != nil || v != 1.25 {
+		t.Fatalf("unexpected Price: %f, %v", v, err)
+	}
+	if v, err := m.GetBool(43); err != nil || !v {
+		t.Fatalf("unexpected PossDupFlag: %t, %v", v, err)
+	}
+
+	if _, err := m.GetInt(55); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := m.GetString(1); !errors.Is(err, ErrFieldNotFound) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	m.SetInt(38, 200).Remove(43)
+	if m.Has(43) || m.Len() != 3 {
+		t.Fatalf("the field is not removed")
+	}
+
+	m.SetGroup(453,
+		NewFieldMap().SetString(448, "party1").SetInt(452, 1),
+		NewFieldMap().SetString(448, "party2"),
+	)
+
+	expected := "55=EURUSD\x0138=200\x0144=1.25\x01453=2\x01448=party1\x01452=1\x01448=party2\x01"
+	if string(m.ToBytes()) != expected {
+		t.Fatalf("unexpected data: %q", m.ToBytes())
+	}
+
+	entries, err := m.GetGroup(453)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("unexpected entries: %v, %v", entries, err)
+	}
+	if v, _ := entries[1].GetString(448); v != "party2" {
+		t.Fatalf("unexpected PartyID: %s", v)
+	}
+}
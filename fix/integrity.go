@@ -0,0 +1,66 @@
+package fix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	ErrInvalidHeader     = errors.New("the message does not start with the BeginString and BodyLength fields")
+	ErrInvalidBodyLength = errors.New("invalid body length")
+	ErrInvalidCheckSum   = errors.New("invalid checksum")
+)
+
+// checkSumFieldSize is the size of the CheckSum field, e.g. "10=123<SOH>".
+const checkSumFieldSize = 7
+
+// VerifyIntegrity checks the BodyLength(9) and CheckSum(10) fields of a raw message.
+// A message failing the check is considered garbled.
+func VerifyIntegrity(msg []byte) error {
+	if !bytes.HasPrefix(msg, []byte("8=")) {
+		return ErrInvalidHeader
+	}
+
+	beginStringEnd := bytes.IndexByte(msg, DelimiterChar)
+	if beginStringEnd == -1 || !bytes.HasPrefix(msg[beginStringEnd+1:], []byte("9=")) {
+		return ErrInvalidHeader
+	}
+
+	bodyLengthStart := beginStringEnd + 3
+	bodyLengthEnd := bytes.IndexByte(msg[bodyLengthStart:], DelimiterChar)
+	if bodyLengthEnd == -1 {
+		return ErrInvalidHeader
+	}
+	bodyLengthEnd += bodyLengthStart
+
+	bodyLength, err := strconv.Atoi(string(msg[bodyLengthStart:bodyLengthEnd]))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidBodyLength, err)
+	}
+
+	trailerStart := len(msg) - checkSumFieldSize
+	if trailerStart <= bodyLengthEnd || !bytes.HasPrefix(msg[trailerStart:], []byte("10=")) ||
+		msg[len(msg)-1] != DelimiterChar {
+		return fmt.Errorf("%w: the CheckSum field is missing", ErrInvalidCheckSum)
+	}
+
+	if actual := trailerStart - (bodyLengthEnd + 1); actual != bodyLength {
+		return fmt.Errorf("%w: specified: %d, actual: %d", ErrInvalidBodyLength, bodyLength, actual)
+	}
+
+	var sum int
+	for _, b := range msg[:trailerStart] {
+		sum += int(b)
+	}
+	n := sum % 256
+
+	specified := msg[trailerStart+3 : len(msg)-1]
+	expected := []byte{byte('0' + n/100), byte('0' + n/10%10), byte('0' + n%10)}
+	if !bytes.Equal(specified, expected) {
+		return fmt.Errorf("%w: specified: %s, calculated: %s", ErrInvalidCheckSum, specified, expected)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package fix
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestVerifyIntegrity(t *testing.T) {
+	const valid = "8=FIX.4.4\x019=5\x0135=0\x0110=163\x01"
+
+	testCases := map[string]struct {
+		msg string
+		err error
+	}{
+		"valid":                   {msg: valid},
+		"invalid checksum":        {msg: strings.Replace(valid, "10=163", "10=164", 1), err: ErrInvalidCheckSum},
+		"missing checksum":        {msg: strings.TrimSuffix(valid, "10=163\x01"), err: ErrInvalidCheckSum},
+		"short body length":       {msg: strings.Replace(valid, "9=5", "9=4", 1), err: ErrInvalidBodyLength},
+		"non-numeric body length": {msg: strings.Replace(valid, "9=5", "9=x", 1), err: ErrInvalidBodyLength},
+		"missing body length":     {msg: strings.Replace(valid, "9=5\x01", "", 1), err: ErrInvalidHeader},
+		"missing begin string":    {msg: strings.TrimPrefix(valid, "8=FIX.4.4\x01"), err: ErrInvalidHeader},
+		"garbled body":            {msg: strings.Replace(valid, "35=0", "35=1", 1), err: ErrInvalidCheckSum},
+	}
+
+	for name, testCase := range testCases {
+		err := VerifyIntegrity([]byte(testCase.msg))
+		if testCase.err == nil && err != nil || !errors.Is(err, testCase.err) {
+			t.Fatalf("unexpected result in case '%s': %v, expected: %v", name, err, testCase.err)
+		}
+	}
+}
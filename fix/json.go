@@ -0,0 +1,273 @@
+package fix
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidJSON is returned when a message could not be decoded from the FIX JSON encoding.
+var ErrInvalidJSON = errors.New("invalid FIX JSON")
+
+// FieldNames maps the tags to the names of the fields, which are the keys of the FIX JSON encoding.
+// The fields without names are encoded by their tags.
+type FieldNames map[string]string
+
+// name returns the key of a field in the FIX JSON encoding.
+func (n FieldNames) name(tag string) string {
+	if name, ok := n[tag]; ok {
+		return name
+	}
+
+	return tag
+}
+
+// jsonMessage is a message in the FIX JSON encoding.
+type jsonMessage struct {
+	Header  map[string]json.RawMessage `json:"Header"`
+	Body    map[string]json.RawMessage `json:"Body"`
+	Trailer map[string]json.RawMessage `json:"Trailer"`
+}
+
+// ToJSON returns the message in the FIX JSON encoding of the FIX Trading Community:
+// the Header, Body and Trailer objects contain the fields by their names, the values are strings,
+// and the entries of the groups are the arrays of objects named after their NumInGroup fields.
+// The fields of the components are put into the objects containing the components.
+// The values of the data fields are encoded in base64. The BodyLength and CheckSum fields are omitted.
+// The values of the redacted tags are kept, so the result can be decoded by FromJSON;
+// use ToRedactedJSON to log messages.
+func (msg *Message) ToJSON(names FieldNames) ([]byte, error) {
+	return msg.toJSON(names, false)
+}
+
+// ToRedactedJSON returns the message in the FIX JSON encoding, see ToJSON,
+// with the values of the redacted tags replaced by RedactedValue.
+func (msg *Message) ToRedactedJSON(names FieldNames) ([]byte, error) {
+	return msg.toJSON(names, true)
+}
+
+func (msg *Message) toJSON(names FieldNames, redact bool) ([]byte, error) {
+	if msg == nil {
+		return []byte("null"), nil
+	}
+
+	buf := &bytes.Buffer{}
+
+	_, _ = buf.WriteString(`{"Header":{`)
+	w := &jsonWriter{buf: buf, names: names, redact: redact}
+	w.item(msg.beginString)
+	w.item(msg.msgType)
+	if msg.header != nil {
+		w.item(msg.header)
+	}
+
+	_, _ = buf.WriteString(`},"Body":{`)
+	w = &jsonWriter{buf: buf, names: names, redact: redact}
+	w.items(msg.body)
+
+	_, _ = buf.WriteString(`},"Trailer":{`)
+	w = &jsonWriter{buf: buf, names: names, redact: redact}
+	if msg.trailer != nil {
+		w.item(msg.trailer)
+	}
+	_, _ = buf.WriteString(`}}`)
+
+	return buf.Bytes(), nil
+}
+
+// FromJSON sets the items of the message from the FIX JSON encoding, see ToJSON.
+// The message must contain the templates of its items, e.g. be made by a generated constructor.
+// The fields which are not defined in the message are ignored.
+func (msg *Message) FromJSON(data []byte, names FieldNames) error {
+	var m jsonMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidJSON, err)
+	}
+
+	if raw, ok := m.Header[names.name(msg.msgType.Key)]; ok {
+		msgType, err := jsonValue(raw)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %s", ErrInvalidJSON, msg.msgType.Key, err)
+		}
+		if string(msgType) != msg.MsgType() {
+			return fmt.Errorf("%w: unexpected message type %s instead of %s", ErrInvalidJSON, msgType, msg.MsgType())
+		}
+	}
+
+	header := Items{msg.beginString}
+	if msg.header != nil {
+		header = append(header, msg.header)
+	}
+
+	var trailer Items
+	if msg.trailer != nil {
+		trailer = Items{msg.trailer}
+	}
+
+	for _, section := range []struct {
+		fields map[string]json.RawMessage
+		items  Items
+	}{{m.Header, header}, {m.Body, msg.body}, {m.Trailer, trailer}} {
+		if err := decodeJSONItems(section.fields, section.items, names); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type jsonWriter struct {
+	buf    *bytes.Buffer
+	names  FieldNames
+	redact bool
+	// next is true if a comma must precede the next field.
+	next bool
+}
+
+func (w *jsonWriter) items(items Items) {
+	for _, item := range items {
+		w.item(item)
+	}
+}
+
+func (w *jsonWriter) item(item Item) {
+	switch value := item.(type) {
+	case *KeyValue:
+		if !hasValue(value) {
+			return
+		}
+
+		w.key(value.Key)
+		switch {
+		case w.redact && IsRedacted(value.Key):
+			w.string([]byte(RedactedValue))
+		case isData(value):
+			w.string([]byte(base64.StdEncoding.EncodeToString(value.Value.ToBytes())))
+		default:
+			w.string(value.Value.ToBytes())
+		}
+
+	case *Group:
+		if len(value.Entries()) == 0 {
+			return
+		}
+
+		w.key(value.NoTag())
+		_ = w.buf.WriteByte('[')
+		for i, entry := range value.Entries() {
+			if i > 0 {
+				_ = w.buf.WriteByte(',')
+			}
+
+			_ = w.buf.WriteByte('{')
+			(&jsonWriter{buf: w.buf, names: w.names, redact: w.redact}).items(entry)
+			_ = w.buf.WriteByte('}')
+		}
+		_ = w.buf.WriteByte(']')
+
+	case *Component:
+		w.items(value.Items())
+	}
+}
+
+func (w *jsonWriter) key(tag string) {
+	if w.next {
+		_ = w.buf.WriteByte(',')
+	}
+	w.next = true
+
+	w.string([]byte(w.names.name(tag)))
+	_ = w.buf.WriteByte(':')
+}
+
+func (w *jsonWriter) string(value []byte) {
+	data, _ := json.Marshal(string(value))
+	_, _ = w.buf.Write(data)
+}
+
+// decodeJSONItems sets the items from the fields of an object, the fields of the components are looked up
+// in the same object.
+func decodeJSONItems(fields map[string]json.RawMessage, items Items, names FieldNames) error {
+	for _, item := range items {
+		switch value := item.(type) {
+		case *KeyValue:
+			raw, ok := fields[names.name(value.Key)]
+			if !ok {
+				continue
+			}
+
+			v, err := jsonValue(raw)
+			if err != nil {
+				return fmt.Errorf("%w: %s: %s", ErrInvalidJSON, value.Key, err)
+			}
+			if len(v) == 0 {
+				continue
+			}
+			if isData(value) {
+				if v, err = base64.StdEncoding.AppendDecode(nil, v); err != nil {
+					return fmt.Errorf("%w: %s: %s", ErrInvalidJSON, value.Key, err)
+				}
+			}
+
+			if err = value.FromBytes(v); err != nil {
+				return fmt.Errorf("%w: %s: %s", ErrInvalidFieldValue, value.Key, err)
+			}
+
+		case *Group:
+			raw, ok := fields[names.name(value.NoTag())]
+			if !ok {
+				continue
+			}
+
+			var entries []map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &entries); err != nil {
+				return fmt.Errorf("%w: %s: an array of objects is expected", ErrInvalidJSON, value.NoTag())
+			}
+
+			for _, entryFields := range entries {
+				entry := value.AsTemplate()
+				if err := decodeJSONItems(entryFields, entry, names); err != nil {
+					return err
+				}
+
+				value.AddEntry(entry)
+			}
+
+		case *Component:
+			if err := decodeJSONItems(fields, value.Items(), names); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isData checks whether the field is a data field, whose value is encoded in base64.
+func isData(kv *KeyValue) bool {
+	_, ok := kv.Value.(*Data)
+
+	return ok
+}
+
+// jsonValue returns the value of a field, which is either a string or a number.
+func jsonValue(raw json.RawMessage) ([]byte, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+
+		return []byte(s), nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(raw, &number); err != nil {
+		return nil, errors.New("a string is expected")
+	}
+
+	return []byte(number), nil
+}
@@ -0,0 +1,110 @@
+package fix
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func makeJSONTestMessage() *Message {
+	return NewMessage("8", "9", "10", "35", "FIX.4.4", "V").
+		SetHeader(NewComponent(NewKeyValue("49", &String{}), NewKeyValue("34", &Int{}))).
+		SetBody(
+			NewKeyValue("262", &String{}),
+			NewComponent(NewKeyValue("55", &String{})),
+			NewGroup("267", NewKeyValue("269", &String{}), NewKeyValue("270", &Float{})),
+		).
+		SetTrailer(NewComponent(NewKeyValue("93", &Int{})))
+}
+
+func TestMessageJSON(t *testing.T) {
+	names := FieldNames{"8": "BeginString", "35": "MsgType", "49": "SenderCompID", "262": "MDReqID",
+		"55": "Symbol", "267": "NoMDEntryTypes", "269": "MDEntryType"}
+
+	msg := makeJSONTestMessage()
+	_ = msg.Header().Get(0).(*KeyValue).Load().Set("sender")
+	_ = msg.Get(0).(*KeyValue).Load().Set("req")
+	_ = msg.Get(1).(*Component).Get(0).(*KeyValue).Load().Set("EURUSD")
+	group := msg.Get(2).(*Group)
+	for _, entryType := range []string{"0", "1"} {
+		entry := group.AsTemplate()
+		_ = entry[0].(*KeyValue).Load().Set(entryType)
+		_ = entry[1].(*KeyValue).Load().Set(1.5)
+		group.AddEntry(entry)
+	}
+
+	data, err := msg.ToJSON(names)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"Header":{"BeginString":"FIX.4.4","MsgType":"V","SenderCompID":"sender"},` +
+		`"Body":{"MDReqID":"req","Symbol":"EURUSD","NoMDEntryTypes":[{"MDEntryType":"0","270":"1.5"},` +
+		`{"MDEntryType":"1","270":"1.5"}]},"Trailer":{}}`
+	if string(data) != expected {
+		t.Fatalf("unexpected JSON: %s", data)
+	}
+
+	decoded := makeJSONTestMessage()
+	if err = decoded.FromJSON(data, names); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	original, _ := msg.ToBytes()
+	restored, _ := decoded.ToBytes()
+	if !bytes.Equal(original, restored) {
+		t.Fatalf("unexpected message: %s", restored)
+	}
+
+	if err = makeJSONTestMessage().FromJSON([]byte(`{"Header":{"MsgType":"D"}}`), names); !errors.Is(err, ErrInvalidJSON) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = makeJSONTestMessage().FromJSON([]byte(`{"Body":{"NoMDEntryTypes":[{"270":"price"}]}}`), names)
+	if !errors.Is(err, ErrInvalidFieldValue) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMessageJSONData(t *testing.T) {
+	names := FieldNames{"95": "RawDataLength", "96": "RawData", "554": "Password"}
+
+	msg := NewMessage("8", "9", "10", "35", "FIX.4.4", "A").
+		SetBody(NewKeyValue("95", &Int{}), NewKeyValue("96", &Data{}), NewKeyValue("554", &String{}))
+	_ = msg.Get(0).(*KeyValue).Load().Set(3)
+	_ = msg.Get(1).(*KeyValue).Load().Set("a\x01b")
+	_ = msg.Get(2).(*KeyValue).Load().Set("secret")
+
+	data, err := msg.ToJSON(names)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"Header":{"8":"FIX.4.4","35":"A"},"Body":{"RawDataLength":"3","RawData":"YQFi","Password":"secret"},"Trailer":{}}`
+	if string(data) != expected {
+		t.Fatalf("unexpected JSON: %s", data)
+	}
+
+	decoded := NewMessage("8", "9", "10", "35", "FIX.4.4", "A").
+		SetBody(NewKeyValue("95", &Int{}), NewKeyValue("96", &Data{}), NewKeyValue("554", &String{}))
+	if err = decoded.FromJSON(data, names); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if value := decoded.Get(1).(*KeyValue).Value.String(); value != "a\x01b" {
+		t.Fatalf("unexpected data: %q", value)
+	}
+
+	data, err = msg.ToRedactedJSON(names)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected = `{"Header":{"8":"FIX.4.4","35":"A"},"Body":{"RawDataLength":"3","RawData":"***","Password":"***"},"Trailer":{}}`
+	if string(data) != expected {
+		t.Fatalf("unexpected JSON: %s", data)
+	}
+
+	err = decoded.FromJSON([]byte(`{"Body":{"RawData":"a\\u0001b"}}`), names)
+	if !errors.Is(err, ErrInvalidJSON) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
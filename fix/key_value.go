@@ -17,9 +17,14 @@ func NewKeyValue(key string, value Value) *KeyValue {
 	return &KeyValue{Key: key, Value: value}
 }
 
+// templateValue is implemented by the values which could not be listed in AsTemplate, e.g. the generic ones.
+type templateValue interface {
+	template() Value
+}
+
 // AsTemplate returns a copy of a KeyValue object with an empty value assigned to it.
 func (kv *KeyValue) AsTemplate() *KeyValue {
-	switch kv.Value.(type) {
+	switch v := kv.Value.(type) {
 	case *String:
 		return NewKeyValue(kv.Key, &String{})
 	case *Int:
@@ -30,6 +35,24 @@ func (kv *KeyValue) AsTemplate() *KeyValue {
 		return NewKeyValue(kv.Key, &Time{})
 	case *Float:
 		return NewKeyValue(kv.Key, &Float{})
+	case *Data:
+		return NewKeyValue(kv.Key, &Data{lengthTag: v.lengthTag})
+	case *Decimal:
+		return NewKeyValue(kv.Key, &Decimal{})
+	case *UTCTimestamp:
+		return NewKeyValue(kv.Key, (&UTCTimestamp{}).WithPrecision(v.precision))
+	case *TZTimestamp:
+		return NewKeyValue(kv.Key, (&TZTimestamp{}).WithPrecision(v.precision))
+	case *UTCTimeOnly:
+		return NewKeyValue(kv.Key, (&UTCTimeOnly{}).WithPrecision(v.precision))
+	case *UTCDateOnly:
+		return NewKeyValue(kv.Key, &UTCDateOnly{})
+	case *LocalMktDate:
+		return NewKeyValue(kv.Key, &LocalMktDate{})
+	case *MonthYearValue:
+		return NewKeyValue(kv.Key, &MonthYearValue{})
+	case templateValue:
+		return NewKeyValue(kv.Key, v.template())
 	default:
 		return NewKeyValue(kv.Key, &Raw{})
 	}
@@ -96,6 +119,9 @@ func (kv *KeyValue) String() string {
 	if kv.Value.IsNull() {
 		return ""
 	}
+	if IsRedacted(kv.Key) {
+		return fmt.Sprintf("%s: %s", kv.Key, RedactedValue)
+	}
 	return fmt.Sprintf("%s: %s", kv.Key, kv.Value)
 }
 
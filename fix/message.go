@@ -186,6 +186,10 @@ func (msg *Message) WriteBytesWithoutChecksum(buffers *buffer.MessageByteBuffers
 
 // Prepare prepares message by calculating body length and check sum
 func (msg *Message) Prepare() error {
+	if err := setDataLengths(msg.Items()); err != nil {
+		return err
+	}
+
 	msg.bodyLength.Value = NewInt(msg.CalcBodyLength())
 
 	byteMsg := msg.BytesWithoutChecksum()
@@ -208,6 +212,10 @@ func (msg *Message) Prepare() error {
 func (msg *Message) prepareBuffered(buffers *buffer.MessageByteBuffers) error {
 	msgBuff := buffers.GetMessageBuffer()
 
+	if err := setDataLengths(msg.Items()); err != nil {
+		return err
+	}
+
 	msg.WriteBytesWithoutChecksum(buffers)
 	checkSum := CalcCheckSumOptimizedFromBuffer(msgBuff)
 	if err := msg.checkSum.Value.Set(string(checkSum)); err != nil {
@@ -0,0 +1,236 @@
+package fix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// The tags of the fields required for any message.
+const (
+	TagBeginString = 8
+	TagBodyLength  = 9
+	TagMsgType     = 35
+	TagCheckSum    = 10
+)
+
+var (
+	ErrInvalidTag   = errors.New("invalid tag")
+	ErrInvalidGroup = errors.New("invalid repeating group")
+)
+
+// GroupTemplate describes the entries of a repeating group.
+type GroupTemplate struct {
+	// Tags are the tags of the entry fields in their order. The first tag delimits the entries.
+	Tags []int
+	// Groups are the templates of the nested groups by their NumInGroup tags.
+	Groups map[int]*GroupTemplate
+}
+
+func (t *GroupTemplate) contains(tag int) bool {
+	for _, entryTag := range t.Tags {
+		if entryTag == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MessageLayout specifies the header and trailer fields and the repeating groups of messages.
+type MessageLayout struct {
+	HeaderTags  map[int]bool
+	TrailerTags map[int]bool
+	// Groups are the templates of the header, body and trailer groups by their NumInGroup tags.
+	Groups map[int]*GroupTemplate
+}
+
+// DefaultMessageLayout contains the standard header and trailer fields.
+// The body groups are unknown, so their fields are kept in the order of the message without entries.
+var DefaultMessageLayout = &MessageLayout{
+	HeaderTags: map[int]bool{
+		8: true, 9: true, 35: true, 49: true, 56: true, 115: true, 128: true, 90: true, 91: true,
+		34: true, 50: true, 142: true, 57: true, 143: true, 116: true, 144: true, 129: true, 145: true,
+		43: true, 97: true, 52: true, 122: true, 212: true, 213: true, 347: true, 369: true,
+		627: true, 628: true, 629: true, 630: true, 1128: true, 1129: true, 1156: true,
+	},
+	TrailerTags: map[int]bool{93: true, 89: true, 10: true},
+	Groups: map[int]*GroupTemplate{
+		627: {Tags: []int{628, 629, 630}}, // NoHops
+	},
+}
+
+// RawMessage is a message without generated code.
+// It is parsed once into the header, the body and the trailer indexed by tags.
+type RawMessage struct {
+	Header  *FieldMap
+	Body    *FieldMap
+	Trailer *FieldMap
+}
+
+// NewRawMessage creates a message with the BeginString and MsgType fields.
+func NewRawMessage(beginString, msgType string) *RawMessage {
+	return &RawMessage{
+		Header: NewFieldMap().
+			SetString(TagBeginString, beginString).
+			SetString(TagMsgType, msgType),
+		Body:    NewFieldMap(),
+		Trailer: NewFieldMap(),
+	}
+}
+
+// ParseRawMessage parses a message using a layout, or the DefaultMessageLayout if it is nil.
+// The values refer to the data, so it should not be modified.
+func ParseRawMessage(data []byte, layout *MessageLayout) (*RawMessage, error) {
+	if layout == nil {
+		layout = DefaultMessageLayout
+	}
+
+	var (
+		fields []*RawField
+		err    error
+	)
+	EachField(data, func(tag string, value []byte) bool {
+		tagID, convErr := strconv.Atoi(tag)
+		if convErr != nil || tagID <= 0 {
+			err = fmt.Errorf("%w: %s", ErrInvalidTag, tag)
+			return false
+		}
+
+		fields = append(fields, &RawField{Tag: tagID, Value: value})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &RawMessage{Header: NewFieldMap(), Body: NewFieldMap(), Trailer: NewFieldMap()}
+	p := &rawParser{fields: fields}
+
+	for p.pos < len(p.fields) {
+		field := p.fields[p.pos]
+
+		section := msg.Body
+		switch {
+		case layout.HeaderTags[field.Tag]:
+			section = msg.Header
+		case layout.TrailerTags[field.Tag]:
+			section = msg.Trailer
+		}
+
+		if err = p.field(section, layout.Groups); err != nil {
+			return nil, err
+		}
+	}
+
+	return msg, nil
+}
+
+type rawParser struct {
+	fields []*RawField
+	pos    int
+}
+
+// field adds the field at the current position along with the entries of a repeating group.
+func (p *rawParser) field(m *FieldMap, groups map[int]*GroupTemplate) error {
+	field := p.fields[p.pos]
+	m.add(field)
+	p.pos++
+
+	template, ok := groups[field.Tag]
+	if !ok || len(template.Tags) == 0 {
+		return nil
+	}
+
+	count, err := bytesToInt(field.Value)
+	if err != nil {
+		return fmt.Errorf("%w: %d: invalid number of entries", ErrInvalidGroup, field.Tag)
+	}
+
+	delimiter := template.Tags[0]
+	for p.pos < len(p.fields) && p.fields[p.pos].Tag == delimiter {
+		entry := NewFieldMap()
+		if err = p.field(entry, template.Groups); err != nil {
+			return err
+		}
+
+		for p.pos < len(p.fields) {
+			tag := p.fields[p.pos].Tag
+			if tag == delimiter || !template.contains(tag) {
+				break
+			}
+			if err = p.field(entry, template.Groups); err != nil {
+				return err
+			}
+		}
+
+		field.Group = append(field.Group, entry)
+	}
+
+	if len(field.Group) != count {
+		return fmt.Errorf("%w: %d: %d entries are specified, %d are found",
+			ErrInvalidGroup, field.Tag, count, len(field.Group))
+	}
+
+	return nil
+}
+
+// MsgType returns the value of the MsgType field.
+func (m *RawMessage) MsgType() string {
+	msgType, _ := m.Header.Get(TagMsgType)
+	return string(msgType)
+}
+
+// ToBytes returns the message with the calculated BodyLength and CheckSum fields.
+// The BeginString and MsgType fields are moved to the beginning of the header.
+func (m *RawMessage) ToBytes() ([]byte, error) {
+	beginString, ok := m.Header.Get(TagBeginString)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrFieldNotFound, TagBeginString)
+	}
+	msgType, ok := m.Header.Get(TagMsgType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrFieldNotFound, TagMsgType)
+	}
+
+	var body bytes.Buffer
+	writeRawField(&body, TagMsgType, msgType)
+	for _, section := range []*FieldMap{m.Header, m.Body, m.Trailer} {
+		for _, field := range section.fields {
+			switch field.Tag {
+			case TagBeginString, TagBodyLength, TagMsgType, TagCheckSum:
+				continue
+			}
+
+			writeRawField(&body, field.Tag, field.Value)
+			for _, entry := range field.Group {
+				entry.WriteBytes(&body)
+			}
+		}
+	}
+
+	var msg bytes.Buffer
+	writeRawField(&msg, TagBeginString, beginString)
+	writeRawField(&msg, TagBodyLength, intToBytes(body.Len()))
+	_, _ = msg.Write(body.Bytes())
+
+	var sum int
+	for _, b := range msg.Bytes() {
+		sum += int(b)
+	}
+	n := sum % 256
+	writeRawField(&msg, TagCheckSum, []byte{byte('0' + n/100), byte('0' + (n/10)%10), byte('0' + n%10)})
+
+	return msg.Bytes(), nil
+}
+
+// String returns a string representation of the message with the sensitive values redacted.
+func (m *RawMessage) String() string {
+	data, err := m.ToBytes()
+	if err != nil {
+		return fmt.Sprintf("%s%s%s", m.Header, m.Body, m.Trailer)
+	}
+
+	return string(bytes.ReplaceAll(Redact(data), Delimiter, []byte{'|'}))
+}
@@ -0,0 +1,97 @@
+package fix
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseRawMessage(t *testing.T) {
+	data := []byte(strings.ReplaceAll(
+		"8=FIX.4.4|9=5|35=D|49=sender|56=target|34=2|52=20210706-19:06:12.838|627=1|628=hop|629=20210706-19:06:12.838|"+
+			"11=order|453=2|448=party1|447=D|452=1|802=1|523=sub|803=1|448=party2|55=EURUSD|44=1.1|10=000|",
+		"|", "\x01"))
+
+	layout := &MessageLayout{
+		HeaderTags:  DefaultMessageLayout.HeaderTags,
+		TrailerTags: DefaultMessageLayout.TrailerTags,
+		Groups: map[int]*GroupTemplate{
+			627: DefaultMessageLayout.Groups[627],
+			453: {
+				Tags:   []int{448, 447, 452, 802},
+				Groups: map[int]*GroupTemplate{802: {Tags: []int{523, 803}}},
+			},
+		},
+	}
+
+	msg, err := ParseRawMessage(data, layout)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if msg.MsgType() != "D" || !msg.Header.Has(49) || msg.Body.Has(49) || !msg.Trailer.Has(TagCheckSum) {
+		t.Fatalf("unexpected sections: %s", msg)
+	}
+	if v, _ := msg.Body.GetFloat(44); v != 1.1 {
+		t.Fatalf("unexpected Price: %f", v)
+	}
+
+	hops, err := msg.Header.GetGroup(627)
+	if err != nil || len(hops) != 1 {
+		t.Fatalf("unexpected hops: %v, %v", hops, err)
+	}
+
+	parties, err := msg.Body.GetGroup(453)
+	if err != nil || len(parties) != 2 {
+		t.Fatalf("unexpected parties: %v, %v", parties, err)
+	}
+	subIDs, err := parties[0].GetGroup(802)
+	if err != nil || len(subIDs) != 1 {
+		t.Fatalf("unexpected party sub IDs: %v, %v", subIDs, err)
+	}
+	if v, _ := subIDs[0].GetString(523); v != "sub" {
+		t.Fatalf("unexpected PartySubID: %s", v)
+	}
+	if parties[1].Has(55) {
+		t.Fatalf("the field following the group belongs to the last entry")
+	}
+
+	out, err := msg.ToBytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err = VerifyIntegrity(out); err != nil {
+		t.Fatalf("invalid message: %s", err)
+	}
+
+	reparsed, err := ParseRawMessage(out, layout)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.Contains(string(reparsed.Body.ToBytes()), "448=party2\x0155=EURUSD\x01") {
+		t.Fatalf("unexpected body: %q", reparsed.Body.ToBytes())
+	}
+
+	_, err = ParseRawMessage([]byte(strings.Replace(string(data), "453=2", "453=3", 1)), layout)
+	if !errors.Is(err, ErrInvalidGroup) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRawMessageToBytes(t *testing.T) {
+	msg := NewRawMessage("FIX.4.4", "0")
+	msg.Header.SetString(49, "sender").SetString(56, "target").SetInt(34, 1)
+	msg.Body.SetString(112, "test")
+
+	data, err := msg.ToBytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.HasPrefix(string(data), "8=FIX.4.4\x019=") || !strings.Contains(string(data), "35=0\x0149=sender\x01") {
+		t.Fatalf("unexpected data: %q", data)
+	}
+	if err = VerifyIntegrity(data); err != nil {
+		t.Fatalf("invalid message: %s", err)
+	}
+}
@@ -0,0 +1,96 @@
+package fix
+
+import (
+	"strconv"
+	"sync/atomic"
+)
+
+// RedactedValue replaces the values of sensitive fields in the string representation of messages,
+// in logs and in error messages.
+const RedactedValue = "***"
+
+// DefaultRedactedTags are the sensitive fields redacted by default:
+// Password, NewPassword, RawData, SecureData, EncryptedPassword and EncryptedNewPassword.
+var DefaultRedactedTags = []string{"554", "925", "96", "91", "1402", "1404"}
+
+var redactedTags atomic.Pointer[map[string]struct{}]
+
+func init() {
+	SetRedactedTags(DefaultRedactedTags...)
+}
+
+// SetRedactedTags replaces the set of redacted tags.
+// Calling it without arguments disables redaction.
+func SetRedactedTags(tags ...string) {
+	set := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		set[tag] = struct{}{}
+	}
+
+	redactedTags.Store(&set)
+}
+
+// RedactedTags returns the current set of redacted tags.
+func RedactedTags() []string {
+	set := *redactedTags.Load()
+
+	tags := make([]string, 0, len(set))
+	for tag := range set {
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// IsRedacted checks whether the value of a tag must be hidden.
+func IsRedacted(tag string) bool {
+	_, ok := (*redactedTags.Load())[tag]
+
+	return ok
+}
+
+// Redact returns a copy of a raw FIX message with the values of the redacted tags replaced by RedactedValue.
+// The length field of a redacted data field is set to the length of RedactedValue,
+// so the result is still a consistent FIX message.
+// The message is returned as is if it contains no redacted tags.
+func Redact(msg []byte) []byte {
+	set := *redactedTags.Load()
+	if len(set) == 0 {
+		return msg
+	}
+
+	var (
+		res []byte
+		// copied is the position of msg up to which the message has been copied into res.
+		copied   int
+		prevTag  string
+		prevFrom int
+		prevTo   int
+	)
+
+	eachField(msg, func(tag string, start, end int) bool {
+		lengthTag, paired := dataLengthTags[tag]
+		paired = paired && lengthTag == prevTag
+
+		if _, ok := set[tag]; ok {
+			if paired && copied <= prevFrom {
+				res = append(res, msg[copied:prevFrom]...)
+				res = strconv.AppendInt(res, int64(len(RedactedValue)), 10)
+				copied = prevTo
+			}
+			res = append(res, msg[copied:start]...)
+			res = append(res, RedactedValue...)
+			copied = end
+		}
+
+		prevTag, prevFrom, prevTo = tag, start, end
+
+		return true
+	})
+
+	if res == nil {
+		return msg
+	}
+
+	return append(res, msg[copied:]...)
+}
@@ -0,0 +1,73 @@
+package fix
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRedact(t *testing.T) {
+	testCases := map[string]struct {
+		msg      string
+		expected string
+	}{
+		"no sensitive fields": {
+			msg:      "8=FIX.4.4\x0135=0\x0110=000\x01",
+			expected: "8=FIX.4.4\x0135=0\x0110=000\x01",
+		},
+		"password": {
+			msg:      "8=FIX.4.4\x0135=A\x01553=user\x01554=secret\x0110=000\x01",
+			expected: "8=FIX.4.4\x0135=A\x01553=user\x01554=***\x0110=000\x01",
+		},
+		"password without the trailing delimiter": {
+			msg:      "35=A\x01554=secret",
+			expected: "35=A\x01554=***",
+		},
+		"raw data with delimiters": {
+			msg:      "35=A\x0195=11\x0196=raw\x01da=ta\x01x\x01925=new\x0110=000\x01",
+			expected: "35=A\x0195=3\x0196=***\x01925=***\x0110=000\x01",
+		},
+	}
+
+	for name, testCase := range testCases {
+		if res := string(Redact([]byte(testCase.msg))); res != testCase.expected {
+			t.Fatalf("unexpected result in case '%s': %q, expected: %q", name, res, testCase.expected)
+		}
+	}
+}
+
+func TestSetRedactedTags(t *testing.T) {
+	defer SetRedactedTags(DefaultRedactedTags...)
+
+	SetRedactedTags("553")
+	if IsRedacted("554") || !IsRedacted("553") {
+		t.Fatalf("unexpected redacted tags: %v", RedactedTags())
+	}
+
+	msg := "35=A\x01553=user\x01554=secret\x01"
+	if res := string(Redact([]byte(msg))); res != "35=A\x01553=***\x01554=secret\x01" {
+		t.Fatalf("unexpected result: %q", res)
+	}
+
+	SetRedactedTags()
+	if res := string(Redact([]byte(msg))); res != msg {
+		t.Fatalf("unexpected result: %q", res)
+	}
+}
+
+func TestMessage_StringRedacted(t *testing.T) {
+	msg := NewMessage(beginString, bodyLength, checksum, msgType, "FIX.4.4", "A").
+		SetBody(
+			NewKeyValue("553", NewString("user")),
+			NewKeyValue("554", NewString("secret")),
+		).
+		SetHeader(newHeader(1, "sender", "target", time.Unix(1612788703, 0).UTC()))
+
+	res := msg.String()
+	if strings.Contains(res, "secret") || !strings.Contains(res, "554: "+RedactedValue) {
+		t.Fatalf("the password is not redacted: %s", res)
+	}
+	if !strings.Contains(res, "553: user") {
+		t.Fatalf("the username is redacted: %s", res)
+	}
+}
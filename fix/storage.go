@@ -1,5 +1,7 @@
 package fix
 
+import "time"
+
 type StorageSide string
 
 const (
@@ -12,3 +14,21 @@ type StorageID struct {
 	Target string
 	Side   StorageSide
 }
+
+// RetentionPolicy specifies which stored messages may be trimmed from a message storage.
+// Zero values disable the corresponding limit.
+type RetentionPolicy struct {
+	// MaxMessages is the maximum number of messages kept in the storage.
+	MaxMessages int
+
+	// MaxAge is the maximum period of time a message is kept after being saved.
+	MaxAge time.Duration
+
+	// SinceLastReset drops all the messages saved before the last sequence number reset.
+	SinceLastReset bool
+}
+
+// IsEmpty returns true if no limits are specified by the policy.
+func (p RetentionPolicy) IsEmpty() bool {
+	return p.MaxMessages <= 0 && p.MaxAge <= 0 && !p.SinceLastReset
+}
@@ -0,0 +1,798 @@
+package fix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var ErrInvalidTime = errors.New("invalid time")
+
+// TimePrecision specifies the number of digits of the fractional seconds written by the temporal values.
+// The values are parsed with any precision regardless of it.
+type TimePrecision int
+
+const (
+	// Milliseconds is the default precision, which matches TimeLayout.
+	Milliseconds TimePrecision = iota
+	Seconds
+	Microseconds
+	Nanoseconds
+)
+
+// PrecisionOf returns the precision of a number of fractional digits: 0, 3, 6 or 9.
+func PrecisionOf(digits int) (TimePrecision, error) {
+	switch digits {
+	case 0:
+		return Seconds, nil
+	case 3:
+		return Milliseconds, nil
+	case 6:
+		return Microseconds, nil
+	case 9:
+		return Nanoseconds, nil
+	}
+
+	return 0, fmt.Errorf("unsupported number of fractional digits: %d", digits)
+}
+
+// Digits returns the number of fractional digits.
+func (p TimePrecision) Digits() int {
+	switch p {
+	case Seconds:
+		return 0
+	case Microseconds:
+		return 6
+	case Nanoseconds:
+		return 9
+	default:
+		return 3
+	}
+}
+
+func (p TimePrecision) String() string {
+	switch p {
+	case Seconds:
+		return "Seconds"
+	case Microseconds:
+		return "Microseconds"
+	case Nanoseconds:
+		return "Nanoseconds"
+	default:
+		return "Milliseconds"
+	}
+}
+
+// Date is a calendar date without a time zone, it is the value of UTCDateOnly and LocalMktDate fields.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of a time in its location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// In returns the midnight of the date in a location.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// IsZero returns true if the date is not set.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// String returns the date in the YYYYMMDD format.
+func (d Date) String() string {
+	return string(appendDate(nil, d.Year, d.Month, d.Day))
+}
+
+// MonthYear is the value of MonthYear fields, which specify a month optionally refined with a day or a week.
+// Day and Week are 0 if they are absent, e.g. 202406 or 202406w2.
+type MonthYear struct {
+	Year  int
+	Month time.Month
+	Day   int
+	Week  int
+}
+
+// String returns the value in the YYYYMM, YYYYMMDD or YYYYMMwN format.
+func (m MonthYear) String() string {
+	b := appendDigits(nil, m.Year, 4)
+	b = appendDigits(b, int(m.Month), 2)
+
+	switch {
+	case m.Day > 0:
+		b = appendDigits(b, m.Day, 2)
+	case m.Week > 0:
+		b = append(b, 'w')
+		b = appendDigits(b, m.Week, 1)
+	}
+
+	return string(b)
+}
+
+// UTCTimestamp is the value of UTCTimestamp fields in the YYYYMMDD-HH:MM:SS[.sss] format.
+type UTCTimestamp struct {
+	value     time.Time
+	precision TimePrecision
+	valid     bool
+}
+
+func NewUTCTimestamp(value time.Time) *UTCTimestamp {
+	return &UTCTimestamp{value: value, valid: true}
+}
+
+// WithPrecision sets the precision of the written value.
+func (v *UTCTimestamp) WithPrecision(precision TimePrecision) *UTCTimestamp {
+	v.precision = precision
+	return v
+}
+
+// Precision returns the precision of the written value.
+func (v *UTCTimestamp) Precision() TimePrecision {
+	return v.precision
+}
+
+// Set assigns the field value stored as time.Time.
+func (v *UTCTimestamp) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	if res, ok := d.(time.Time); ok {
+		v.value = res
+		v.valid = true
+		return nil
+	}
+
+	return fmt.Errorf("could not convert %s to %s", d, "UTCTimestamp")
+}
+
+func (v *UTCTimestamp) IsNull() bool {
+	return !v.valid
+}
+func (v *UTCTimestamp) IsEmpty() bool {
+	return !v.valid
+}
+
+func (v *UTCTimestamp) Value() interface{} {
+	return v.value
+}
+
+func (v *UTCTimestamp) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	return v.appendTo(make([]byte, 0, 30))
+}
+
+func (v *UTCTimestamp) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	var buf [30]byte
+	_, _ = writer.Write(v.appendTo(buf[:0]))
+	return true
+}
+
+func (v *UTCTimestamp) appendTo(b []byte) []byte {
+	t := v.value.UTC()
+	b = appendDate(b, t.Year(), t.Month(), t.Day())
+	b = append(b, '-')
+
+	return appendClock(b, t, v.precision)
+}
+
+// FromBytes parses the value with any number of fractional digits.
+func (v *UTCTimestamp) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	t, err := parseTimestamp(d, false)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidTime, err)
+	}
+
+	v.value, v.valid = t, true
+	return nil
+}
+
+func (v *UTCTimestamp) String() string {
+	if !v.valid {
+		return ""
+	}
+	return string(v.ToBytes())
+}
+
+// TZTimestamp is the value of TZTimestamp fields in the YYYYMMDD-HH:MM[:SS][.sss][Z|±hh[:mm]] format.
+// The time zone of the value is kept on both parsing and writing.
+type TZTimestamp struct {
+	value     time.Time
+	precision TimePrecision
+	valid     bool
+}
+
+func NewTZTimestamp(value time.Time) *TZTimestamp {
+	return &TZTimestamp{value: value, valid: true}
+}
+
+// WithPrecision sets the precision of the written value.
+func (v *TZTimestamp) WithPrecision(precision TimePrecision) *TZTimestamp {
+	v.precision = precision
+	return v
+}
+
+// Precision returns the precision of the written value.
+func (v *TZTimestamp) Precision() TimePrecision {
+	return v.precision
+}
+
+// Set assigns the field value stored as time.Time.
+func (v *TZTimestamp) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	if res, ok := d.(time.Time); ok {
+		v.value = res
+		v.valid = true
+		return nil
+	}
+
+	return fmt.Errorf("could not convert %s to %s", d, "TZTimestamp")
+}
+
+func (v *TZTimestamp) IsNull() bool {
+	return !v.valid
+}
+func (v *TZTimestamp) IsEmpty() bool {
+	return !v.valid
+}
+
+func (v *TZTimestamp) Value() interface{} {
+	return v.value
+}
+
+func (v *TZTimestamp) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	return v.appendTo(make([]byte, 0, 36))
+}
+
+func (v *TZTimestamp) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	var buf [36]byte
+	_, _ = writer.Write(v.appendTo(buf[:0]))
+	return true
+}
+
+func (v *TZTimestamp) appendTo(b []byte) []byte {
+	b = appendDate(b, v.value.Year(), v.value.Month(), v.value.Day())
+	b = append(b, '-')
+	b = appendClock(b, v.value, v.precision)
+
+	return appendZone(b, v.value)
+}
+
+// FromBytes parses the value with any number of fractional digits.
+func (v *TZTimestamp) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	t, err := parseTimestamp(d, true)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidTime, err)
+	}
+
+	v.value, v.valid = t, true
+	return nil
+}
+
+func (v *TZTimestamp) String() string {
+	if !v.valid {
+		return ""
+	}
+	return string(v.ToBytes())
+}
+
+// UTCTimeOnly is the value of UTCTimeOnly fields in the HH:MM:SS[.sss] format.
+// The value is time.Time of the January 1 of the year 0.
+type UTCTimeOnly struct {
+	value     time.Time
+	precision TimePrecision
+	valid     bool
+}
+
+func NewUTCTimeOnly(value time.Time) *UTCTimeOnly {
+	v := &UTCTimeOnly{}
+	_ = v.Set(value)
+	return v
+}
+
+// WithPrecision sets the precision of the written value.
+func (v *UTCTimeOnly) WithPrecision(precision TimePrecision) *UTCTimeOnly {
+	v.precision = precision
+	return v
+}
+
+// Precision returns the precision of the written value.
+func (v *UTCTimeOnly) Precision() TimePrecision {
+	return v.precision
+}
+
+// Set assigns the field value stored as time.Time, the date is dropped.
+func (v *UTCTimeOnly) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	if res, ok := d.(time.Time); ok {
+		res = res.UTC()
+		v.value = time.Date(0, time.January, 1, res.Hour(), res.Minute(), res.Second(), res.Nanosecond(), time.UTC)
+		v.valid = true
+		return nil
+	}
+
+	return fmt.Errorf("could not convert %s to %s", d, "UTCTimeOnly")
+}
+
+func (v *UTCTimeOnly) IsNull() bool {
+	return !v.valid
+}
+func (v *UTCTimeOnly) IsEmpty() bool {
+	return !v.valid
+}
+
+func (v *UTCTimeOnly) Value() interface{} {
+	return v.value
+}
+
+func (v *UTCTimeOnly) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	return appendClock(make([]byte, 0, 18), v.value, v.precision)
+}
+
+func (v *UTCTimeOnly) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	var buf [18]byte
+	_, _ = writer.Write(appendClock(buf[:0], v.value, v.precision))
+	return true
+}
+
+// FromBytes parses the value with any number of fractional digits.
+func (v *UTCTimeOnly) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	hour, minute, sec, nsec, rest, err := parseClock(d, false)
+	if err == nil && len(rest) > 0 {
+		err = fmt.Errorf("unexpected suffix %q", rest)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidTime, err)
+	}
+
+	v.value = time.Date(0, time.January, 1, hour, minute, sec, nsec, time.UTC)
+	v.valid = true
+	return nil
+}
+
+func (v *UTCTimeOnly) String() string {
+	if !v.valid {
+		return ""
+	}
+	return string(v.ToBytes())
+}
+
+// dateValue is a date in the YYYYMMDD format.
+type dateValue struct {
+	value Date
+	valid bool
+}
+
+// Set assigns the field value stored as Date or time.Time.
+func (v *dateValue) Set(d interface{}) error {
+	switch res := d.(type) {
+	case nil:
+		v.valid = false
+	case Date:
+		v.value, v.valid = res, true
+	case time.Time:
+		v.value, v.valid = DateOf(res), true
+	default:
+		return fmt.Errorf("could not convert %s to %s", d, "Date")
+	}
+
+	return nil
+}
+
+func (v *dateValue) IsNull() bool {
+	return !v.valid
+}
+func (v *dateValue) IsEmpty() bool {
+	return !v.valid
+}
+
+func (v *dateValue) Value() interface{} {
+	return v.value
+}
+
+func (v *dateValue) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	return appendDate(make([]byte, 0, 8), v.value.Year, v.value.Month, v.value.Day)
+}
+
+func (v *dateValue) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	var buf [8]byte
+	_, _ = writer.Write(appendDate(buf[:0], v.value.Year, v.value.Month, v.value.Day))
+	return true
+}
+
+func (v *dateValue) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	year, month, day, err := parseDate(d)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidTime, err)
+	}
+
+	v.value, v.valid = Date{Year: year, Month: month, Day: day}, true
+	return nil
+}
+
+func (v *dateValue) String() string {
+	if !v.valid {
+		return ""
+	}
+	return v.value.String()
+}
+
+// UTCDateOnly is the value of UTCDateOnly fields in the YYYYMMDD format.
+type UTCDateOnly struct {
+	dateValue
+}
+
+func NewUTCDateOnly(value Date) *UTCDateOnly {
+	return &UTCDateOnly{dateValue{value: value, valid: true}}
+}
+
+// LocalMktDate is the value of LocalMktDate fields in the YYYYMMDD format, the date is local to the market center.
+type LocalMktDate struct {
+	dateValue
+}
+
+func NewLocalMktDate(value Date) *LocalMktDate {
+	return &LocalMktDate{dateValue{value: value, valid: true}}
+}
+
+// MonthYearValue is the value of MonthYear fields in the YYYYMM, YYYYMMDD or YYYYMMwN format.
+type MonthYearValue struct {
+	value MonthYear
+	valid bool
+}
+
+func NewMonthYearValue(value MonthYear) *MonthYearValue {
+	return &MonthYearValue{value: value, valid: true}
+}
+
+// Set assigns the field value stored as MonthYear.
+func (v *MonthYearValue) Set(d interface{}) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	if res, ok := d.(MonthYear); ok {
+		v.value, v.valid = res, true
+		return nil
+	}
+
+	return fmt.Errorf("could not convert %s to %s", d, "MonthYear")
+}
+
+func (v *MonthYearValue) IsNull() bool {
+	return !v.valid
+}
+func (v *MonthYearValue) IsEmpty() bool {
+	return !v.valid
+}
+
+func (v *MonthYearValue) Value() interface{} {
+	return v.value
+}
+
+func (v *MonthYearValue) ToBytes() []byte {
+	if !v.valid {
+		return nil
+	}
+	return []byte(v.value.String())
+}
+
+func (v *MonthYearValue) WriteBytes(writer *bytes.Buffer) bool {
+	if !v.valid {
+		return false
+	}
+	_, _ = writer.WriteString(v.value.String())
+	return true
+}
+
+func (v *MonthYearValue) FromBytes(d []byte) error {
+	if d == nil {
+		v.valid = false
+		return nil
+	}
+
+	if len(d) < 6 || !isDigits(d[:6]) {
+		return fmt.Errorf("%w: invalid month-year %q", ErrInvalidTime, d)
+	}
+
+	value := MonthYear{Year: atoi(d[:4]), Month: time.Month(atoi(d[4:6]))}
+	if value.Month < time.January || value.Month > time.December {
+		return fmt.Errorf("%w: invalid month %q", ErrInvalidTime, d)
+	}
+
+	switch suffix := d[6:]; {
+	case len(suffix) == 0:
+	case len(suffix) == 2 && suffix[0] == 'w' && suffix[1] >= '1' && suffix[1] <= '5':
+		value.Week = int(suffix[1] - '0')
+	case len(suffix) == 2 && isDigits(suffix):
+		value.Day = atoi(suffix)
+		if value.Day < 1 || value.Day > daysIn(value.Year, value.Month) {
+			return fmt.Errorf("%w: invalid day %q", ErrInvalidTime, d)
+		}
+	default:
+		return fmt.Errorf("%w: invalid month-year %q", ErrInvalidTime, d)
+	}
+
+	v.value, v.valid = value, true
+	return nil
+}
+
+func (v *MonthYearValue) String() string {
+	if !v.valid {
+		return ""
+	}
+	return v.value.String()
+}
+
+// parseTimestamp parses the YYYYMMDD-HH:MM:SS[.s...] format. If tz is true, the seconds are optional
+// and the time zone is required.
+func parseTimestamp(d []byte, tz bool) (time.Time, error) {
+	if len(d) < 9 || d[8] != '-' {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q", d)
+	}
+
+	year, month, day, err := parseDate(d[:8])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	hour, minute, sec, nsec, rest, err := parseClock(d[9:], tz)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc := time.UTC
+	if tz {
+		if loc, err = parseZone(rest); err != nil {
+			return time.Time{}, err
+		}
+	} else if len(rest) > 0 {
+		return time.Time{}, fmt.Errorf("unexpected suffix %q", rest)
+	}
+
+	return time.Date(year, month, day, hour, minute, sec, nsec, loc), nil
+}
+
+// parseDate parses the YYYYMMDD format.
+func parseDate(d []byte) (year int, month time.Month, day int, err error) {
+	if len(d) != 8 || !isDigits(d) {
+		return 0, 0, 0, fmt.Errorf("invalid date %q", d)
+	}
+
+	year, month, day = atoi(d[:4]), time.Month(atoi(d[4:6])), atoi(d[6:8])
+	if month < time.January || month > time.December || day < 1 || day > daysIn(year, month) {
+		return 0, 0, 0, fmt.Errorf("invalid date %q", d)
+	}
+
+	return year, month, day, nil
+}
+
+// parseClock parses the HH:MM:SS format followed by any number of fractional digits
+// and returns the rest of the data. If the seconds are optional, HH:MM is accepted as well.
+// The digits beyond nanoseconds are truncated.
+func parseClock(d []byte, optionalSeconds bool) (hour, minute, sec, nsec int, rest []byte, err error) {
+	invalid := func() (int, int, int, int, []byte, error) {
+		return 0, 0, 0, 0, nil, fmt.Errorf("invalid time %q", d)
+	}
+
+	if len(d) < 5 || d[2] != ':' || !isDigits(d[:2]) || !isDigits(d[3:5]) {
+		return invalid()
+	}
+	hour, minute = atoi(d[:2]), atoi(d[3:5])
+	rest = d[5:]
+
+	if len(rest) > 0 && rest[0] == ':' {
+		if len(rest) < 3 || !isDigits(rest[1:3]) {
+			return invalid()
+		}
+		sec = atoi(rest[1:3])
+		rest = rest[3:]
+
+		if len(rest) > 0 && rest[0] == '.' {
+			n := 1
+			for n < len(rest) && rest[n] >= '0' && rest[n] <= '9' {
+				if n <= 9 {
+					nsec = nsec*10 + int(rest[n]-'0')
+				}
+				n++
+			}
+			if n == 1 {
+				return invalid()
+			}
+			for i := n; i <= 9; i++ {
+				nsec *= 10
+			}
+			rest = rest[n:]
+		}
+	} else if !optionalSeconds {
+		return invalid()
+	}
+
+	// Leap seconds are allowed.
+	if hour > 23 || minute > 59 || sec > 60 {
+		return invalid()
+	}
+
+	return hour, minute, sec, nsec, rest, nil
+}
+
+// parseZone parses the Z, ±hh or ±hh:mm formats.
+func parseZone(d []byte) (*time.Location, error) {
+	if len(d) == 1 && d[0] == 'Z' {
+		return time.UTC, nil
+	}
+
+	if (len(d) != 3 && len(d) != 6) || (d[0] != '+' && d[0] != '-') || !isDigits(d[1:3]) ||
+		(len(d) == 6 && (d[3] != ':' || !isDigits(d[4:6]))) {
+		return nil, fmt.Errorf("invalid time zone %q", d)
+	}
+
+	offset := atoi(d[1:3]) * 3600
+	if len(d) == 6 {
+		offset += atoi(d[4:6]) * 60
+	}
+	if d[0] == '-' {
+		offset = -offset
+	}
+	if offset == 0 {
+		return time.UTC, nil
+	}
+
+	return time.FixedZone("", offset), nil
+}
+
+func appendDate(b []byte, year int, month time.Month, day int) []byte {
+	b = appendDigits(b, year, 4)
+	b = appendDigits(b, int(month), 2)
+
+	return appendDigits(b, day, 2)
+}
+
+func appendClock(b []byte, t time.Time, precision TimePrecision) []byte {
+	b = appendDigits(b, t.Hour(), 2)
+	b = append(b, ':')
+	b = appendDigits(b, t.Minute(), 2)
+	b = append(b, ':')
+	b = appendDigits(b, t.Second(), 2)
+
+	if digits := precision.Digits(); digits > 0 {
+		b = append(b, '.')
+		b = appendDigits(b, t.Nanosecond()/pow10Int(9-digits), digits)
+	}
+
+	return b
+}
+
+func appendZone(b []byte, t time.Time) []byte {
+	_, offset := t.Zone()
+	if offset == 0 {
+		return append(b, 'Z')
+	}
+
+	sign := byte('+')
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	b = append(b, sign)
+	b = appendDigits(b, offset/3600, 2)
+	b = append(b, ':')
+
+	return appendDigits(b, offset%3600/60, 2)
+}
+
+// appendDigits appends a non-negative number padded with zeros to the width.
+func appendDigits(b []byte, n, width int) []byte {
+	if n < 0 {
+		n = 0
+	}
+
+	start := len(b)
+	b = strconv.AppendInt(b, int64(n), 10)
+	if pad := width - (len(b) - start); pad > 0 {
+		b = append(b, make([]byte, pad)...)
+		copy(b[start+pad:], b[start:len(b)-pad])
+		for i := start; i < start+pad; i++ {
+			b[i] = '0'
+		}
+	}
+
+	return b
+}
+
+func pow10Int(n int) int {
+	p := 1
+	for ; n > 0; n-- {
+		p *= 10
+	}
+
+	return p
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func isDigits(d []byte) bool {
+	if len(d) == 0 {
+		return false
+	}
+
+	for _, c := range d {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func atoi(digits []byte) int {
+	var n int
+	for _, c := range digits {
+		n = n*10 + int(c-'0')
+	}
+
+	return n
+}
@@ -0,0 +1,156 @@
+package fix
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUTCTimestamp(t *testing.T) {
+	for value, expected := range map[string]time.Time{
+		"20240615-09:30:01":              time.Date(2024, 6, 15, 9, 30, 1, 0, time.UTC),
+		"20240615-09:30:01.5":            time.Date(2024, 6, 15, 9, 30, 1, 500e6, time.UTC),
+		"20240615-09:30:01.123":          time.Date(2024, 6, 15, 9, 30, 1, 123e6, time.UTC),
+		"20240615-09:30:01.123456":       time.Date(2024, 6, 15, 9, 30, 1, 123456e3, time.UTC),
+		"20240615-09:30:01.123456789":    time.Date(2024, 6, 15, 9, 30, 1, 123456789, time.UTC),
+		"20240615-09:30:01.123456789123": time.Date(2024, 6, 15, 9, 30, 1, 123456789, time.UTC),
+		"20161231-23:59:60":              time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		v := &UTCTimestamp{}
+		if err := v.FromBytes([]byte(value)); err != nil {
+			t.Fatalf("unexpected error for %s: %s", value, err)
+		}
+		if !v.Value().(time.Time).Equal(expected) {
+			t.Fatalf("unexpected value for %s: %s", value, v.Value())
+		}
+	}
+
+	for _, invalid := range []string{
+		"", "20240615", "20240615-09:30", "20240615-9:30:01", "20241315-09:30:01", "20240230-09:30:01",
+		"20240615-24:00:00", "20240615-09:30:01.", "20240615-09:30:01Z", "20240615 09:30:01",
+	} {
+		if err := (&UTCTimestamp{}).FromBytes([]byte(invalid)); !errors.Is(err, ErrInvalidTime) {
+			t.Fatalf("unexpected error for %q: %v", invalid, err)
+		}
+	}
+
+	value := time.Date(2024, 6, 15, 12, 30, 1, 123456789, time.FixedZone("", 3*3600))
+	for precision, expected := range map[TimePrecision]string{
+		Seconds:      "20240615-09:30:01",
+		Milliseconds: "20240615-09:30:01.123",
+		Microseconds: "20240615-09:30:01.123456",
+		Nanoseconds:  "20240615-09:30:01.123456789",
+	} {
+		v := NewUTCTimestamp(value).WithPrecision(precision)
+		if s := string(v.ToBytes()); s != expected {
+			t.Fatalf("unexpected %s value: %s", precision, s)
+		}
+
+		template := NewKeyValue("52", v).AsTemplate().Value.(*UTCTimestamp)
+		if template.Precision() != precision || !template.IsNull() {
+			t.Fatalf("unexpected template: %v", template)
+		}
+	}
+
+	if s := string(NewUTCTimestamp(value).ToBytes()); s != value.UTC().Format(TimeLayout) {
+		t.Fatalf("the default precision does not match the layout: %s", s)
+	}
+}
+
+func TestTZTimestamp(t *testing.T) {
+	for value, expected := range map[string]string{
+		"20240615-09:30:01Z":             "20240615-09:30:01.000Z",
+		"20240615-09:30Z":                "20240615-09:30:00.000Z",
+		"20240615-09:30:01.25+05":        "20240615-09:30:01.250+05:00",
+		"20240615-09:30:01.123456-03:30": "20240615-09:30:01.123-03:30",
+		"20240615-09:30:01+00:00":        "20240615-09:30:01.000Z",
+	} {
+		v := &TZTimestamp{}
+		if err := v.FromBytes([]byte(value)); err != nil {
+			t.Fatalf("unexpected error for %s: %s", value, err)
+		}
+		if s := v.String(); s != expected {
+			t.Fatalf("unexpected value for %s: %s", value, s)
+		}
+	}
+
+	v := &TZTimestamp{}
+	_ = v.FromBytes([]byte("20240615-09:30:01-03:30"))
+	if !v.Value().(time.Time).Equal(time.Date(2024, 6, 15, 13, 0, 1, 0, time.UTC)) {
+		t.Fatalf("unexpected value: %s", v.Value())
+	}
+
+	for _, invalid := range []string{"20240615-09:30:01", "20240615-09:30:01+5", "20240615-09:30:01+05:3", "20240615-09:30:01X"} {
+		if err := (&TZTimestamp{}).FromBytes([]byte(invalid)); !errors.Is(err, ErrInvalidTime) {
+			t.Fatalf("unexpected error for %q: %v", invalid, err)
+		}
+	}
+}
+
+func TestUTCTimeOnly(t *testing.T) {
+	v := &UTCTimeOnly{}
+	if err := v.FromBytes([]byte("23:59:58.123456")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !v.Value().(time.Time).Equal(time.Date(0, 1, 1, 23, 59, 58, 123456e3, time.UTC)) {
+		t.Fatalf("unexpected value: %s", v.Value())
+	}
+	if s := v.WithPrecision(Microseconds).String(); s != "23:59:58.123456" {
+		t.Fatalf("unexpected value: %s", s)
+	}
+
+	v = NewUTCTimeOnly(time.Date(2024, 6, 15, 12, 30, 1, 0, time.FixedZone("", 3600))).WithPrecision(Seconds)
+	if s := v.String(); s != "11:30:01" {
+		t.Fatalf("unexpected value: %s", s)
+	}
+
+	for _, invalid := range []string{"23:59", "23:59:58Z", "24:00:00", "23:60:00", "23:59:5"} {
+		if err := (&UTCTimeOnly{}).FromBytes([]byte(invalid)); !errors.Is(err, ErrInvalidTime) {
+			t.Fatalf("unexpected error for %q: %v", invalid, err)
+		}
+	}
+}
+
+func TestDates(t *testing.T) {
+	date := &LocalMktDate{}
+	if err := date.FromBytes([]byte("20240229")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if date.Value().(Date) != (Date{Year: 2024, Month: time.February, Day: 29}) {
+		t.Fatalf("unexpected value: %v", date.Value())
+	}
+	if err := date.FromBytes([]byte("20230229")); !errors.Is(err, ErrInvalidTime) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	utcDate := &UTCDateOnly{}
+	if err := utcDate.Set(time.Date(2024, 1, 2, 23, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s := string(utcDate.ToBytes()); s != "20240102" {
+		t.Fatalf("unexpected value: %s", s)
+	}
+	if err := utcDate.Set("20240102"); err == nil {
+		t.Fatalf("an error is expected")
+	}
+
+	for value, expected := range map[string]MonthYear{
+		"202406":   {Year: 2024, Month: time.June},
+		"20240615": {Year: 2024, Month: time.June, Day: 15},
+		"202406w3": {Year: 2024, Month: time.June, Week: 3},
+	} {
+		v := &MonthYearValue{}
+		if err := v.FromBytes([]byte(value)); err != nil {
+			t.Fatalf("unexpected error for %s: %s", value, err)
+		}
+		if v.Value().(MonthYear) != expected || v.String() != value {
+			t.Fatalf("unexpected value for %s: %v", value, v.Value())
+		}
+	}
+
+	for _, invalid := range []string{"2024", "202413", "202406w6", "20240631", "2024061"} {
+		if err := (&MonthYearValue{}).FromBytes([]byte(invalid)); !errors.Is(err, ErrInvalidTime) {
+			t.Fatalf("unexpected error for %q: %v", invalid, err)
+		}
+	}
+}
@@ -3,13 +3,14 @@ package fix
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 )
 
 // ValueByTag locates a value by its tag in a FIX message stored as a byte array.
 func ValueByTag(msg []byte, tag string) ([]byte, error) {
 	start := bytes.Index(msg, bytes.Join([][]byte{{1}, []byte(tag), {61}}, nil))
 	if len(msg) <= len(tag) {
-		return nil, fmt.Errorf("could not find the tag: %s, the message is too short: %s", tag, msg)
+		return nil, fmt.Errorf("could not find the tag: %s, the message is too short: %s", tag, Redact(msg))
 	}
 	if start == -1 && !bytes.Equal(bytes.Join([][]byte{[]byte(tag)}, nil), msg[:len(tag)]) {
 		return nil, fmt.Errorf("the tag is not found: %s", tag)
@@ -23,3 +24,146 @@ func ValueByTag(msg []byte, tag string) ([]byte, error) {
 	}
 	return msg[start:end], nil
 }
+
+// dataLengthTags maps the data fields that might contain an SOH character to the fields specifying their length.
+var dataLengthTags = map[string]string{
+	"89":   "93",   // Signature, SignatureLength
+	"91":   "90",   // SecureData, SecureDataLen
+	"96":   "95",   // RawData, RawDataLength
+	"213":  "212",  // XmlData, XmlDataLen
+	"349":  "348",  // EncodedIssuer, EncodedIssuerLen
+	"351":  "350",  // EncodedSecurityDesc, EncodedSecurityDescLen
+	"353":  "352",  // EncodedListExecInst, EncodedListExecInstLen
+	"355":  "354",  // EncodedText, EncodedTextLen
+	"357":  "356",  // EncodedSubject, EncodedSubjectLen
+	"359":  "358",  // EncodedHeadline, EncodedHeadlineLen
+	"361":  "360",  // EncodedAllocText, EncodedAllocTextLen
+	"363":  "362",  // EncodedUnderlyingIssuer, EncodedUnderlyingIssuerLen
+	"365":  "364",  // EncodedUnderlyingSecurityDesc, EncodedUnderlyingSecurityDescLen
+	"446":  "445",  // EncodedListStatusText, EncodedListStatusTextLen
+	"1402": "1401", // EncryptedPassword, EncryptedPasswordLen
+	"1404": "1403", // EncryptedNewPassword, EncryptedNewPasswordLen
+}
+
+// DataLengthTag returns the tag of the field specifying the length of a data field,
+// which might contain SOH characters.
+func DataLengthTag(tag string) (lengthTag string, ok bool) {
+	lengthTag, ok = dataLengthTags[tag]
+	return lengthTag, ok
+}
+
+// EachField calls fn for each field of a raw FIX message in order until fn returns false.
+// The values of data fields are located using the preceding length fields, so they might contain SOH characters.
+func EachField(msg []byte, fn func(tag string, value []byte) bool) {
+	eachField(msg, func(tag string, start, end int) bool {
+		return fn(tag, msg[start:end])
+	})
+}
+
+// eachField calls fn with the tag and the value boundaries of each field of a raw FIX message.
+func eachField(msg []byte, fn func(tag string, start, end int) bool) {
+	var (
+		prevTag   string
+		prevValue []byte
+	)
+
+	for pos := 0; pos < len(msg); {
+		eq := bytes.IndexByte(msg[pos:], '=')
+		if eq == -1 {
+			return
+		}
+		tag := string(msg[pos : pos+eq])
+		start := pos + eq + 1
+
+		end := -1
+		if lengthTag, ok := dataLengthTags[tag]; ok && lengthTag == prevTag {
+			if length, err := strconv.Atoi(string(prevValue)); err == nil && length >= 0 && start+length <= len(msg) {
+				end = start + length
+			}
+		}
+		if end == -1 {
+			end = bytes.IndexByte(msg[start:], DelimiterChar)
+			if end == -1 {
+				end = len(msg)
+			} else {
+				end += start
+			}
+		}
+
+		if !fn(tag, start, end) {
+			return
+		}
+
+		prevTag, prevValue = tag, msg[start:end]
+		pos = end + 1
+	}
+}
+
+// dataLengthTagIDs is dataLengthTags with numeric tags.
+var dataLengthTagIDs = func() map[int]int {
+	tags := make(map[int]int, len(dataLengthTags))
+	for tag, lengthTag := range dataLengthTags {
+		tagID, _ := strconv.Atoi(tag)
+		lengthTagID, _ := strconv.Atoi(lengthTag)
+		tags[tagID] = lengthTagID
+	}
+
+	return tags
+}()
+
+// maxTagDigits limits the tag numbers so that they do not overflow.
+const maxTagDigits = 9
+
+// Token is the position of a field in a raw FIX message.
+type Token struct {
+	Tag int
+	// Start and End are the bounds of the value.
+	Start, End int
+}
+
+// Tokenize splits a raw FIX message into fields in a single pass without copying and appends them to tokens.
+// The values of data fields are located using the preceding length fields, so they might contain SOH characters.
+func Tokenize(msg []byte, tokens []Token) ([]Token, error) {
+	return TokenizeWith(msg, tokens, dataLengthTagIDs)
+}
+
+// TokenizeWith is Tokenize locating the values of the data fields by the length fields from lengthTags,
+// which are usually returned by DataLengthTags for the message template.
+func TokenizeWith(msg []byte, tokens []Token, lengthTags map[int]int) ([]Token, error) {
+	for pos := 0; pos < len(msg); {
+		tag := 0
+		i := pos
+		for ; i < len(msg) && msg[i] != '='; i++ {
+			c := msg[i]
+			if c < '0' || c > '9' || i-pos >= maxTagDigits {
+				return tokens, fmt.Errorf("%w at %d", ErrInvalidTag, pos)
+			}
+			tag = tag*10 + int(c-'0')
+		}
+		if i == pos || i == len(msg) {
+			return tokens, fmt.Errorf("%w at %d", ErrInvalidTag, pos)
+		}
+
+		start := i + 1
+		end := -1
+		if lengthTag, ok := lengthTags[tag]; ok && len(tokens) > 0 && tokens[len(tokens)-1].Tag == lengthTag {
+			prev := tokens[len(tokens)-1]
+			if length, err := bytesToInt(msg[prev.Start:prev.End]); err == nil && length >= 0 && start+length <= len(msg) {
+				end = start + length
+			}
+		}
+		if end == -1 {
+			end = bytes.IndexByte(msg[start:], DelimiterChar)
+			if end == -1 {
+				end = len(msg)
+			} else {
+				end += start
+			}
+		}
+
+		tokens = append(tokens, Token{Tag: tag, Start: start, End: end})
+		pos = end + 1
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,36 @@
+package fix
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	msg := []byte("8=FIX.4.4\x0135=A\x0195=3\x0196=a\x01b\x0158=\x0110=000\x01")
+
+	tokens, err := Tokenize(msg, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var fields [][2]any
+	for _, token := range tokens {
+		fields = append(fields, [2]any{token.Tag, string(msg[token.Start:token.End])})
+	}
+	expected := [][2]any{{8, "FIX.4.4"}, {35, "A"}, {95, "3"}, {96, "a\x01b"}, {58, ""}, {10, "000"}}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Fatalf("unexpected fields: %v", fields)
+	}
+
+	for _, invalid := range []string{
+		"8=FIX.4.4\x01=A\x01",
+		"8=FIX.4.4\x01A=A\x01",
+		"8=FIX.4.4\x0135\x01",
+		"8=FIX.4.4\x011234567890=A\x01",
+	} {
+		if _, err = Tokenize([]byte(invalid), nil); !errors.Is(err, ErrInvalidTag) {
+			t.Fatalf("unexpected error for %q: %v", invalid, err)
+		}
+	}
+}
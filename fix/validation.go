@@ -0,0 +1,98 @@
+package fix
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// The reasons of the failures of the generated Validate methods.
+var (
+	ErrRequiredFieldMissing    = errors.New("required field missing")
+	ErrConditionalFieldMissing = errors.New("conditionally required field missing")
+)
+
+// ValidationError is returned by the generated Validate methods when a required field,
+// group or component is not set. It matches its Reason with errors.Is.
+type ValidationError struct {
+	// Reason is either ErrRequiredFieldMissing or ErrConditionalFieldMissing.
+	Reason error
+
+	// Tag is the tag of the missing field or of the NumInGroup field of the missing group,
+	// it is empty for a missing component.
+	Tag string
+
+	// Name is the name of the missing item.
+	Name string
+
+	// Condition is the rule requiring the item, e.g. OrdType == 2, it is empty for the required items.
+	Condition string
+}
+
+// NewValidationError creates an error caused by a missing item. The condition is empty for the required items.
+func NewValidationError(tag, name, condition string) *ValidationError {
+	if condition == "" {
+		return &ValidationError{Reason: ErrRequiredFieldMissing, Tag: tag, Name: name}
+	}
+
+	return &ValidationError{Reason: ErrConditionalFieldMissing, Tag: tag, Name: name, Condition: condition}
+}
+
+func (e *ValidationError) Error() string {
+	item := e.Name
+	if e.Tag != "" {
+		item = fmt.Sprintf("%s(%s)", e.Name, e.Tag)
+	}
+
+	if e.Condition != "" {
+		return fmt.Sprintf("%s: %s when %s", e.Reason, item, e.Condition)
+	}
+
+	return fmt.Sprintf("%s: %s", e.Reason, item)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Reason
+}
+
+// TagID returns the tag as a number or zero if it is not numeric.
+func (e *ValidationError) TagID() int {
+	tag, _ := strconv.Atoi(e.Tag)
+	return tag
+}
+
+// IsSet returns true if a field has a value, a group has entries, or any item of a component is set.
+func IsSet(item Item) bool {
+	switch value := item.(type) {
+	case *KeyValue:
+		return hasValue(value)
+	case *Group:
+		return len(value.Entries()) > 0
+	case *Component:
+		for _, member := range value.Items() {
+			if IsSet(member) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return !item.IsEmpty()
+}
+
+// FieldValue returns the wire value of a field, or an empty string if the field is not set.
+// It is used by the generated Validate methods to check the conditions.
+func FieldValue(item Item) string {
+	kv, ok := item.(*KeyValue)
+	if !ok || !hasValue(kv) {
+		return ""
+	}
+
+	return string(kv.Value.ToBytes())
+}
+
+// hasValue returns true if the field is written to the message.
+func hasValue(kv *KeyValue) bool {
+	return kv != nil && kv.Value != nil && !kv.Value.IsNull() && !kv.Value.IsEmpty()
+}
@@ -0,0 +1,45 @@
+package fix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsSet(t *testing.T) {
+	field := NewKeyValue("1", &String{})
+	group := NewGroup("2", NewKeyValue("3", &String{}))
+	component := NewComponent(NewKeyValue("4", &String{}), NewGroup("5", NewKeyValue("6", &String{})))
+
+	if IsSet(field) || IsSet(group) || IsSet(component) {
+		t.Fatalf("the empty items are set")
+	}
+	if FieldValue(field) != "" {
+		t.Fatalf("unexpected value of the empty field: %s", FieldValue(field))
+	}
+
+	_ = field.Load().Set("value")
+	group.AddEntry(group.AsTemplate())
+	_ = component.Get(0).(*KeyValue).Load().Set("value")
+
+	if !IsSet(field) || !IsSet(group) || !IsSet(component) {
+		t.Fatalf("the filled items are not set")
+	}
+	if FieldValue(field) != "value" || FieldValue(group) != "" {
+		t.Fatalf("unexpected values: %s, %s", FieldValue(field), FieldValue(group))
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	err := NewValidationError("44", "Price", "OrdType == ^Limit")
+	if !errors.Is(err, ErrConditionalFieldMissing) || err.TagID() != 44 {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err.Error() != "conditionally required field missing: Price(44) when OrdType == ^Limit" {
+		t.Fatalf("unexpected message: %s", err)
+	}
+
+	err = NewValidationError("", "Instrument", "")
+	if !errors.Is(err, ErrRequiredFieldMissing) || err.TagID() != 0 || err.Error() != "required field missing: Instrument" {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
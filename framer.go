@@ -0,0 +1,225 @@
+package simplefixgo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// DefaultMaxMessageSize is the default limit of the incoming message size in bytes.
+const DefaultMaxMessageSize = 1 << 20
+
+const (
+	framerReadSize = 4096
+
+	// maxBeginStringSize and maxBodyLengthSize limit the fields preceding the body
+	// so that garbage is not mistaken for a message header.
+	maxBeginStringSize = 16
+	maxBodyLengthSize  = 10
+
+	// trailerSize is the size of the CheckSum field, e.g. "10=123<SOH>".
+	trailerSize = 7
+)
+
+var (
+	beginStringPrefix          = []byte("8=FIX")
+	delimitedBeginStringPrefix = []byte("\x018=FIX")
+	bodyLengthPrefix           = []byte("9=")
+	checkSumPrefix             = []byte("10=")
+)
+
+var (
+	ErrMessageTooLarge = errors.New("the message exceeds the maximum size")
+	ErrInvalidFrame    = errors.New("invalid message frame")
+
+	// ErrInvalidBodyLength is the same error as fix.ErrInvalidBodyLength returned by fix.VerifyIntegrity,
+	// so the framing and integrity failures match each other.
+	ErrInvalidBodyLength = fix.ErrInvalidBodyLength
+)
+
+// framer splits a stream into FIX messages using the BodyLength field.
+// It looks for the BeginString field, reads the BodyLength field and consumes
+// exactly BodyLength bytes followed by the CheckSum field.
+// The bytes that do not form a valid message are discarded, and the framer resynchronises
+// on the next BeginString field, which is looked for only at the start of a field,
+// i.e. at the beginning of the stream or after a delimiter.
+type framer struct {
+	r              io.Reader
+	maxMessageSize int
+
+	buf []byte
+	off int
+	// fieldStart is true if buf[off] is the beginning of the stream or follows a delimiter.
+	fieldStart bool
+
+	// onRead is called for each chunk read from the stream.
+	onRead func(n int)
+	// onDiscard is called for each discarded part of the stream along with the reason.
+	onDiscard func(n int, reason error)
+}
+
+func newFramer(r io.Reader, maxMessageSize int) *framer {
+	return &framer{
+		r:              r,
+		maxMessageSize: maxMessageSize,
+		fieldStart:     true,
+		onRead:         func(int) {},
+		onDiscard:      func(int, error) {},
+	}
+}
+
+// next returns the next message from the stream.
+// The returned slice is not reused by the framer.
+func (f *framer) next() ([]byte, error) {
+	for {
+		msg, ok := f.parse()
+		if ok {
+			return msg, nil
+		}
+
+		if err := f.fill(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parse extracts a message from the buffered data. It returns false if more data is required.
+func (f *framer) parse() ([]byte, bool) {
+	for {
+		data := f.buf[f.off:]
+
+		start := f.beginString(data)
+		if start == -1 {
+			// The end of the data might contain the beginning of a BeginString field.
+			keep := len(delimitedBeginStringPrefix) - 1
+			if len(data) > keep {
+				f.discard(len(data)-keep, ErrInvalidFrame)
+			}
+			return nil, false
+		}
+		if start > 0 {
+			f.discard(start, ErrInvalidFrame)
+			data = data[start:]
+		}
+
+		msgLen, err := f.frameLength(data)
+		if err != nil {
+			// Skip the found BeginString prefix to look for the next one.
+			f.discard(1, err)
+			continue
+		}
+		if msgLen == 0 {
+			return nil, false
+		}
+
+		msg := make([]byte, msgLen)
+		copy(msg, data)
+		f.off += msgLen
+		f.fieldStart = true
+
+		return msg, true
+	}
+}
+
+// beginString returns the offset of the first BeginString field in data or -1 if it is not found.
+// A BeginString prefix inside a field value, e.g. in "58=FIX", is ignored.
+func (f *framer) beginString(data []byte) int {
+	if f.fieldStart && bytes.HasPrefix(data, beginStringPrefix) {
+		return 0
+	}
+
+	start := bytes.Index(data, delimitedBeginStringPrefix)
+	if start == -1 {
+		return -1
+	}
+
+	return start + 1
+}
+
+// frameLength returns the length of the message at the beginning of data
+// or zero if the message is incomplete.
+func (f *framer) frameLength(data []byte) (int, error) {
+	beginStringEnd := bytes.IndexByte(data, 1)
+	if beginStringEnd == -1 {
+		if len(data) > maxBeginStringSize {
+			return 0, ErrInvalidFrame
+		}
+		return 0, nil
+	}
+	if beginStringEnd > maxBeginStringSize || bytes.IndexByte(data[2:beginStringEnd], '=') != -1 {
+		return 0, ErrInvalidFrame
+	}
+
+	field := data[beginStringEnd+1:]
+	if len(field) < len(bodyLengthPrefix) {
+		return 0, nil
+	}
+	if !bytes.HasPrefix(field, bodyLengthPrefix) {
+		return 0, fmt.Errorf("%w: the BodyLength field is missing", ErrInvalidFrame)
+	}
+	field = field[len(bodyLengthPrefix):]
+
+	bodyLengthEnd := bytes.IndexByte(field, 1)
+	if bodyLengthEnd == -1 {
+		if len(field) > maxBodyLengthSize {
+			return 0, ErrInvalidBodyLength
+		}
+		return 0, nil
+	}
+
+	bodyLength, err := strconv.Atoi(string(field[:bodyLengthEnd]))
+	if err != nil || bodyLength <= 0 || bodyLengthEnd > maxBodyLengthSize {
+		return 0, ErrInvalidBodyLength
+	}
+
+	bodyStart := beginStringEnd + 1 + len(bodyLengthPrefix) + bodyLengthEnd + 1
+	msgLen := bodyStart + bodyLength + trailerSize
+	if f.maxMessageSize > 0 && msgLen > f.maxMessageSize {
+		return 0, fmt.Errorf("%w: %d > %d", ErrMessageTooLarge, msgLen, f.maxMessageSize)
+	}
+	if len(data) < msgLen {
+		return 0, nil
+	}
+
+	trailer := data[bodyStart+bodyLength : msgLen]
+	if !bytes.HasPrefix(trailer, checkSumPrefix) || trailer[trailerSize-1] != 1 {
+		return 0, fmt.Errorf("%w: the CheckSum field is not found after %d bytes of the body", ErrInvalidBodyLength, bodyLength)
+	}
+
+	return msgLen, nil
+}
+
+func (f *framer) discard(n int, reason error) {
+	f.fieldStart = f.buf[f.off+n-1] == 1
+	f.off += n
+	f.onDiscard(n, reason)
+}
+
+// fill reads the next chunk of the stream into the buffer.
+func (f *framer) fill() error {
+	if f.off > 0 {
+		n := copy(f.buf, f.buf[f.off:])
+		f.buf = f.buf[:n]
+		f.off = 0
+	}
+
+	if cap(f.buf)-len(f.buf) < framerReadSize {
+		buf := make([]byte, len(f.buf), 2*cap(f.buf)+framerReadSize)
+		copy(buf, f.buf)
+		f.buf = buf
+	}
+
+	n, err := f.r.Read(f.buf[len(f.buf):cap(f.buf)])
+	f.buf = f.buf[:len(f.buf)+n]
+	if n > 0 {
+		f.onRead(n)
+		// An error returned along with the data is returned again by the next call.
+		return nil
+	}
+
+	return err
+}
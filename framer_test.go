@@ -0,0 +1,143 @@
+package simplefixgo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// makeFrame returns a message with the specified body, a valid BodyLength and a CheckSum.
+func makeFrame(body string) string {
+	msg := fmt.Sprintf("8=FIX.4.4\x019=%d\x01%s", len(body), body)
+
+	var sum int
+	for i := 0; i < len(msg); i++ {
+		sum += int(msg[i])
+	}
+
+	return fmt.Sprintf("%s10=%03d\x01", msg, sum%256)
+}
+
+// oneByteReader returns the data byte by byte to check incomplete frames.
+type oneByteReader struct {
+	data []byte
+}
+
+func (r *oneByteReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	p[0] = r.data[0]
+	r.data = r.data[1:]
+
+	return 1, nil
+}
+
+func readFrames(t *testing.T, r io.Reader, maxMessageSize int) ([]string, int) {
+	f := newFramer(r, maxMessageSize)
+
+	var discarded int
+	f.onDiscard = func(n int, _ error) {
+		discarded += n
+	}
+
+	var frames []string
+	for {
+		msg, err := f.next()
+		if errors.Is(err, io.EOF) {
+			return frames, discarded
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		frames = append(frames, string(msg))
+	}
+}
+
+func TestFramer(t *testing.T) {
+	heartbeatBody := "35=0\x0134=1\x0149=sender\x0156=target\x01"
+	heartbeat := makeFrame(heartbeatBody)
+	bodyLength := fmt.Sprintf("9=%d", len(heartbeatBody))
+	rawData := makeFrame("35=A\x0134=2\x0195=11\x0196=a\x0110=000\x01b\x01")
+	logout := makeFrame("35=5\x0134=3\x01")
+	large := makeFrame("35=0\x0158=" + strings.Repeat("x", 100) + "\x01")
+
+	testCases := map[string]struct {
+		stream         string
+		maxMessageSize int
+		expected       []string
+		discarded      int
+	}{
+		"sequential messages": {
+			stream:   heartbeat + rawData + logout,
+			expected: []string{heartbeat, rawData, logout},
+		},
+		"garbage before the messages": {
+			stream:    "garbage\x0110=000\x01" + heartbeat + "8=FIX\x01" + logout,
+			expected:  []string{heartbeat, logout},
+			discarded: len("garbage\x0110=000\x01") + len("8=FIX\x01"),
+		},
+		"begin string inside a field value": {
+			stream:    "1=a\x0158" + heartbeat + logout,
+			expected:  []string{logout},
+			discarded: len("1=a\x0158") + len(heartbeat),
+		},
+		"too small body length": {
+			stream:    strings.Replace(heartbeat, bodyLength, "9=20", 1) + logout,
+			expected:  []string{logout},
+			discarded: len(heartbeat),
+		},
+		"too large body length": {
+			stream:    strings.Replace(heartbeat, bodyLength, "9=35", 1) + logout,
+			expected:  []string{logout},
+			discarded: len(heartbeat),
+		},
+		"invalid body length": {
+			stream:    strings.Replace(heartbeat, bodyLength, "9=x", 1) + logout,
+			expected:  []string{logout},
+			discarded: len(heartbeat) - len(bodyLength) + len("9=x"),
+		},
+		"missing body length": {
+			stream:    strings.Replace(heartbeat, bodyLength+"\x01", "", 1) + logout,
+			expected:  []string{logout},
+			discarded: len(heartbeat) - len(bodyLength) - 1,
+		},
+		"too large message": {
+			stream:         heartbeat + large + logout,
+			maxMessageSize: 100,
+			expected:       []string{heartbeat, logout},
+			discarded:      len(large),
+		},
+	}
+
+	for name, testCase := range testCases {
+		readers := map[string]io.Reader{
+			"whole":        bytes.NewReader([]byte(testCase.stream)),
+			"byte by byte": &oneByteReader{data: []byte(testCase.stream)},
+		}
+
+		for readerName, r := range readers {
+			frames, discarded := readFrames(t, r, testCase.maxMessageSize)
+			if len(frames) != len(testCase.expected) {
+				t.Fatalf("unexpected frames in case '%s' (%s): %q", name, readerName, frames)
+			}
+			for i, frame := range frames {
+				if frame != testCase.expected[i] {
+					t.Fatalf("unexpected frame in case '%s' (%s): %q, expected: %q", name, readerName, frame, testCase.expected[i])
+				}
+			}
+			if discarded != testCase.discarded {
+				t.Fatalf("unexpected number of discarded bytes in case '%s' (%s): %d, expected: %d",
+					name, readerName, discarded, testCase.discarded)
+			}
+		}
+	}
+}
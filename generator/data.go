@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+const lengthType = "LENGTH"
+
+// findDataFields finds the length fields of the data fields, which might contain SOH characters.
+// The length field is named after the data field with the Len or Length suffix,
+// otherwise it is expected to precede the data field in the messages and components.
+func (g *Generator) findDataFields() error {
+	byName := make(map[string]*Field, len(g.doc.Fields))
+	for _, field := range g.doc.Fields {
+		byName[field.Name] = field
+	}
+
+	preceding := make(map[string]string)
+	var walk func(members []*ComponentMember)
+	walk = func(members []*ComponentMember) {
+		for i, member := range members {
+			if member.XMLName.Local == FieldItem && i > 0 && members[i-1].XMLName.Local == FieldItem {
+				if _, ok := preceding[member.Name]; !ok {
+					preceding[member.Name] = members[i-1].Name
+				}
+			}
+			walk(member.Members)
+		}
+	}
+	for _, component := range g.doc.Messages {
+		walk(component.Members)
+	}
+	for _, component := range g.doc.Components {
+		walk(component.Members)
+	}
+	if g.doc.Header != nil {
+		walk(g.doc.Header.Members)
+	}
+	if g.doc.Trailer != nil {
+		walk(g.doc.Trailer.Members)
+	}
+
+	g.dataFields = make(map[string]*Field)
+	for _, field := range g.doc.Fields {
+		if g.typeCast[field.Type] != fixData {
+			continue
+		}
+
+		for _, name := range []string{field.Name + "Len", field.Name + "Length", preceding[field.Name]} {
+			if lengthField, ok := byName[name]; ok && strings.EqualFold(lengthField.Type, lengthType) {
+				g.dataFields[field.Name] = lengthField
+				break
+			}
+		}
+
+		if _, ok := g.dataFields[field.Name]; !ok {
+			return fmt.Errorf("could not find the length field of the data field %s", field.Name)
+		}
+	}
+
+	return nil
+}
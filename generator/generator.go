@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	gofmt "go/format"
+	"go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -25,11 +27,22 @@ type Generator struct {
 	config   *Config
 	libPkg   string
 	typeCast map[string]string
+	// typePrecision is the precision constructor of the temporal types with the format attribute.
+	typePrecision map[string]string
 
 	enums      map[string]*Field
 	fields     map[string]*Field
+	dataFields map[string]*Field
 	components map[string]*Component
 	groups     map[string]*ComponentMember
+
+	sessionOpts bool
+	selected    []string
+
+	layout     Layout
+	importPath string
+	// imports maps the package names, which might be selected in the generated code, to their import paths.
+	imports map[string]string
 }
 
 // NewGenerator creates a new Generator instance.
@@ -42,6 +55,12 @@ func NewGenerator(doc *Doc, config *Config, libPkg string) *Generator {
 	}
 }
 
+// SetSessionOpts enables the generation of the SessionOpts function, which is disabled by default,
+// since the function makes the generated package depend on the session package.
+func (g *Generator) SetSessionOpts(enabled bool) {
+	g.sessionOpts = enabled
+}
+
 func (g *Generator) checkName(name string) (err error) {
 	if name == "" {
 		return fmt.Errorf("the name is empty")
@@ -74,24 +93,15 @@ func (g *Generator) write(path, data string) (err error) {
 }
 
 func (g *Generator) makeFile(data, pkg string) string {
-	imports := []string{}
-
-	if strings.Contains(data, "fix.") {
-		// TODO: move
-		imports = append(imports, `"github.com/b2broker/simplefix-go/fix"`)
-	}
-	if strings.Contains(data, "messages.") {
-		// TODO: move
-		imports = append(imports, `"github.com/b2broker/simplefix-go/session/messages"`)
-	}
-	if strings.Contains(data, "time.") {
-		imports = append(imports, `"time"`)
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package "+pkg+"\n"+data, 0)
+	if err != nil {
+		panic(err)
 	}
 
 	source := g.mustExecuteTemplate(fileTemplateFormat, fileTemplate{
 		Data:    data,
 		Pkg:     pkg,
-		Imports: strings.Join(imports, "\n"),
+		Imports: strings.Join(g.fileImports(f), "\n"),
 	})
 
 	formatted, err := gofmt.Source([]byte(source))
@@ -103,65 +113,97 @@ func (g *Generator) makeFile(data, pkg string) string {
 }
 
 // Execute creates a separate file for each message.
+// The files are put into the subpackages of the output directory depending on the layout.
 func (g *Generator) Execute(outputDirPath string) (err error) {
-	// TODO: split generated code into packages
 	if err = g.prepare(); err != nil {
 		return err
 	}
-	od := filepath.Clean(outputDirPath)
 
-	dpkg := filepath.SplitList(od)[0]
+	// The session options are made first, so the generation fails before writing any files
+	// if the dictionary lacks a session message.
+	var sessionOpts string
+	if g.sessionOpts {
+		if sessionOpts, err = g.makeSessionOpts(); err != nil {
+			return err
+		}
+	}
 
-	pkg := strings.ReplaceAll(dpkg, "-", "_")
+	pkg := strings.ReplaceAll(filepath.Base(filepath.Clean(outputDirPath)), "-", "_")
 	if err = g.checkName(pkg); err != nil {
 		return fmt.Errorf("invalid package name %s: %w", pkg, err)
 	}
 
-	pathFormat := filepath.Join(outputDirPath, "%s.go")
-	err = g.write(fmt.Sprintf(pathFormat, "header"), g.makeFile(g.makeHeader(), pkg))
-	if err != nil {
+	if err = g.checkPackages(); err != nil {
 		return err
 	}
 
-	err = g.write(fmt.Sprintf(pathFormat, "trailer"), g.makeFile(g.makeTrailer(), pkg))
-	if err != nil {
+	if err = g.initImports(outputDirPath); err != nil {
 		return err
 	}
 
-	err = g.write(fmt.Sprintf(pathFormat, "fields"), g.makeFile(g.makeFieldTypes(), pkg))
-	if err != nil {
-		return err
+	files := []*generatedFile{
+		{dir: g.packageDir(componentsPackage, ""), name: "header", data: g.makeHeader()},
+		{dir: g.packageDir(componentsPackage, ""), name: "trailer", data: g.makeTrailer()},
+		{dir: g.packageDir(fieldsPackage, ""), name: "fields", data: g.makeFieldTypes()},
 	}
 
 	for _, enum := range g.enums {
-		err = g.write(fmt.Sprintf(pathFormat, "enum_"+strings.ToLower(enum.Name)), g.makeFile(g.makeEnum(enum), pkg))
-		if err != nil {
-			return err
-		}
+		files = append(files, &generatedFile{
+			dir:  g.packageDir(enumsPackage, ""),
+			name: "enum_" + strings.ToLower(enum.Name),
+			data: g.makeEnum(enum),
+		})
 	}
 
 	for _, message := range g.doc.Messages {
-		err = g.write(
-			fmt.Sprintf(pathFormat, strings.ToLower(message.Name)),
-			g.makeFile(g.makeMessage(message), pkg))
-		if err != nil {
-			return err
-		}
+		files = append(files, &generatedFile{
+			dir:  g.packageDir("", message.Name),
+			name: strings.ToLower(message.Name),
+			data: g.makeMessage(message),
+		})
+	}
+
+	files = append(files, &generatedFile{name: "router", data: g.makeRouter()})
+
+	if g.sessionOpts {
+		files = append(files, &generatedFile{name: "session_opts", data: sessionOpts})
 	}
 
 	for _, component := range g.components {
-		err = g.write(
-			fmt.Sprintf(pathFormat, strings.ToLower(component.Name)),
-			g.makeFile(g.makeComponent(component, g.makeComponentTypeName(component.Name)), pkg))
-		if err != nil {
+		files = append(files, &generatedFile{
+			dir:  g.packageDir(componentsPackage, ""),
+			name: strings.ToLower(component.Name),
+			data: strings.Join([]string{
+				g.makeComponent(component, g.makeComponentTypeName(component.Name)),
+				g.mustMakeValidate(g.makeComponentTypeName(component.Name), g.componentMembers(component)),
+			}, "\n"),
+		})
+	}
+
+	for _, group := range g.groups {
+		files = append(files, &generatedFile{
+			dir:  g.packageDir(componentsPackage, ""),
+			name: strings.ToLower(g.makeGroupTypeName(group.Name)),
+			data: g.makeGroupConstructor(group),
+		})
+	}
+
+	if g.layout == LayoutPackages {
+		if err = g.qualify(files); err != nil {
 			return err
 		}
 	}
 
-	for _, group := range g.groups {
-		err = g.write(
-			fmt.Sprintf(pathFormat, strings.ToLower(g.makeGroupTypeName(group.Name))),
-			g.makeFile(g.makeGroupConstructor(group), pkg))
+	for _, file := range files {
+		dirPath, filePkg := outputDirPath, pkg
+		if file.dir != "" {
+			dirPath, filePkg = filepath.Join(outputDirPath, file.dir), file.dir
+			if err = os.MkdirAll(dirPath, 0o755); err != nil {
+				return err
+			}
+		}
+
+		err = g.write(filepath.Join(dirPath, file.name+".go"), g.makeFile(file.data, filePkg))
 		if err != nil {
 			return err
 		}
@@ -173,6 +215,10 @@ func (g *Generator) Execute(outputDirPath string) (err error) {
 func (g *Generator) prepare() error {
 	g.initTypes()
 
+	if err := g.selectMessages(); err != nil {
+		return err
+	}
+
 	g.fields = make(map[string]*Field)
 	g.enums = make(map[string]*Field)
 	numbers := make(map[string]struct{}, len(g.doc.Fields))
@@ -190,6 +236,10 @@ func (g *Generator) prepare() error {
 		}
 	}
 
+	if err := g.findDataFields(); err != nil {
+		return err
+	}
+
 	g.components = make(map[string]*Component)
 	for _, component := range g.doc.Components {
 		g.components[component.Name] = component
@@ -220,7 +270,7 @@ func (g *Generator) prepare() error {
 		g.grabGroups(member)
 	}
 
-	return nil
+	return g.checkConditions()
 }
 
 func (g *Generator) validateComponent(component *ComponentMember) {
@@ -295,11 +345,18 @@ func (g *Generator) makeArg(member *ComponentMember) string {
 
 func (g *Generator) makeFieldTypes() string {
 	goFields := make([]string, 0, len(g.doc.Fields))
+	goNames := make([]string, 0, len(g.doc.Fields))
 	for _, field := range g.doc.Fields {
 		goFields = append(goFields, g.makeStringConst("Field"+field.Name, field.Number))
+		goNames = append(goNames, g.mustExecuteTemplate(fieldNameTemplateFormat, fieldNameTemplate{
+			FieldName: g.makeFieldName(field.Name),
+			Name:      field.Name,
+		}))
 	}
 	return g.mustExecuteTemplate(constantsTemplateFormat, constantsTemplate{
 		Constants: strings.Join(goFields, "\n"),
+	}) + g.mustExecuteTemplate(fieldNamesTemplateFormat, fieldNamesTemplate{
+		Names: strings.Join(goNames, "\n"),
 	})
 }
 
@@ -312,6 +369,9 @@ func (g *Generator) validateHeader() {
 	for rf := range RequiredHeaderFields {
 		requiredFields[rf] = true
 	}
+	for rf := range SessionHeaderFields {
+		requiredFields[rf] = true
+	}
 
 	err := g.validateRequiredFields(g.doc.Header.Members, requiredFields)
 	if err != nil {
@@ -354,7 +414,7 @@ func (g *Generator) makeHeader() string {
 	beginString := fmt.Sprintf("var beginString = \"%s.%s.%s\"", g.doc.Type, g.doc.Major, g.doc.Minor)
 	header := g.makeComponent(g.doc.Header, componentName)
 	fieldSetters := make([]string, len(RequiredHeaderFields))
-	requiredFields := sortedMapKeys(RequiredHeaderFields)
+	requiredFields := append(sortedMapKeys(RequiredHeaderFields), sortedMapKeys(SessionHeaderFields)...)
 	for _, fieldName := range requiredFields {
 		if g.isFieldExcluded(fieldName) {
 			continue
@@ -369,7 +429,7 @@ func (g *Generator) makeHeader() string {
 		}
 
 		fieldSetters = append(fieldSetters,
-			g.mustExecuteTemplate(defaultFieldSetterTemplateFormat, fieldGetterSetterTemplate{
+			g.mustExecuteTemplate(g.defaultFieldTemplate(field.Name), fieldGetterSetterTemplate{
 				Name:          field.Name,
 				LocalName:     g.makeLocalName(field.Name),
 				Type:          g.fixTypeToGo(g.makeType(field.Name)),
@@ -388,6 +448,15 @@ func (g *Generator) makeHeader() string {
 	)
 }
 
+// defaultFieldTemplate returns the template of the methods used by the session pipelines to access a field.
+func (g *Generator) defaultFieldTemplate(fieldName string) string {
+	if _, ok := g.enums[fieldName]; ok {
+		return defaultEnumFieldTemplateFormat
+	}
+
+	return defaultFieldSetterTemplateFormat
+}
+
 func (g *Generator) makeTrailer() string {
 	g.validateTrailer()
 
@@ -440,7 +509,8 @@ func (g *Generator) makeMessage(message *Component) string {
 	goSettersCalls := make([]string, 0, len(message.Members))
 	goGetterSetters := make([]string, 0, len(message.Members))
 
-	for i, member := range message.Members {
+	members := g.includedMembers(message.Members)
+	for i, member := range members {
 		goFields = append(goFields, g.makeCallConstructor(member))
 
 		withArgs := member.Required == "Y"
@@ -465,6 +535,7 @@ func (g *Generator) makeMessage(message *Component) string {
 		Fields:        strings.Join(goFields, "\n"),
 		Setters:       setters,
 		GetterSetters: strings.Join(goGetterSetters, "\n"),
+		Validate:      g.mustMakeValidate(message.Name, members),
 	}
 
 	// The following code is executed if a message is a part of standard pipelines.
@@ -481,7 +552,7 @@ func (g *Generator) makeMessage(message *Component) string {
 			}
 
 			fieldSetters = append(fieldSetters,
-				g.mustExecuteTemplate(defaultFieldSetterTemplateFormat, fieldGetterSetterTemplate{
+				g.mustExecuteTemplate(g.defaultFieldTemplate(field.Name), fieldGetterSetterTemplate{
 					Name:          field.Name,
 					LocalName:     g.makeLocalName(field.Name),
 					Type:          g.fixTypeToGo(g.makeType(field.Name)),
@@ -523,7 +594,7 @@ func (g *Generator) makeEnumName(enum *Field) string {
 	return fmt.Sprintf("Enum%s", enum.Name)
 }
 
-func (g *Generator) makeEnumVariant(enum string, value *Value) string {
+func (g *Generator) makeEnumVariantName(enum string, value *Value) string {
 	parts := strings.Split(value.Description, "_")
 	name := ""
 	// nolint
@@ -538,34 +609,54 @@ func (g *Generator) makeEnumVariant(enum string, value *Value) string {
 	}
 	//}
 
-	return g.mustExecuteTemplate(enumVariantTemplateFormat, enumVariantTemplate{
-		Name: fmt.Sprintf("%s%s", enum, name),
-		//Enum:  enum,
-		Value: value.Enum,
-	})
+	return fmt.Sprintf("%s%s", enum, name)
 }
 
 func (g *Generator) makeEnum(field *Field) string {
 	name := g.makeEnumName(field)
 	variants := make([]string, 0, len(field.Values))
+	descriptions := make([]string, 0, len(field.Values))
+	values := make([]string, 0, len(field.Values))
 	for _, value := range field.Values {
-		variants = append(variants, g.makeEnumVariant(name, value))
+		variant := g.makeEnumVariantName(name, value)
+
+		variants = append(variants, g.mustExecuteTemplate(enumVariantTemplateFormat, enumVariantTemplate{
+			Name:  variant,
+			Enum:  name,
+			Value: value.Enum,
+		}))
+		descriptions = append(descriptions, g.mustExecuteTemplate(enumDescriptionTemplateFormat, enumDescriptionTemplate{
+			Name:        variant,
+			Description: value.Description,
+		}))
+		values = append(values, variant)
 	}
 
 	return g.mustExecuteTemplate(enumTemplateFormat, enumTemplate{
-		Name:     name,
-		FixType:  g.fixTypeToGo(g.typeToFix(field.Name)),
-		Variants: strings.Join(variants, "\n"),
+		Name:          name,
+		FieldName:     field.Name,
+		Tag:           field.Number,
+		Variants:      strings.Join(variants, "\n"),
+		Descriptions:  strings.Join(descriptions, "\n"),
+		Values:        strings.Join(values, ",\n"),
+		MultipleValue: multipleValueTypes[field.Type],
 	})
 }
 
 func (g *Generator) makeTypeConstructor(member *ComponentMember) string {
 	if field, ok := g.fields[member.Name]; ok {
+		if lengthField, ok := g.dataFields[field.Name]; ok {
+			return fmt.Sprintf("fix.NewDataField(%s)", g.makeFieldName(lengthField.Name))
+		}
+		if precision, ok := g.typePrecision[field.Type]; ok {
+			return fmt.Sprintf("(&fix.%s{}).WithPrecision(%s)", g.typeToFix(field.Type), precision)
+		}
+
 		return fmt.Sprintf("&fix.%s{}", g.typeToFix(field.Type))
 	}
 
-	if _, ok := g.enums[member.Name]; ok {
-		return "&fix.String{}"
+	if enum, ok := g.enums[member.Name]; ok {
+		return fmt.Sprintf("&fix.Enum[%s]{}", g.makeEnumName(enum))
 	}
 
 	panic(fmt.Errorf("unexpected field: %s", member.Name))
@@ -660,7 +751,8 @@ func (g *Generator) makeComponentCallConstructor(member *ComponentMember) string
 func (g *Generator) makeGroupConstructor(group *ComponentMember) string {
 	goGetterSetters := make([]string, 0, len(group.Members))
 	goFields := make([]string, 0, len(group.Members))
-	for i, member := range group.Members {
+	members := g.includedMembers(group.Members)
+	for i, member := range members {
 		goFields = append(goFields, g.makeCallConstructor(member))
 		goGetterSetters = append(goGetterSetters, g.makeSetterGetterField(g.makeGroupEntryTypeName(group.Name), member, i))
 	}
@@ -672,11 +764,15 @@ func (g *Generator) makeGroupConstructor(group *ComponentMember) string {
 			NoTag:     g.makeFieldName(group.Name),
 			Fields:    strings.Join(goFields, "\n"),
 		}),
+		g.mustExecuteTemplate(groupValidateTemplateFormat, groupConstructorTemplate{
+			Name: g.makeGroupTypeName(group.Name),
+		}),
 		g.mustExecuteTemplate(componentTemplateFormat, componentTemplate{
 			Name:          g.makeGroupEntryTypeName(group.Name),
 			Fields:        strings.Join(goFields, "\n"),
 			GetterSetters: strings.Join(goGetterSetters, "\n"),
 		}),
+		g.mustMakeValidate(g.makeGroupEntryTypeName(group.Name), members),
 	}, "\n")
 }
 
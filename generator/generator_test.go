@@ -1,10 +1,16 @@
 package generator
 
 import (
+	"encoding/xml"
+	"errors"
 	"fmt"
-	"github.com/b2broker/simplefix-go/utils"
+	"go/format"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/b2broker/simplefix-go/utils"
 )
 
 var generator *Generator
@@ -26,3 +32,743 @@ func TestMain(m *testing.M) {
 	m.Run()
 	os.Exit(0)
 }
+
+func TestFindDataFields(t *testing.T) {
+	field := func(name string) *ComponentMember {
+		return &ComponentMember{XMLName: xml.Name{Local: FieldItem}, Name: name}
+	}
+
+	doc := &Doc{
+		Fields: []*Field{
+			{Number: "95", Name: "RawDataLength", Type: "LENGTH"},
+			{Number: "96", Name: "RawData", Type: "DATA"},
+			{Number: "5001", Name: "PayloadSize", Type: "LENGTH"},
+			{Number: "5002", Name: "Payload", Type: "DATA"},
+		},
+		Messages: []*Component{{Name: "Custom", Members: []*ComponentMember{field("PayloadSize"), field("Payload")}}},
+	}
+	config := &Config{Types: []*Type{{Name: "DATA", CastType: fixData}, {Name: "LENGTH", CastType: fixInt}}}
+
+	g := NewGenerator(doc, config, "fix")
+	g.initTypes()
+	if err := g.findDataFields(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if g.dataFields["RawData"].Name != "RawDataLength" || g.dataFields["Payload"].Name != "PayloadSize" {
+		t.Fatalf("unexpected length fields: %v", g.dataFields)
+	}
+	g.fields = map[string]*Field{"Payload": doc.Fields[3]}
+	if constructor := g.makeTypeConstructor(field("Payload")); constructor != "fix.NewDataField(FieldPayloadSize)" {
+		t.Fatalf("unexpected constructor of the data field: %s", constructor)
+	}
+
+	doc.Messages = nil
+	if err := g.findDataFields(); err == nil {
+		t.Fatalf("an error is expected for the data field without length")
+	}
+}
+
+func TestTimeFormats(t *testing.T) {
+	doc := &Doc{
+		Fields: []*Field{
+			{Number: "52", Name: "SendingTime", Type: "UTCTIMESTAMP"},
+			{Number: "273", Name: "MDEntryTime", Type: "UTCTIMEONLY"},
+			{Number: "272", Name: "MDEntryDate", Type: "UTCDATEONLY"},
+		},
+	}
+	config := &Config{Types: []*Type{
+		{Name: "UTCTIMESTAMP", CastType: fixUTCTimestamp, Format: "20060102-15:04:05.000000"},
+		{Name: "UTCTIMEONLY", CastType: fixUTCTimeOnly, Format: "15:04:05.000"},
+		{Name: "UTCDATEONLY", CastType: fixUTCDateOnly},
+	}}
+
+	g := NewGenerator(doc, config, "fix")
+	g.initTypes()
+	g.fields = map[string]*Field{}
+	for _, field := range doc.Fields {
+		g.fields[field.Name] = field
+	}
+
+	for name, expected := range map[string]string{
+		"SendingTime": "(&fix.UTCTimestamp{}).WithPrecision(fix.Microseconds)",
+		"MDEntryTime": "&fix.UTCTimeOnly{}",
+		"MDEntryDate": "&fix.UTCDateOnly{}",
+	} {
+		if constructor := g.makeTypeConstructor(&ComponentMember{Name: name}); constructor != expected {
+			t.Fatalf("unexpected constructor of %s: %s", name, constructor)
+		}
+	}
+	if tp := g.fixTypeToGo(g.makeType("MDEntryDate")); tp != "fix.Date" {
+		t.Fatalf("unexpected type: %s", tp)
+	}
+
+	for _, layout := range []string{"20060102-15:04", "20060102-15:04:05.0000", "15:04:05.00"} {
+		if _, err := formatPrecision(layout); err == nil {
+			t.Fatalf("an error is expected for %s", layout)
+		}
+	}
+}
+
+func TestMakeEnum(t *testing.T) {
+	g := NewGenerator(&Doc{}, &Config{}, "fix")
+	g.enums = map[string]*Field{}
+
+	side := &Field{Number: "54", Name: "Side", Type: "CHAR", Values: []*Value{
+		{Enum: "1", Description: "BUY"}, {Enum: "2", Description: "SELL"},
+	}}
+	execInst := &Field{Number: "18", Name: "ExecInst", Type: "MULTIPLEVALUESTRING", Values: []*Value{
+		{Enum: "G", Description: "ALL_OR_NONE"},
+	}}
+	g.enums[side.Name], g.enums[execInst.Name] = side, execInst
+
+	source := g.makeEnum(side)
+	for _, expected := range []string{
+		"type EnumSide string",
+		`EnumSideBuy EnumSide = "1"`,
+		`case EnumSideSell:
+		return "SELL"`,
+		"func (v EnumSide) IsValid() bool",
+	} {
+		if !strings.Contains(source, expected) {
+			t.Fatalf("%q is not found in %s", expected, source)
+		}
+	}
+	if !strings.Contains(g.makeEnum(execInst), "fix.IsValidMultipleValue") {
+		t.Fatalf("the multiple value enum should check its items")
+	}
+
+	if constructor := g.makeTypeConstructor(&ComponentMember{Name: "Side"}); constructor != "&fix.Enum[EnumSide]{}" {
+		t.Fatalf("unexpected constructor: %s", constructor)
+	}
+	if tp := g.fixTypeToGo(g.makeType("Side")); tp != "EnumSide" {
+		t.Fatalf("unexpected type: %s", tp)
+	}
+}
+
+func TestMakeRouter(t *testing.T) {
+	g := NewGenerator(&Doc{}, generator.config, "fix")
+	g.initTypes()
+	g.fields = map[string]*Field{
+		"RefSeqNum":  {Number: "45", Name: "RefSeqNum", Type: "SEQNUM"},
+		"RefMsgType": {Number: "372", Name: "RefMsgType", Type: "STRING"},
+		"Text":       {Number: "58", Name: "Text", Type: "STRING"},
+	}
+	g.enums = map[string]*Field{
+		"BusinessRejectReason": {Number: "380", Name: "BusinessRejectReason", Type: "INT", Values: []*Value{
+			{Enum: "0", Description: "OTHER"},
+		}},
+	}
+
+	field := func(name string) *ComponentMember {
+		return &ComponentMember{XMLName: xml.Name{Local: FieldItem}, Name: name}
+	}
+	g.doc.Messages = []*Component{
+		{Name: "Heartbeat", MsgType: "0"},
+		{Name: "BusinessMessageReject", MsgType: "j", Members: []*ComponentMember{
+			field("RefSeqNum"), field("RefMsgType"), field("BusinessRejectReason"), field("Text"),
+		}},
+	}
+
+	source := g.makeRouter()
+	for _, expected := range []string{
+		"func (r *Router) OnHeartbeat(handle func(ctx context.Context, msg *Heartbeat) error) *Router",
+		"simplefixgo.Route(r.MessageRouter, MsgTypeBusinessMessageReject, NewBusinessMessageReject, handle)",
+		"simplefixgo.NewMessageRouter(handler, session, makeBusinessReject)",
+		"msg.SetRefSeqNum(reject.RefSeqNum)",
+		"msg.SetBusinessRejectReason(EnumBusinessRejectReason(strconv.Itoa(reject.Reason)))",
+	} {
+		if !strings.Contains(source, expected) {
+			t.Fatalf("%q is not found in %s", expected, source)
+		}
+	}
+	if strings.Contains(source, "SetBusinessRejectRefID") {
+		t.Fatalf("the missing field is set: %s", source)
+	}
+
+	g.doc.Messages = g.doc.Messages[:1]
+	if source = g.makeRouter(); !strings.Contains(source, "simplefixgo.NewMessageRouter(handler, session, nil)") {
+		t.Fatalf("the router should not make business rejects without the message: %s", source)
+	}
+}
+
+func TestMakeSessionOpts(t *testing.T) {
+	g := NewGenerator(&Doc{}, generator.config, "fix")
+	g.fields = map[string]*Field{
+		"MsgType":    {Number: "35", Name: "MsgType", Type: "STRING"},
+		"MsgSeqNum":  {Number: "34", Name: "MsgSeqNum", Type: "SEQNUM"},
+		"HeartBtInt": {Number: "108", Name: "HeartBtInt", Type: "INT"},
+	}
+	g.enums = map[string]*Field{
+		"EncryptMethod": {Number: "98", Name: "EncryptMethod", Type: "INT", Values: []*Value{
+			{Enum: "0", Description: "NONE_OTHER"}, {Enum: "1", Description: "PKCS"},
+		}},
+		"SessionRejectReason": {Number: "373", Name: "SessionRejectReason", Type: "INT", Values: []*Value{
+			{Enum: "1", Description: "REQUIRED_TAG_MISSING"}, {Enum: "99", Description: "OTHER"},
+		}},
+	}
+	for _, name := range append(SessionMessages, "NewOrderSingle") {
+		g.doc.Messages = append(g.doc.Messages, &Component{Name: name})
+	}
+
+	source, err := g.makeSessionOpts()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, expected := range []string{
+		"LogonBuilder: Logon{}.New(),",
+		"NewOrderSingleBuilder: NewOrderSingle{}.New(),",
+		"EncryptedMethod: 98,",
+		"string(EnumEncryptMethodNoneOther): {},",
+		"RequiredTagMissing: 1, // REQUIRED_TAG_MISSING",
+		"Other: 99, // OTHER",
+	} {
+		if !strings.Contains(source, expected) {
+			t.Fatalf("%q is not found in %s", expected, source)
+		}
+	}
+	if !strings.Contains(source, "InvalidTagNumber: 0, // the standard code, not defined by the dictionary") ||
+		!strings.Contains(source, "GroupFieldsOutOfOrder: 15, // the standard code, not defined by the dictionary") {
+		t.Fatalf("the missing codes are not set: %s", source)
+	}
+	if strings.Contains(source, "ExecutionReportBuilder") {
+		t.Fatalf("the missing message is set: %s", source)
+	}
+
+	delete(g.fields, "MsgSeqNum")
+	if _, err = g.makeSessionOpts(); err == nil || !strings.Contains(err.Error(), "MsgSeqNum") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i, message := range g.doc.Messages {
+		if message.Name == "Reject" {
+			g.doc.Messages = append(g.doc.Messages[:i], g.doc.Messages[i+1:]...)
+			break
+		}
+	}
+	if _, err = g.makeSessionOpts(); err == nil || !strings.Contains(err.Error(), "Reject") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSelectMessages(t *testing.T) {
+	g := NewGenerator(generator.doc, generator.config, "fix")
+	g.initTypes()
+	g.SetMessages([]string{"D"})
+	if err := g.selectMessages(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	names := func(components []*Component) map[string]bool {
+		m := make(map[string]bool, len(components))
+		for _, component := range components {
+			m[component.Name] = true
+		}
+		return m
+	}
+
+	messages := names(g.doc.Messages)
+	for _, name := range append(SessionMessages, "NewOrderSingle", "SequenceReset", "BusinessMessageReject") {
+		if !messages[name] {
+			t.Fatalf("the message is not selected: %s", name)
+		}
+	}
+	if messages["ExecutionReport"] || len(messages) != 9 {
+		t.Fatalf("unexpected messages: %v", messages)
+	}
+
+	components := names(g.doc.Components)
+	if !components["Instrument"] || !components["UnderlyingInstrument"] || components["PositionQty"] {
+		t.Fatalf("unexpected components: %v", components)
+	}
+
+	fields := make(map[string]bool, len(g.doc.Fields))
+	for _, field := range g.doc.Fields {
+		fields[field.Name] = true
+	}
+	for _, name := range []string{"Symbol", "NoUnderlyings", "ClOrdID", "RawDataLength", "SessionRejectReason"} {
+		if !fields[name] {
+			t.Fatalf("the field is not selected: %s", name)
+		}
+	}
+	if fields["MDReqID"] || fields["PosType"] {
+		t.Fatalf("the unused fields are selected")
+	}
+
+	if len(generator.doc.Messages) == len(g.doc.Messages) {
+		t.Fatalf("the original document is modified")
+	}
+
+	g = NewGenerator(generator.doc, generator.config, "fix")
+	g.SetMessages([]string{"Unknown"})
+	if err := g.selectMessages(); err == nil {
+		t.Fatalf("an error is expected")
+	}
+}
+
+func TestMergeOverlay(t *testing.T) {
+	parse := func(source string) *Doc {
+		doc := &Doc{}
+		if err := xml.Unmarshal([]byte(source), doc); err != nil {
+			t.Fatalf("could not parse the overlay: %s", err)
+		}
+		return doc
+	}
+
+	overlay := parse(`<fix>
+		<header><field name='OnBehalfOfCompID' required='Y'/></header>
+		<messages>
+			<message name='NewOrderSingle'>
+				<field name='VenueTag' required='Y'/>
+				<field name='Price' required='Y'/>
+			</message>
+			<message name='VenueStatus' msgtype='U1' msgcat='app'>
+				<field name='VenueTag' required='Y'/>
+				<component name='VenueInfo' required='N'/>
+			</message>
+		</messages>
+		<components>
+			<component name='VenueInfo'><field name='Text' required='N'/></component>
+			<component name='Parties'>
+				<group name='NoPartyIDs'><field name='VenueTag' required='N'/></group>
+			</component>
+		</components>
+		<fields>
+			<field number='5001' name='VenueTag' type='STRING'/>
+			<field number='59' name='TimeInForce' type='CHAR'><value enum='Z' description='VENUE_SESSION'/></field>
+		</fields>
+	</fix>`)
+
+	doc, err := generator.doc.Merge(overlay)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	find := func(components []*Component, name string) *Component {
+		for _, component := range components {
+			if component.Name == name {
+				return component
+			}
+		}
+		t.Fatalf("%s is not found", name)
+		return nil
+	}
+	member := func(members []*ComponentMember, name string) *ComponentMember {
+		for _, m := range members {
+			if m.Name == name {
+				return m
+			}
+		}
+		return nil
+	}
+
+	order := find(doc.Messages, "NewOrderSingle")
+	if m := member(order.Members, "VenueTag"); m == nil || m.Required != "Y" || order.Members[len(order.Members)-1] != m {
+		t.Fatalf("the field is not appended to the message")
+	}
+	if member(order.Members, "Price").Required != "Y" {
+		t.Fatalf("the required flag is not changed")
+	}
+	if group := member(find(doc.Components, "Parties").Members, "NoPartyIDs"); member(group.Members, "VenueTag") == nil {
+		t.Fatalf("the field is not added to the group")
+	}
+	if find(doc.Messages, "VenueStatus").MsgType != "U1" || len(find(doc.Components, "VenueInfo").Members) != 1 {
+		t.Fatalf("the message is not added")
+	}
+	if member(doc.Header.Members, "OnBehalfOfCompID").Required != "Y" {
+		t.Fatalf("the header is not changed")
+	}
+
+	if member(find(generator.doc.Messages, "NewOrderSingle").Members, "VenueTag") != nil ||
+		member(find(generator.doc.Messages, "NewOrderSingle").Members, "Price").Required == "Y" {
+		t.Fatalf("the base document is modified")
+	}
+
+	for name, source := range map[string]string{
+		"field number":   `<fix><fields><field number='5002' name='Symbol' type='STRING'/></fields></fix>`,
+		"used number":    `<fix><fields><field number='55' name='VenueSymbol' type='STRING'/></fields></fix>`,
+		"field type":     `<fix><fields><field number='55' name='Symbol' type='INT'/></fields></fix>`,
+		"enum value":     `<fix><fields><field number='59' name='TimeInForce' type='CHAR'><value enum='0' description='TODAY'/></field></fields></fix>`,
+		"msgtype":        `<fix><messages><message name='NewOrderSingle' msgtype='E'/></messages></fix>`,
+		"used msgtype":   `<fix><messages><message name='VenueOrder' msgtype='D'/></messages></fix>`,
+		"no msgtype":     `<fix><messages><message name='VenueOrder'/></messages></fix>`,
+		"unknown field":  `<fix><messages><message name='NewOrderSingle'><field name='Unknown'/></message></messages></fix>`,
+		"unknown member": `<fix><components><component name='Parties'><component name='Unknown'/></component></components></fix>`,
+		"kind":           `<fix><messages><message name='NewOrderSingle'><group name='Price'/></message></messages></fix>`,
+	} {
+		if _, err := generator.doc.Merge(parse(source)); !errors.Is(err, ErrOverlayConflict) {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+	}
+}
+
+func TestLayoutPackages(t *testing.T) {
+	g := NewGenerator(generator.doc, generator.config, "fix")
+	g.SetMessages([]string{"NewOrderSingle"})
+	g.SetLayout(LayoutPackages)
+	g.SetImportPath("example.com/fix44")
+
+	outputDir := filepath.Join(t.TempDir(), "fix44")
+	if err := os.Mkdir(outputDir, 0o755); err != nil {
+		t.Fatalf("could not make the output directory: %s", err)
+	}
+	if err := g.Execute(outputDir); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(outputDir, name))
+		if err != nil {
+			t.Fatalf("could not read %s: %s", name, err)
+		}
+		return string(data)
+	}
+
+	message := read("newordersingle/newordersingle.go")
+	for _, expected := range []string{
+		"package newordersingle",
+		`"example.com/fix44/components"`,
+		`"example.com/fix44/enums"`,
+		`"example.com/fix44/fields"`,
+		"fix.NewMessage(fields.FieldBeginString, fields.FieldBodyLength, fields.FieldCheckSum, fields.FieldMsgType, components.BeginString, MsgTypeNewOrderSingle)",
+		"components.MakeParties().Component",
+		"msg.SetHeader(components.MakeHeader().AsComponent())",
+		"func (newOrderSingle *NewOrderSingle) Parties() *components.Parties {",
+	} {
+		if !strings.Contains(message, expected) {
+			t.Fatalf("the message does not contain %q:\n%s", expected, message)
+		}
+	}
+
+	header := read("components/header.go")
+	if !strings.Contains(header, "package components") || !strings.Contains(header, "func MakeHeader() *Header {") {
+		t.Fatalf("unexpected header:\n%s", header)
+	}
+	if strings.Contains(header, "components.") {
+		t.Fatalf("the references within the package are qualified:\n%s", header)
+	}
+
+	router := read("router.go")
+	if !strings.Contains(router, "package fix44") ||
+		!strings.Contains(router, "simplefixgo.Route(r.MessageRouter, newordersingle.MsgTypeNewOrderSingle, newordersingle.NewNewOrderSingle, handle)") {
+		t.Fatalf("unexpected router:\n%s", router)
+	}
+
+	for _, name := range []string{"fields/fields.go", "enums/enum_side.go", "session_opts.go"} {
+		read(name)
+	}
+
+	g = NewGenerator(&Doc{Messages: []*Component{{Name: "Fields", MsgType: "U1"}}}, generator.config, "fix")
+	g.SetLayout(LayoutPackages)
+	if err := g.checkPackages(); err == nil {
+		t.Fatalf("an error is expected for the message colliding with the fields package")
+	}
+}
+
+func TestMakeFileImports(t *testing.T) {
+	g := NewGenerator(&Doc{}, generator.config, "fix")
+	if err := g.initImports(""); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The local fix variable and the package names within the comments and strings are not imports.
+	file := g.makeFile(`
+// Parse uses time.Now and strconv.Itoa.
+func Parse(messages string) string {
+	fix := "context.Background"
+	return messages + fix
+}
+
+var Ticker = time.Second
+`, "fix44")
+
+	if !strings.Contains(file, `"time"`) {
+		t.Fatalf("the time package is not imported:\n%s", file)
+	}
+	for _, unexpected := range []string{`"strconv"`, `"context"`, `"github.com/b2broker/simplefix-go`} {
+		if strings.Contains(file, unexpected) {
+			t.Fatalf("unexpected import %s:\n%s", unexpected, file)
+		}
+	}
+}
+
+func TestOrchestraDoc(t *testing.T) {
+	repository := &Orchestra{}
+	err := xml.Unmarshal([]byte(`<fixr:repository xmlns:fixr="http://fixprotocol.io/2020/orchestra/repository" name="Venue" version="FIX.5.0SP2">
+		<fixr:categories>
+			<fixr:category name="Session" section="Session"/>
+			<fixr:category name="SingleGeneralOrderHandling" section="Trade"/>
+		</fixr:categories>
+		<fixr:codeSets>
+			<fixr:codeSet name="OrdTypeCodeSet" id="40" type="char">
+				<fixr:code name="Market" id="40001" value="1"/>
+				<fixr:code name="StopLimit" id="40004" value="4"/>
+			</fixr:codeSet>
+		</fixr:codeSets>
+		<fixr:fields>
+			<fixr:field id="8" name="BeginString" type="String"/>
+			<fixr:field id="10" name="CheckSum" type="String"/>
+			<fixr:field id="11" name="ClOrdID" type="String"/>
+			<fixr:field id="40" name="OrdType" type="OrdTypeCodeSet"/>
+			<fixr:field id="44" name="Price" type="Price"/>
+			<fixr:field id="44" name="Price" type="String" scenario="Text"/>
+			<fixr:field id="448" name="PartyID" type="String"/>
+			<fixr:field id="453" name="NoPartyIDs" type="NumInGroup"/>
+		</fixr:fields>
+		<fixr:components>
+			<fixr:component name="StandardHeader" id="1024"><fixr:fieldRef id="8" presence="required"/></fixr:component>
+			<fixr:component name="StandardTrailer" id="1025"><fixr:fieldRef id="10" presence="required"/></fixr:component>
+			<fixr:component name="Parties" id="1012"><fixr:groupRef id="1012" presence="optional"/></fixr:component>
+		</fixr:components>
+		<fixr:groups>
+			<fixr:group name="PartiesGrp" id="1012">
+				<fixr:numInGroup id="453"/>
+				<fixr:fieldRef id="448" presence="required"/>
+			</fixr:group>
+		</fixr:groups>
+		<fixr:messages>
+			<fixr:message name="NewOrderSingle" id="14" msgType="D" category="SingleGeneralOrderHandling">
+				<fixr:structure>
+					<fixr:componentRef id="1024" presence="required"/>
+					<fixr:fieldRef id="11" presence="required"/>
+					<fixr:componentRef id="1012"/>
+					<fixr:fieldRef id="40" presence="constant"/>
+					<fixr:fieldRef id="44" presence="conditional">
+						<fixr:rule name="PriceForStopLimit" presence="required"><fixr:when>OrdType == ^StopLimit</fixr:when></fixr:rule>
+					</fixr:fieldRef>
+					<fixr:componentRef id="1025" presence="required"/>
+				</fixr:structure>
+			</fixr:message>
+			<fixr:message name="NewOrderSingle" id="14" msgType="D" scenario="Limit"/>
+			<fixr:message name="Heartbeat" id="1" msgType="0" category="Session"/>
+		</fixr:messages>
+	</fixr:repository>`), repository)
+	if err != nil {
+		t.Fatalf("could not parse the repository: %s", err)
+	}
+
+	doc, err := repository.Doc()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if doc.Type != "FIX" || doc.Major != "5" || doc.Minor != "0" || doc.ServicePack != 2 {
+		t.Fatalf("unexpected version: %s.%s.%s SP%d", doc.Type, doc.Major, doc.Minor, doc.ServicePack)
+	}
+	if len(doc.Fields) != 7 {
+		t.Fatalf("the fields of the other scenarios are not skipped: %d", len(doc.Fields))
+	}
+
+	ordType := doc.Fields[3]
+	if ordType.Name != "OrdType" || ordType.Type != "CHAR" || len(ordType.Values) != 2 ||
+		ordType.Values[1].Enum != "4" || ordType.Values[1].Description != "STOP_LIMIT" {
+		t.Fatalf("unexpected enum field: %+v %+v", ordType, ordType.Values)
+	}
+	if doc.Fields[4].Type != "PRICE" {
+		t.Fatalf("unexpected type: %s", doc.Fields[4].Type)
+	}
+
+	if len(doc.Header.Members) != 1 || doc.Header.Members[0].Name != "BeginString" ||
+		len(doc.Trailer.Members) != 1 || doc.Trailer.Members[0].Name != "CheckSum" {
+		t.Fatalf("unexpected header or trailer: %+v %+v", doc.Header.Members, doc.Trailer.Members)
+	}
+
+	if len(doc.Components) != 1 || len(doc.Components[0].Members) != 1 {
+		t.Fatalf("unexpected components: %+v", doc.Components)
+	}
+	group := doc.Components[0].Members[0]
+	if group.XMLName.Local != GroupItem || group.Name != "NoPartyIDs" || group.Required != "N" ||
+		len(group.Members) != 1 || group.Members[0].Name != "PartyID" || group.Members[0].Required != "Y" {
+		t.Fatalf("unexpected group: %+v", group)
+	}
+
+	if len(doc.Messages) != 2 {
+		t.Fatalf("the messages of the other scenarios are not skipped: %d", len(doc.Messages))
+	}
+	order, heartbeat := doc.Messages[0], doc.Messages[1]
+	if order.MsgType != "D" || order.MsgCat != "app" || heartbeat.MsgCat != "admin" {
+		t.Fatalf("unexpected messages: %+v %+v", order, heartbeat)
+	}
+
+	var members []string
+	for _, member := range order.Members {
+		members = append(members, fmt.Sprintf("%s %s %s", member.XMLName.Local, member.Name, member.Required))
+	}
+	expected := "field ClOrdID Y, component Parties N, field OrdType Y, field Price N"
+	if strings.Join(members, ", ") != expected {
+		t.Fatalf("unexpected members: %s", strings.Join(members, ", "))
+	}
+	if order.Members[3].RequiredWhen != "OrdType == ^StopLimit" {
+		t.Fatalf("unexpected condition: %s", order.Members[3].RequiredWhen)
+	}
+
+	repository.Components = repository.Components[1:]
+	if _, err = repository.Doc(); err == nil {
+		t.Fatalf("an error is expected for the undefined component")
+	}
+}
+
+func TestOrchestraVersion(t *testing.T) {
+	// The headers of the repositories published by the FIX Trading Community.
+	headers := map[string]string{
+		"FIX.Latest_EP269": `<?xml version="1.0" encoding="UTF-8"?>
+<fixr:repository xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:fixr="http://fixprotocol.io/2020/orchestra/repository" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" name="FIX.Latest" version="FIX.Latest_EP269" xsi:schemaLocation="http://fixprotocol.io/2020/orchestra/repository https://raw.githubusercontent.com/FIXTradingCommunity/fix-orchestra/master/repository/src/main/resources/xsd/repository.xsd">
+	<fixr:metadata>
+		<dc:title>Orchestra</dc:title>
+		<dc:creator>unified2orchestra.xslt script</dc:creator>
+		<dc:publisher>FIX Trading Community</dc:publisher>
+		<dc:format>Orchestra schema</dc:format>
+		<dc:source>FIX Unified Repository</dc:source>
+		<dc:rights>Copyright (c) FIX Protocol Ltd. All Rights Reserved.</dc:rights>
+	</fixr:metadata>
+</fixr:repository>`,
+		"FIX.5.0SP2_EP254": `<?xml version="1.0" encoding="UTF-8"?>
+<fixr:repository xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:fixr="http://fixprotocol.io/2020/orchestra/repository" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" name="FIX.5.0SP2" version="FIX.5.0SP2_EP254" xsi:schemaLocation="http://fixprotocol.io/2020/orchestra/repository https://raw.githubusercontent.com/FIXTradingCommunity/fix-orchestra/master/repository/src/main/resources/xsd/repository.xsd">
+	<fixr:metadata>
+		<dc:title>Orchestra</dc:title>
+		<dc:creator>unified2orchestra.xslt script</dc:creator>
+		<dc:publisher>FIX Trading Community</dc:publisher>
+		<dc:format>Orchestra schema</dc:format>
+		<dc:source>FIX Unified Repository</dc:source>
+		<dc:rights>Copyright (c) FIX Protocol Ltd. All Rights Reserved.</dc:rights>
+	</fixr:metadata>
+</fixr:repository>`,
+	}
+
+	for version, header := range headers {
+		repository := &Orchestra{}
+		if err := xml.Unmarshal([]byte(header), repository); err != nil {
+			t.Fatalf("could not parse the repository %s: %s", version, err)
+		}
+		if repository.Version != version {
+			t.Fatalf("unexpected version: %s", repository.Version)
+		}
+
+		doc, err := repository.Doc()
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %s", version, err)
+		}
+		if doc.Type != "FIX" || doc.Major != "5" || doc.Minor != "0" || doc.ServicePack != 2 {
+			t.Fatalf("unexpected version of %s: %s.%s.%s SP%d", version, doc.Type, doc.Major, doc.Minor, doc.ServicePack)
+		}
+	}
+
+	for _, version := range []string{"FIX.4.4", "FIX.4.4_EP1"} {
+		doc := &Doc{}
+		if err := doc.setVersion(version); err != nil || doc.Major != "4" || doc.Minor != "4" || doc.ServicePack != 0 {
+			t.Fatalf("unexpected version of %s: %s.%s SP%d, %v", version, doc.Major, doc.Minor, doc.ServicePack, err)
+		}
+	}
+	for _, version := range []string{"FIX.Next", "FIX.5.0SPx_EP1", "Latest_EP269"} {
+		if err := (&Doc{}).setVersion(version); err == nil {
+			t.Fatalf("an error is expected for %s", version)
+		}
+	}
+}
+
+func TestMakeValidate(t *testing.T) {
+	field := func(name, required, requiredWhen string) *ComponentMember {
+		return &ComponentMember{XMLName: xml.Name{Local: FieldItem}, Name: name, Required: required, RequiredWhen: requiredWhen}
+	}
+
+	doc := &Doc{
+		Fields: []*Field{
+			{Number: "11", Name: "ClOrdID", Type: "STRING"},
+			{Number: "40", Name: "OrdType", Type: "CHAR", Values: []*Value{
+				{Enum: "1", Description: "MARKET"}, {Enum: "3", Description: "STOP"}, {Enum: "4", Description: "STOP_LIMIT"},
+			}},
+			{Number: "44", Name: "Price", Type: "PRICE"},
+			{Number: "55", Name: "Symbol", Type: "STRING"},
+			{Number: "99", Name: "StopPx", Type: "PRICE"},
+			{Number: "448", Name: "PartyID", Type: "STRING"},
+			{Number: "453", Name: "NoPartyIDs", Type: "NUMINGROUP"},
+		},
+		Components: []*Component{{Name: "Instrument", Members: []*ComponentMember{field("Symbol", "Y", "")}}},
+		Messages: []*Component{{Name: "NewOrderSingle", MsgType: "D", Members: []*ComponentMember{
+			field("ClOrdID", "Y", ""),
+			{XMLName: xml.Name{Local: ComponentItem}, Name: "Instrument", Required: "N"},
+			{XMLName: xml.Name{Local: GroupItem}, Name: "NoPartyIDs", Required: "N", Members: []*ComponentMember{field("PartyID", "Y", "")}},
+			field("OrdType", "Y", ""),
+			field("Price", "N", "OrdType == 2 || exists Symbol"),
+			field("StopPx", "N", "OrdType in {^Stop, ^StopLimit} and not (Price = '0')"),
+		}}},
+	}
+
+	g := NewGenerator(doc, generator.config, "fix")
+	g.initTypes()
+	g.fields, g.enums = make(map[string]*Field), make(map[string]*Field)
+	for _, f := range doc.Fields {
+		if len(f.Values) > 0 {
+			g.enums[f.Name] = f
+		} else {
+			g.fields[f.Name] = f
+		}
+	}
+	g.components = map[string]*Component{"Instrument": doc.Components[0]}
+
+	source, err := g.makeValidate("NewOrderSingle", doc.Messages[0].Members)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	formatted, err := format.Source([]byte("package fix\n" + source))
+	if err != nil {
+		t.Fatalf("could not format the Validate method: %s\n%s", err, source)
+	}
+	validate := string(formatted)
+
+	for _, expected := range []string{
+		"func (newOrderSingle *NewOrderSingle) Validate() error {",
+		`if !fix.IsSet(newOrderSingle.Get(0)) {
+		return fix.NewValidationError(FieldClOrdID, "ClOrdID", "")`,
+		`if fix.IsSet(newOrderSingle.Get(1)) {
+		if err := newOrderSingle.Instrument().Validate(); err != nil {`,
+		"if err := newOrderSingle.PartyIDsGrp().Validate(); err != nil {",
+		`if (fix.FieldValue(newOrderSingle.Get(3)) == "2" || fix.IsSet(newOrderSingle.Get(1).(*fix.Component).Get(0))) && !fix.IsSet(newOrderSingle.Get(4)) {
+		return fix.NewValidationError(FieldPrice, "Price", "OrdType == 2 || exists Symbol")`,
+		`if (fix.FieldValue(newOrderSingle.Get(3)) == "3" || fix.FieldValue(newOrderSingle.Get(3)) == "4") && !(fix.FieldValue(newOrderSingle.Get(4)) == "0") && !fix.IsSet(newOrderSingle.Get(5)) {`,
+	} {
+		if !strings.Contains(validate, expected) {
+			t.Fatalf("the Validate method does not contain %q:\n%s", expected, validate)
+		}
+	}
+
+	for _, condition := range []string{
+		"PartyID == 1",
+		"OrdType == ^Limit",
+		"OrdType > 2",
+		"(OrdType == 2",
+		"OrdType in {3 4}",
+		"exists",
+	} {
+		doc.Messages[0].Members[4].RequiredWhen = condition
+		if _, err = g.makeValidate("NewOrderSingle", doc.Messages[0].Members); err == nil {
+			t.Fatalf("an error is expected for the condition %q", condition)
+		}
+	}
+}
+
+func TestMakeMessageExcludedFields(t *testing.T) {
+	doc := &Doc{
+		Fields: []*Field{
+			{Number: "11", Name: "ClOrdID", Type: "STRING"},
+			{Number: "35", Name: "MsgType", Type: "STRING"},
+		},
+	}
+	message := &Component{Name: "OrderStatusRequest", MsgType: "H", Members: []*ComponentMember{
+		{XMLName: xml.Name{Local: FieldItem}, Name: "MsgType", Required: "Y"},
+		{XMLName: xml.Name{Local: FieldItem}, Name: "ClOrdID", Required: "Y"},
+	}}
+
+	g := NewGenerator(doc, generator.config, "fix")
+	g.initTypes()
+	g.fields = map[string]*Field{"ClOrdID": doc.Fields[0], "MsgType": doc.Fields[1]}
+	g.enums = make(map[string]*Field)
+
+	// The excluded fields are not the items of the message, so the indexes of the validated items are shifted.
+	source := g.makeMessage(message)
+	if strings.Contains(source, "FieldMsgType,") || strings.Contains(source, "Get(1)") {
+		t.Fatalf("the excluded field is generated:\n%s", source)
+	}
+	if !strings.Contains(source, "if !fix.IsSet(orderStatusRequest.Get(0)) {\nreturn fix.NewValidationError(FieldClOrdID") {
+		t.Fatalf("unexpected validation:\n%s", source)
+	}
+}
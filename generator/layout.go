@@ -0,0 +1,312 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Layout specifies how the generated code is split into packages.
+type Layout int
+
+const (
+	// LayoutFlat puts all the generated code into the output package.
+	LayoutFlat Layout = iota
+
+	// LayoutPackages puts each message into its own package named after the message, e.g. newordersingle,
+	// which share the fields, enums and components packages. The header, trailer and groups are put into
+	// the components package, while the router and the session options are left in the output package.
+	LayoutPackages
+)
+
+// The shared packages of LayoutPackages.
+const (
+	fieldsPackage     = "fields"
+	enumsPackage      = "enums"
+	componentsPackage = "components"
+)
+
+// libraryImports are the packages which might be used by the generated code.
+var libraryImports = map[string]string{
+	"fix":         "github.com/b2broker/simplefix-go/fix",
+	"messages":    "github.com/b2broker/simplefix-go/session/messages",
+	"session":     "github.com/b2broker/simplefix-go/session",
+	"simplefixgo": "github.com/b2broker/simplefix-go",
+	"context":     "context",
+	"strconv":     "strconv",
+	"time":        "time",
+}
+
+// generatedFile is a file of the generated code, which is qualified and written after all files are made.
+type generatedFile struct {
+	// dir is the directory of the subpackage, it is empty for the output package.
+	dir  string
+	name string
+	data string
+}
+
+// SetLayout specifies how the generated code is split into packages, LayoutFlat is used by default.
+func (g *Generator) SetLayout(layout Layout) {
+	g.layout = layout
+}
+
+// SetImportPath specifies the import path of the output directory, which is required to import
+// the subpackages of LayoutPackages. By default, it is found using the go.mod file of the output directory.
+func (g *Generator) SetImportPath(importPath string) {
+	g.importPath = importPath
+}
+
+// packageDir returns the subpackage of an item of the generated code.
+func (g *Generator) packageDir(shared, message string) string {
+	if g.layout != LayoutPackages {
+		return ""
+	}
+	if message != "" {
+		return strings.ToLower(message)
+	}
+
+	return shared
+}
+
+// checkPackages makes sure that the message packages do not collide with the shared ones.
+func (g *Generator) checkPackages() error {
+	if g.layout != LayoutPackages {
+		return nil
+	}
+
+	for _, message := range g.doc.Messages {
+		name := strings.ToLower(message.Name)
+		if err := g.checkName(name); err != nil {
+			return fmt.Errorf("invalid package name of the message %s: %w", message.Name, err)
+		}
+
+		switch name {
+		case fieldsPackage, enumsPackage, componentsPackage:
+			return fmt.Errorf("the package of the message %s collides with the shared package", message.Name)
+		}
+	}
+
+	return nil
+}
+
+// qualify rewrites the references to the items of the other subpackages into qualified identifiers,
+// e.g. FieldSymbol into fields.FieldSymbol. The unexported items referenced from other packages,
+// e.g. makeHeader, are exported.
+func (g *Generator) qualify(files []*generatedFile) error {
+	fset := token.NewFileSet()
+	parsed := make([]*ast.File, len(files))
+	symbols := make(map[string]string)
+	for i, file := range files {
+		f, err := parser.ParseFile(fset, "", "package p\n"+file.data, parser.ParseComments)
+		if err != nil {
+			return fmt.Errorf("could not parse %s: %w", file.name, err)
+		}
+		parsed[i] = f
+
+		for name := range f.Scope.Objects {
+			if dir, ok := symbols[name]; ok && dir != file.dir {
+				return fmt.Errorf("%s is defined in both %s and %s packages", name, dir, file.dir)
+			}
+			symbols[name] = file.dir
+		}
+	}
+
+	exported := make(map[string]string)
+	for i, f := range parsed {
+		topLevelRefs(f, func(ident *ast.Ident) {
+			if dir, ok := symbols[ident.Name]; ok && dir != files[i].dir && !ast.IsExported(ident.Name) {
+				exported[ident.Name] = exportName(ident.Name)
+			}
+		})
+	}
+	for name, export := range exported {
+		if _, ok := symbols[export]; ok {
+			return fmt.Errorf("could not export %s, since %s is already defined", name, export)
+		}
+	}
+
+	for i, f := range parsed {
+		topLevelRefs(f, func(ident *ast.Ident) {
+			dir, ok := symbols[ident.Name]
+			if !ok {
+				return
+			}
+
+			name := ident.Name
+			if export, ok := exported[name]; ok {
+				name = export
+			}
+			if dir != files[i].dir && dir != "" {
+				name = dir + "." + name
+			}
+
+			ident.Name = name
+		})
+
+		buffer := &bytes.Buffer{}
+		if err := printer.Fprint(buffer, fset, f); err != nil {
+			return fmt.Errorf("could not print %s: %w", files[i].name, err)
+		}
+
+		files[i].data = strings.TrimPrefix(buffer.String(), "package p\n")
+	}
+
+	return nil
+}
+
+// topLevelRefs calls fn for each identifier which refers to a top-level declaration,
+// either of the same file or of the other files, which are unresolved by the parser.
+// The selected names, the keys of composite literals and the names of methods are skipped,
+// since they might coincide with the top-level names.
+func topLevelRefs(f *ast.File, fn func(ident *ast.Ident)) {
+	skip := make(map[*ast.Ident]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.SelectorExpr:
+			skip[node.Sel] = true
+		case *ast.CompositeLit:
+			for _, elt := range node.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					if key, ok := kv.Key.(*ast.Ident); ok {
+						skip[key] = true
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if node.Recv != nil {
+				skip[node.Name] = true
+			}
+		case *ast.Ident:
+			if skip[node] {
+				return true
+			}
+			if node.Obj == nil || f.Scope.Lookup(node.Name) == node.Obj {
+				fn(node)
+			}
+		}
+
+		return true
+	})
+}
+
+// exportName returns the exported form of an unexported name, e.g. MakeHeader for makeHeader.
+func exportName(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+
+	return string(r)
+}
+
+// importSpec returns the import of a package, with the package name if it differs from the last path element.
+func importSpec(name, importPath string) string {
+	if path.Base(importPath) == name {
+		return fmt.Sprintf("%q", importPath)
+	}
+
+	return fmt.Sprintf("%s %q", name, importPath)
+}
+
+// fileImports returns the imports of the packages selected in the code.
+func (g *Generator) fileImports(f *ast.File) []string {
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Obj == nil {
+				used[x.Name] = true
+			}
+		}
+		return true
+	})
+
+	imports := make([]string, 0, len(used))
+	for name := range used {
+		if importPath, ok := g.imports[name]; ok {
+			imports = append(imports, importSpec(name, importPath))
+		}
+	}
+	sort.Strings(imports)
+
+	return imports
+}
+
+// initImports makes the imports of the library packages and of the subpackages of the generated code.
+func (g *Generator) initImports(outputDirPath string) error {
+	g.imports = make(map[string]string, len(libraryImports))
+	for name, importPath := range libraryImports {
+		g.imports[name] = importPath
+	}
+
+	if g.layout != LayoutPackages {
+		return nil
+	}
+
+	importPath := g.importPath
+	if importPath == "" {
+		var err error
+		if importPath, err = moduleImportPath(outputDirPath); err != nil {
+			return fmt.Errorf("could not find the import path of the output directory: %w", err)
+		}
+	}
+
+	for _, dir := range []string{fieldsPackage, enumsPackage, componentsPackage} {
+		g.imports[dir] = path.Join(importPath, dir)
+	}
+	for _, message := range g.doc.Messages {
+		dir := g.packageDir("", message.Name)
+		g.imports[dir] = path.Join(importPath, dir)
+	}
+
+	return nil
+}
+
+// moduleImportPath returns the import path of a directory using the go.mod file of its module.
+func moduleImportPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for root := dir; ; {
+		if module, err := readModulePath(filepath.Join(root, "go.mod")); err == nil {
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				return "", err
+			}
+
+			return path.Join(module, filepath.ToSlash(rel)), nil
+		}
+
+		parent := filepath.Dir(root)
+		if parent == root {
+			return "", fmt.Errorf("go.mod is not found for %s", dir)
+		}
+		root = parent
+	}
+}
+
+func readModulePath(goModPath string) (string, error) {
+	file, err := os.Open(goModPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+
+	return "", fmt.Errorf("the module path is not found in %s", goModPath)
+}
@@ -0,0 +1,385 @@
+package generator
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// OrchestraRoot is the root element of the FIX Orchestra repository files.
+const OrchestraRoot = "repository"
+
+// The Orchestra items which are not represented by the members of the QuickFIX-style messages.
+const (
+	orchestraHeader    = "StandardHeader"
+	orchestraTrailer   = "StandardTrailer"
+	orchestraBase      = "base"
+	orchestraSession   = "Session"
+	orchestraRequired  = "required"
+	orchestraConstant  = "constant"
+	orchestraForbidden = "forbidden"
+)
+
+// The Orchestra references to the structure members.
+const (
+	orchestraFieldRef     = "fieldRef"
+	orchestraComponentRef = "componentRef"
+	orchestraGroupRef     = "groupRef"
+	orchestraNumInGroup   = "numInGroup"
+)
+
+// Orchestra is a FIX Orchestra repository, the machine-readable rules of engagement published
+// by the FIX Trading Community and the venues. It is converted into a Doc to be used by the Generator.
+//
+// Only the base scenario of each item is used, since a Doc could not contain several
+// variants of a message. The datatypes are converted to the QuickFIX-style types, e.g. UTCTimestamp
+// to UTCTIMESTAMP, and the names of the codes into the value descriptions, e.g. StopLimit to STOP_LIMIT.
+type Orchestra struct {
+	Name    string `xml:"name,attr"`
+	Version string `xml:"version,attr"`
+
+	Categories []*OrchestraCategory  `xml:"categories>category"`
+	CodeSets   []*OrchestraCodeSet   `xml:"codeSets>codeSet"`
+	Fields     []*OrchestraField     `xml:"fields>field"`
+	Components []*OrchestraStructure `xml:"components>component"`
+	Groups     []*OrchestraStructure `xml:"groups>group"`
+	Messages   []*OrchestraMessage   `xml:"messages>message"`
+}
+
+type OrchestraCategory struct {
+	Name    string `xml:"name,attr"`
+	Section string `xml:"section,attr"`
+}
+
+type OrchestraCodeSet struct {
+	ID       string           `xml:"id,attr"`
+	Name     string           `xml:"name,attr"`
+	Type     string           `xml:"type,attr"`
+	Scenario string           `xml:"scenario,attr"`
+	Codes    []*OrchestraCode `xml:"code"`
+}
+
+type OrchestraCode struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type OrchestraField struct {
+	ID       string `xml:"id,attr"`
+	Name     string `xml:"name,attr"`
+	Type     string `xml:"type,attr"`
+	Scenario string `xml:"scenario,attr"`
+}
+
+// OrchestraStructure is a component or a group, the members of the group follow its numInGroup field.
+type OrchestraStructure struct {
+	ID       string          `xml:"id,attr"`
+	Name     string          `xml:"name,attr"`
+	Scenario string          `xml:"scenario,attr"`
+	Members  []*OrchestraRef `xml:",any"`
+}
+
+type OrchestraMessage struct {
+	ID        string              `xml:"id,attr"`
+	Name      string              `xml:"name,attr"`
+	MsgType   string              `xml:"msgType,attr"`
+	Category  string              `xml:"category,attr"`
+	Scenario  string              `xml:"scenario,attr"`
+	Structure *OrchestraStructure `xml:"structure"`
+}
+
+// OrchestraRef is a reference to a field, component or group by its id.
+type OrchestraRef struct {
+	XMLName  xml.Name
+	ID       string           `xml:"id,attr"`
+	Presence string           `xml:"presence,attr"`
+	Rules    []*OrchestraRule `xml:"rule"`
+}
+
+// OrchestraRule changes the presence of a conditional member when the condition is met.
+type OrchestraRule struct {
+	Name     string `xml:"name,attr"`
+	Presence string `xml:"presence,attr"`
+	When     string `xml:"when"`
+}
+
+func isBaseScenario(scenario string) bool {
+	return scenario == "" || scenario == orchestraBase
+}
+
+// Doc converts the repository into the document used by the Generator.
+func (o *Orchestra) Doc() (*Doc, error) {
+	doc := &Doc{}
+	if err := doc.setVersion(o.Version); err != nil {
+		return nil, err
+	}
+
+	codeSets := make(map[string]*OrchestraCodeSet, len(o.CodeSets))
+	for _, codeSet := range o.CodeSets {
+		if isBaseScenario(codeSet.Scenario) {
+			codeSets[codeSet.Name] = codeSet
+		}
+	}
+
+	c := &orchestraConverter{
+		fields:     make(map[string]*Field, len(o.Fields)),
+		components: make(map[string]*OrchestraStructure, len(o.Components)),
+		groups:     make(map[string]*OrchestraStructure, len(o.Groups)),
+	}
+
+	for _, field := range o.Fields {
+		if !isBaseScenario(field.Scenario) {
+			continue
+		}
+
+		item := &Field{Number: field.ID, Name: field.Name, Type: orchestraType(field.Type)}
+		if codeSet, ok := codeSets[field.Type]; ok {
+			item.Type = orchestraType(codeSet.Type)
+			for _, code := range codeSet.Codes {
+				description := orchestraDescription(code.Name)
+				if description == "" {
+					description = code.Value
+				}
+
+				item.Values = append(item.Values, &Value{Enum: code.Value, Description: description})
+			}
+		}
+
+		doc.Fields = append(doc.Fields, item)
+		c.fields[field.ID] = item
+	}
+
+	for _, component := range o.Components {
+		if isBaseScenario(component.Scenario) {
+			c.components[component.ID] = component
+		}
+	}
+	for _, group := range o.Groups {
+		if isBaseScenario(group.Scenario) {
+			c.groups[group.ID] = group
+		}
+	}
+
+	for _, component := range o.Components {
+		if !isBaseScenario(component.Scenario) {
+			continue
+		}
+
+		members, err := c.members("component "+component.Name, component.Members)
+		if err != nil {
+			return nil, err
+		}
+
+		item := &Component{Name: component.Name, Members: members}
+		switch component.Name {
+		case orchestraHeader:
+			doc.Header = item
+		case orchestraTrailer:
+			doc.Trailer = item
+		default:
+			doc.Components = append(doc.Components, item)
+		}
+	}
+
+	sections := make(map[string]string, len(o.Categories))
+	for _, category := range o.Categories {
+		sections[category.Name] = category.Section
+	}
+
+	for _, message := range o.Messages {
+		if !isBaseScenario(message.Scenario) {
+			continue
+		}
+		if message.MsgType == "" {
+			return nil, fmt.Errorf("message %s: the msgType is not specified", message.Name)
+		}
+
+		var refs []*OrchestraRef
+		if message.Structure != nil {
+			refs = message.Structure.Members
+		}
+
+		members, err := c.members("message "+message.Name, refs)
+		if err != nil {
+			return nil, err
+		}
+
+		msgCat := "app"
+		if section, ok := sections[message.Category]; (ok && section == orchestraSession) ||
+			(!ok && message.Category == orchestraSession) {
+			msgCat = "admin"
+		}
+
+		doc.Messages = append(doc.Messages, &Component{
+			Name:    message.Name,
+			MsgType: message.MsgType,
+			MsgCat:  msgCat,
+			Members: members,
+		})
+	}
+
+	if doc.Header == nil || doc.Trailer == nil {
+		return nil, fmt.Errorf("the %s and %s components are required", orchestraHeader, orchestraTrailer)
+	}
+
+	return doc, nil
+}
+
+// orchestraLatest is the version of the FIX Latest repositories, which extend FIX 5.0 SP2
+// with the extension packs instead of the service packs.
+const orchestraLatest = "FIX.Latest"
+
+// setVersion parses the version of the repository, e.g. FIX.4.4, FIX.5.0SP2_EP254 or FIX.Latest_EP269.
+// The extension pack suffix is dropped, since it does not change the session protocol version,
+// and FIX.Latest is treated as FIX.5.0SP2.
+func (d *Doc) setVersion(version string) error {
+	base, _, _ := strings.Cut(version, "_EP")
+	if base == orchestraLatest {
+		base = "FIX.5.0SP2"
+	}
+
+	parts := strings.Split(base, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("unexpected version of the repository: %s", version)
+	}
+
+	d.Type, d.Major, d.Minor = parts[0], parts[1], parts[2]
+	if minor, servicePack, ok := strings.Cut(parts[2], "SP"); ok {
+		sp, err := strconv.Atoi(servicePack)
+		if err != nil {
+			return fmt.Errorf("unexpected service pack of the repository: %s", version)
+		}
+
+		d.Minor, d.ServicePack = minor, sp
+	}
+
+	return nil
+}
+
+type orchestraConverter struct {
+	fields     map[string]*Field
+	components map[string]*OrchestraStructure
+	groups     map[string]*OrchestraStructure
+}
+
+// members converts the references into the members, the header and trailer references are skipped.
+func (c *orchestraConverter) members(path string, refs []*OrchestraRef) ([]*ComponentMember, error) {
+	members := make([]*ComponentMember, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Presence == orchestraForbidden {
+			continue
+		}
+
+		required := "N"
+		if ref.Presence == orchestraRequired || ref.Presence == orchestraConstant {
+			required = "Y"
+		}
+
+		var conditions []string
+		for _, rule := range ref.Rules {
+			if rule.Presence == orchestraRequired && strings.TrimSpace(rule.When) != "" {
+				conditions = append(conditions, strings.TrimSpace(rule.When))
+			}
+		}
+
+		requiredWhen := strings.Join(conditions, " || ")
+		if len(conditions) > 1 {
+			requiredWhen = "(" + strings.Join(conditions, ") || (") + ")"
+		}
+
+		switch ref.XMLName.Local {
+		case orchestraFieldRef:
+			field, ok := c.fields[ref.ID]
+			if !ok {
+				return nil, fmt.Errorf("%s: the field %s is not defined", path, ref.ID)
+			}
+
+			members = append(members, &ComponentMember{
+				XMLName: xml.Name{Local: FieldItem}, Name: field.Name, Required: required, RequiredWhen: requiredWhen,
+			})
+
+		case orchestraComponentRef:
+			component, ok := c.components[ref.ID]
+			if !ok {
+				return nil, fmt.Errorf("%s: the component %s is not defined", path, ref.ID)
+			}
+			if component.Name == orchestraHeader || component.Name == orchestraTrailer {
+				continue
+			}
+
+			members = append(members, &ComponentMember{
+				XMLName: xml.Name{Local: ComponentItem}, Name: component.Name, Required: required, RequiredWhen: requiredWhen,
+			})
+
+		case orchestraGroupRef:
+			member, err := c.group(path, ref.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			member.Required, member.RequiredWhen = required, requiredWhen
+			members = append(members, member)
+		}
+	}
+
+	return members, nil
+}
+
+// group converts a group into the member named after its numInGroup field.
+func (c *orchestraConverter) group(path, id string) (*ComponentMember, error) {
+	group, ok := c.groups[id]
+	if !ok {
+		return nil, fmt.Errorf("%s: the group %s is not defined", path, id)
+	}
+
+	path = fmt.Sprintf("%s: group %s", path, group.Name)
+
+	var counter *Field
+	refs := make([]*OrchestraRef, 0, len(group.Members))
+	for _, ref := range group.Members {
+		if ref.XMLName.Local != orchestraNumInGroup {
+			refs = append(refs, ref)
+			continue
+		}
+
+		if counter, ok = c.fields[ref.ID]; !ok {
+			return nil, fmt.Errorf("%s: the field %s is not defined", path, ref.ID)
+		}
+	}
+	if counter == nil {
+		return nil, fmt.Errorf("%s: the numInGroup field is not specified", path)
+	}
+
+	members, err := c.members(path, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ComponentMember{XMLName: xml.Name{Local: GroupItem}, Name: counter.Name, Members: members}, nil
+}
+
+// orchestraType converts an Orchestra datatype into the QuickFIX-style one, e.g. UTCTimestamp into UTCTIMESTAMP.
+func orchestraType(datatype string) string {
+	return strings.ToUpper(datatype)
+}
+
+// orchestraDescription converts the name of a code into the QuickFIX-style description,
+// e.g. StopLimit into STOP_LIMIT, so the enum constants are named as the ones generated from QuickFIX files.
+func orchestraDescription(name string) string {
+	r := []rune(name)
+	words := make([]string, 0, len(r))
+	start := 0
+	for i := 1; i < len(r); i++ {
+		lowerBefore := unicode.IsLower(r[i-1]) || unicode.IsDigit(r[i-1])
+		acronymEnd := unicode.IsUpper(r[i-1]) && i+1 < len(r) && unicode.IsLower(r[i+1])
+		if unicode.IsUpper(r[i]) && (lowerBefore || acronymEnd) {
+			words = append(words, string(r[start:i]))
+			start = i
+		}
+	}
+	words = append(words, string(r[start:]))
+
+	return strings.ToUpper(strings.Join(words, "_"))
+}
@@ -0,0 +1,310 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrOverlayConflict = errors.New("overlay conflict")
+
+// Merge returns a copy of the document with the overlays applied in order, the document itself is not modified.
+//
+// An overlay has the format of the main XML file and contains only the changes:
+//   - new fields, or new values of the existing enum fields;
+//   - new messages and components;
+//   - new members of the existing messages, components, groups, header and trailer,
+//     which are appended to them;
+//   - the required flags and the requiredWhen conditions of the existing members, specified by repeating the members.
+//
+// The existing items are matched by their names. A field redefined with another number or type,
+// a message redefined with another type, an item redefined with another kind, an enum value redefined
+// with another description, and a member referring to an undefined field or component are conflicts.
+func (d *Doc) Merge(overlays ...*Doc) (*Doc, error) {
+	doc := &Doc{
+		Type:        d.Type,
+		Major:       d.Major,
+		Minor:       d.Minor,
+		ServicePack: d.ServicePack,
+		Header:      copyComponent(d.Header),
+		Trailer:     copyComponent(d.Trailer),
+		Messages:    make([]*Component, 0, len(d.Messages)),
+		Components:  make([]*Component, 0, len(d.Components)),
+		Fields:      make([]*Field, 0, len(d.Fields)),
+	}
+	for _, message := range d.Messages {
+		doc.Messages = append(doc.Messages, copyComponent(message))
+	}
+	for _, component := range d.Components {
+		doc.Components = append(doc.Components, copyComponent(component))
+	}
+	for _, field := range d.Fields {
+		doc.Fields = append(doc.Fields, copyField(field))
+	}
+
+	for _, overlay := range overlays {
+		if err := doc.apply(overlay); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+func (d *Doc) apply(overlay *Doc) error {
+	for _, field := range overlay.Fields {
+		if err := d.mergeField(field); err != nil {
+			return err
+		}
+	}
+
+	// The components are added before merging the members, so the members could refer to any of them.
+	components := make(map[string]*Component, len(d.Components))
+	for _, component := range d.Components {
+		components[component.Name] = component
+	}
+	for _, component := range overlay.Components {
+		if _, ok := components[component.Name]; !ok {
+			added := &Component{Name: component.Name}
+			d.Components = append(d.Components, added)
+			components[component.Name] = added
+		}
+	}
+
+	fields := make(map[string]bool, len(d.Fields))
+	for _, field := range d.Fields {
+		fields[field.Name] = true
+	}
+	m := &merger{fields: fields, components: components}
+
+	for _, component := range overlay.Components {
+		if err := m.members("component "+component.Name, components[component.Name], component.Members); err != nil {
+			return err
+		}
+	}
+
+	for _, section := range []struct {
+		name          string
+		base, changes *Component
+	}{
+		{"header", d.Header, overlay.Header},
+		{"trailer", d.Trailer, overlay.Trailer},
+	} {
+		if section.changes == nil {
+			continue
+		}
+		if section.base == nil {
+			return fmt.Errorf("%w: the %s is not defined", ErrOverlayConflict, section.name)
+		}
+		if err := m.members(section.name, section.base, section.changes.Members); err != nil {
+			return err
+		}
+	}
+
+	messages := make(map[string]*Component, len(d.Messages))
+	msgTypes := make(map[string]string, len(d.Messages))
+	for _, message := range d.Messages {
+		messages[message.Name] = message
+		msgTypes[message.MsgType] = message.Name
+	}
+	for _, message := range overlay.Messages {
+		base, ok := messages[message.Name]
+		if !ok {
+			if message.MsgType == "" {
+				return fmt.Errorf("%w: message %s: the msgtype is not specified", ErrOverlayConflict, message.Name)
+			}
+			if name, ok := msgTypes[message.MsgType]; ok {
+				return fmt.Errorf("%w: message %s: msgtype %s is already used by %s",
+					ErrOverlayConflict, message.Name, message.MsgType, name)
+			}
+
+			base = &Component{Name: message.Name, MsgType: message.MsgType, MsgCat: message.MsgCat}
+			d.Messages = append(d.Messages, base)
+			messages[base.Name], msgTypes[base.MsgType] = base, base.Name
+		} else if message.MsgType != "" && message.MsgType != base.MsgType {
+			return fmt.Errorf("%w: message %s: msgtype %s differs from %s",
+				ErrOverlayConflict, message.Name, message.MsgType, base.MsgType)
+		}
+
+		if err := m.members("message "+message.Name, base, message.Members); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Doc) mergeField(field *Field) error {
+	for _, base := range d.Fields {
+		switch {
+		case base.Name == field.Name && base.Number != field.Number:
+			return fmt.Errorf("%w: field %s: number %s differs from %s", ErrOverlayConflict, field.Name, field.Number, base.Number)
+		case base.Name != field.Name && base.Number == field.Number:
+			return fmt.Errorf("%w: field %s: number %s is already used by %s", ErrOverlayConflict, field.Name, field.Number, base.Name)
+		case base.Name != field.Name:
+			continue
+		}
+
+		if field.Type != "" && field.Type != base.Type {
+			return fmt.Errorf("%w: field %s: type %s differs from %s", ErrOverlayConflict, field.Name, field.Type, base.Type)
+		}
+
+		values := make(map[string]*Value, len(base.Values))
+		for _, value := range base.Values {
+			values[value.Enum] = value
+		}
+		for _, value := range field.Values {
+			existing, ok := values[value.Enum]
+			if !ok {
+				added := *value
+				base.Values = append(base.Values, &added)
+				values[value.Enum] = &added
+				continue
+			}
+			if existing.Description != value.Description {
+				return fmt.Errorf("%w: field %s: value %s is already described as %s",
+					ErrOverlayConflict, field.Name, value.Enum, existing.Description)
+			}
+		}
+
+		return nil
+	}
+
+	if field.Number == "" || field.Type == "" {
+		return fmt.Errorf("%w: field %s: the number and type are required", ErrOverlayConflict, field.Name)
+	}
+
+	d.Fields = append(d.Fields, copyField(field))
+
+	return nil
+}
+
+type merger struct {
+	fields     map[string]bool
+	components map[string]*Component
+}
+
+// members merges the members of an overlay item into the members of the base one.
+func (m *merger) members(path string, base *Component, members []*ComponentMember) error {
+	merged, err := m.mergeMembers(path, base.Members, members)
+	if err != nil {
+		return err
+	}
+
+	base.Members = merged
+
+	return nil
+}
+
+func (m *merger) mergeMembers(path string, base, members []*ComponentMember) ([]*ComponentMember, error) {
+	for _, member := range members {
+		memberPath := fmt.Sprintf("%s: %s %s", path, member.XMLName.Local, member.Name)
+
+		var existing *ComponentMember
+		for _, item := range base {
+			if item.Name == member.Name {
+				existing = item
+				break
+			}
+		}
+
+		if existing == nil {
+			if err := m.check(memberPath, member); err != nil {
+				return nil, err
+			}
+
+			base = append(base, copyMember(member))
+			continue
+		}
+
+		if existing.XMLName.Local != member.XMLName.Local {
+			return nil, fmt.Errorf("%w: %s: already defined as %s", ErrOverlayConflict, memberPath, existing.XMLName.Local)
+		}
+		if member.Required != "" {
+			existing.Required = member.Required
+		}
+		if member.RequiredWhen != "" {
+			existing.RequiredWhen = member.RequiredWhen
+		}
+
+		if len(member.Members) == 0 {
+			continue
+		}
+		if member.XMLName.Local != GroupItem {
+			return nil, fmt.Errorf("%w: %s: only groups have members", ErrOverlayConflict, memberPath)
+		}
+
+		merged, err := m.mergeMembers(memberPath, existing.Members, member.Members)
+		if err != nil {
+			return nil, err
+		}
+		existing.Members = merged
+	}
+
+	return base, nil
+}
+
+// check makes sure that a new member refers to the defined fields and components.
+func (m *merger) check(path string, member *ComponentMember) error {
+	switch member.XMLName.Local {
+	case FieldItem, GroupItem:
+		if !m.fields[member.Name] {
+			return fmt.Errorf("%w: %s: the field is not defined", ErrOverlayConflict, path)
+		}
+	case ComponentItem:
+		if _, ok := m.components[member.Name]; !ok {
+			return fmt.Errorf("%w: %s: the component is not defined", ErrOverlayConflict, path)
+		}
+	default:
+		return fmt.Errorf("%w: %s: unexpected item", ErrOverlayConflict, path)
+	}
+
+	for _, item := range member.Members {
+		if err := m.check(fmt.Sprintf("%s: %s %s", path, item.XMLName.Local, item.Name), item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyComponent(component *Component) *Component {
+	if component == nil {
+		return nil
+	}
+
+	c := *component
+	c.Members = copyMembers(component.Members)
+
+	return &c
+}
+
+func copyMembers(members []*ComponentMember) []*ComponentMember {
+	if members == nil {
+		return nil
+	}
+
+	items := make([]*ComponentMember, 0, len(members))
+	for _, member := range members {
+		items = append(items, copyMember(member))
+	}
+
+	return items
+}
+
+func copyMember(member *ComponentMember) *ComponentMember {
+	m := *member
+	m.Members = copyMembers(member.Members)
+
+	return &m
+}
+
+func copyField(field *Field) *Field {
+	f := *field
+	f.Values = make([]*Value, 0, len(field.Values))
+	for _, value := range field.Values {
+		v := *value
+		f.Values = append(f.Values, &v)
+	}
+
+	return &f
+}
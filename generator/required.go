@@ -34,6 +34,16 @@ var RequiredHeaderFields = map[string]bool{
 	"SendingTime": true,
 }
 
+// SessionHeaderFields are the optional header fields set by the session pipelines,
+// e.g. by the SequenceReset-GapFill messages sent instead of the resent messages.
+var SessionHeaderFields = map[string]bool{
+	// Indicates that the message might have been sent under the same sequence number before.
+	"PossDupFlag": true,
+
+	// The original time of transmission of a message sent with the PossDupFlag.
+	"OrigSendingTime": true,
+}
+
 // RequiredTrailerFields indicates the required field(s) that must be contained in the trailer.
 // A FIX message is not considered properly structured unless it contains these fields in its trailer.
 var RequiredTrailerFields = map[string]bool{
@@ -51,7 +61,7 @@ var DefaultFlowFields = map[string][]string{
 	"TestRequest":        {"TestReqID"},
 	"ResendRequest":      {"BeginSeqNo", "EndSeqNo"},
 	"SequenceReset":      {"NewSeqNo", "GapFillFlag"},
-	"Reject":             {"SessionRejectReason", "RefSeqNum", "RefTagID"},
+	"Reject":             {"SessionRejectReason", "RefSeqNum", "RefTagID", "RefMsgType", "Text"},
 	"ExecutionReport":    nil,
 	"NewOrderSingle":     nil,
 	"MarketDataRequest":  nil,
@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+const businessMessageReject = "BusinessMessageReject"
+
+// businessRejectFields maps the fields of a BusinessMessageReject to the simplefixgo.BusinessReject fields.
+var businessRejectFields = []struct {
+	name   string
+	value  string
+	goType string
+}{
+	{"RefSeqNum", "reject.RefSeqNum", "int"},
+	{"RefMsgType", "reject.RefMsgType", "string"},
+	{"BusinessRejectRefID", "reject.RefID", "string"},
+	{"BusinessRejectReason", "reject.Reason", "int"},
+	{"Text", "reject.Text", "string"},
+}
+
+// makeRouter makes the Router with a typed handler setter for each message.
+// The handler errors are answered with a BusinessMessageReject if the dictionary contains it.
+func (g *Generator) makeRouter() string {
+	routes := make([]string, 0, len(g.doc.Messages))
+	var businessReject *Component
+	for _, message := range g.doc.Messages {
+		routes = append(routes, g.mustExecuteTemplate(routeTemplateFormat, routeTemplate{Name: message.Name}))
+
+		if message.Name == businessMessageReject {
+			businessReject = message
+		}
+	}
+
+	data := routerTemplate{
+		Routes:             strings.Join(routes, ""),
+		BusinessRejectFunc: "nil",
+	}
+
+	if businessReject != nil {
+		data.BusinessRejectFunc = "makeBusinessReject"
+		data.BusinessReject = g.makeBusinessReject(businessReject)
+	}
+
+	return g.mustExecuteTemplate(routerTemplateFormat, data)
+}
+
+func (g *Generator) makeBusinessReject(message *Component) string {
+	members := make(map[string]bool, len(message.Members))
+	for _, member := range message.Members {
+		if member.XMLName.Local == FieldItem {
+			members[member.Name] = true
+		}
+	}
+
+	var setters []string
+	for _, field := range businessRejectFields {
+		if !members[field.name] {
+			continue
+		}
+
+		value, ok := g.convertValue(field.value, field.goType, g.fixTypeToGo(g.makeType(field.name)))
+		if !ok {
+			continue
+		}
+
+		setters = append(setters, fmt.Sprintf("msg.Set%s(%s)", field.name, value))
+	}
+
+	return g.mustExecuteTemplate(businessRejectTemplateFormat, businessRejectTemplate{
+		Name:    message.Name,
+		Setters: strings.Join(setters, "\n"),
+	})
+}
+
+// convertValue converts an expression of the int or string type to the type of a field setter.
+func (g *Generator) convertValue(value, from, to string) (string, bool) {
+	if from == to {
+		return value, true
+	}
+
+	if from == "int" {
+		value = fmt.Sprintf("strconv.Itoa(%s)", value)
+	}
+
+	switch {
+	case to == "string":
+		return value, true
+	case g.isEnumType(to):
+		return fmt.Sprintf("%s(%s)", to, value), true
+	}
+
+	return "", false
+}
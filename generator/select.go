@@ -0,0 +1,128 @@
+package generator
+
+import "fmt"
+
+// OptionalSessionMessages are added to the selected messages if the dictionary defines them,
+// since the session and the router make use of them.
+var OptionalSessionMessages = []string{"SequenceReset", "BusinessMessageReject"}
+
+// SetMessages restricts the generated messages to the specified ones, given by their names or types.
+// The session messages, and the components, groups, fields and enums used by the messages are selected as well.
+// All messages are generated if the list is empty.
+func (g *Generator) SetMessages(messages []string) {
+	g.selected = messages
+}
+
+// selectMessages replaces the document by the one containing only the selected messages
+// and the items they reference.
+func (g *Generator) selectMessages() error {
+	if len(g.selected) == 0 {
+		return nil
+	}
+
+	byName := make(map[string]*Component, len(g.doc.Messages))
+	byType := make(map[string]*Component, len(g.doc.Messages))
+	for _, message := range g.doc.Messages {
+		byName[message.Name] = message
+		byType[message.MsgType] = message
+	}
+
+	selected := make(map[string]bool)
+	for _, name := range g.selected {
+		message, ok := byName[name]
+		if !ok {
+			message, ok = byType[name]
+		}
+		if !ok {
+			return fmt.Errorf("the selected message is not found: %s", name)
+		}
+
+		selected[message.Name] = true
+	}
+	for _, name := range SessionMessages {
+		selected[name] = true
+	}
+	for _, name := range OptionalSessionMessages {
+		if _, ok := byName[name]; ok {
+			selected[name] = true
+		}
+	}
+
+	components := make(map[string]*Component, len(g.doc.Components))
+	for _, component := range g.doc.Components {
+		components[component.Name] = component
+	}
+
+	usedComponents := make(map[string]bool)
+	usedFields := make(map[string]bool)
+	var walk func(members []*ComponentMember)
+	walk = func(members []*ComponentMember) {
+		for _, member := range members {
+			switch member.XMLName.Local {
+			case ComponentItem:
+				if usedComponents[member.Name] {
+					continue
+				}
+				usedComponents[member.Name] = true
+
+				if component, ok := components[member.Name]; ok {
+					walk(component.Members)
+				}
+			default:
+				usedFields[member.Name] = true
+				walk(member.Members)
+			}
+		}
+	}
+
+	if g.doc.Header != nil {
+		walk(g.doc.Header.Members)
+	}
+	if g.doc.Trailer != nil {
+		walk(g.doc.Trailer.Members)
+	}
+
+	messages := make([]*Component, 0, len(selected))
+	for _, message := range g.doc.Messages {
+		if !selected[message.Name] {
+			continue
+		}
+
+		messages = append(messages, message)
+		walk(message.Members)
+		for _, field := range DefaultFlowFields[message.Name] {
+			usedFields[field] = true
+		}
+	}
+
+	for _, tag := range sessionTags {
+		usedFields[tag.field] = true
+	}
+	usedFields["SessionRejectReason"] = true
+
+	// The length fields of the data fields might be referenced by the excluded messages only.
+	for _, field := range g.doc.Fields {
+		if usedFields[field.Name] && g.typeCast[field.Type] == fixData {
+			usedFields[field.Name+"Len"], usedFields[field.Name+"Length"] = true, true
+		}
+	}
+
+	doc := *g.doc
+	doc.Messages = messages
+	doc.Components = make([]*Component, 0, len(usedComponents))
+	for _, component := range g.doc.Components {
+		if usedComponents[component.Name] {
+			doc.Components = append(doc.Components, component)
+		}
+	}
+	doc.Fields = make([]*Field, 0, len(usedFields))
+	for _, field := range g.doc.Fields {
+		if usedFields[field.Name] {
+			doc.Fields = append(doc.Fields, field)
+		}
+	}
+
+	g.doc = &doc
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SessionMessages are the messages without which a session could not be run,
+// the generation of the SessionOpts function fails if the dictionary lacks any of them.
+// The other DefaultFlowFields messages, such as SequenceReset or NewOrderSingle, are not required,
+// so their builders are set only if the dictionary contains them.
+var SessionMessages = []string{"Logon", "Logout", "Heartbeat", "TestRequest", "ResendRequest", "Reject"}
+
+// sessionTags maps the messages.Tags fields to the fields of the dictionary.
+var sessionTags = []struct {
+	name  string
+	field string
+}{
+	{"MsgType", "MsgType"},
+	{"MsgSeqNum", "MsgSeqNum"},
+	{"HeartBtInt", "HeartBtInt"},
+	{"EncryptedMethod", "EncryptMethod"},
+}
+
+// sessionErrorCodes maps the messages.SessionErrorCodes fields to the standard SessionRejectReason codes.
+var sessionErrorCodes = []struct {
+	name string
+	code string
+}{
+	{"InvalidTagNumber", "0"},
+	{"RequiredTagMissing", "1"},
+	{"TagNotDefinedForMessageType", "2"},
+	{"UndefinedTag", "3"},
+	{"TagSpecialWithoutValue", "4"},
+	{"IncorrectValue", "5"},
+	{"IncorrectDataFormatValue", "6"},
+	{"DecryptionProblem", "7"},
+	{"SignatureProblem", "8"},
+	{"CompIDProblem", "9"},
+	{"InvalidMsgType", "11"},
+	{"TagAppearsMoreThanOnce", "13"},
+	{"TagSpecifiedOutOfOrder", "14"},
+	{"GroupFieldsOutOfOrder", "15"},
+	{"IncorrectNumInGroupCount", "16"},
+	{"Other", "99"},
+}
+
+// encryptMethodNone is the EncryptMethod value allowed by the session.
+const encryptMethodNone = "0"
+
+// makeSessionOpts makes the SessionOpts function returning the session options filled from the dictionary.
+func (g *Generator) makeSessionOpts() (string, error) {
+	messages := make(map[string]bool, len(g.doc.Messages))
+	for _, message := range g.doc.Messages {
+		messages[message.Name] = true
+	}
+
+	for _, name := range SessionMessages {
+		if !messages[name] {
+			return "", fmt.Errorf("the message required for the session is not found: %s", name)
+		}
+	}
+
+	flowMessages := make([]string, 0, len(DefaultFlowFields))
+	for name := range DefaultFlowFields {
+		if messages[name] {
+			flowMessages = append(flowMessages, name)
+		}
+	}
+	sort.Strings(flowMessages)
+
+	builders := make([]string, 0, len(flowMessages))
+	for _, name := range flowMessages {
+		builders = append(builders, fmt.Sprintf("%sBuilder: %s{}.New(),", name, name))
+	}
+
+	tags := make([]string, 0, len(sessionTags))
+	for _, tag := range sessionTags {
+		field, ok := g.fields[tag.field]
+		if !ok {
+			field, ok = g.enums[tag.field]
+		}
+		if !ok {
+			return "", fmt.Errorf("the field required for the session is not found: %s", tag.field)
+		}
+
+		tags = append(tags, fmt.Sprintf("%s: %s,", tag.name, field.Number))
+	}
+
+	var encryptMethods []string
+	if field, ok := g.enums["EncryptMethod"]; ok {
+		for _, value := range field.Values {
+			if value.Enum == encryptMethodNone {
+				encryptMethods = append(encryptMethods,
+					fmt.Sprintf("string(%s): {},", g.makeEnumVariantName(g.makeEnumName(field), value)))
+			}
+		}
+	}
+
+	values := make(map[string]*Value)
+	if field, ok := g.enums["SessionRejectReason"]; ok {
+		for _, value := range field.Values {
+			values[value.Enum] = value
+		}
+	}
+
+	errorCodes := make([]string, 0, len(sessionErrorCodes))
+	for _, code := range sessionErrorCodes {
+		value, ok := values[code.code]
+		if !ok {
+			// The older dictionaries lack some of the codes, e.g. FIX 4.2 defines them up to 11.
+			// The standard code is set, since the zero value would stand for InvalidTagNumber.
+			errorCodes = append(errorCodes,
+				fmt.Sprintf("%s: %s, // the standard code, not defined by the dictionary", code.name, code.code))
+			continue
+		}
+
+		errorCodes = append(errorCodes, fmt.Sprintf("%s: %s, // %s", code.name, value.Enum, value.Description))
+	}
+
+	return g.mustExecuteTemplate(sessionOptsTemplateFormat, sessionOptsTemplate{
+		Builders:       strings.Join(builders, "\n"),
+		Tags:           strings.Join(tags, "\n"),
+		EncryptMethods: strings.Join(encryptMethods, "\n"),
+		ErrorCodes:     strings.Join(errorCodes, "\n"),
+	}), nil
+}
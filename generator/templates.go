@@ -17,6 +17,24 @@ type constantTemplate struct {
 
 var constantTemplateFormat = `	{{.Name}} = "{{.Value}}"`
 
+type fieldNameTemplate struct {
+	FieldName string
+	Name      string
+}
+
+var fieldNameTemplateFormat = `	{{.FieldName}}: "{{.Name}}",`
+
+type fieldNamesTemplate struct {
+	Names string
+}
+
+var fieldNamesTemplateFormat = `
+// fieldNames are the names of the fields, which are the keys of the FIX JSON encoding.
+var fieldNames = fix.FieldNames{
+{{.Names}}
+}
+`
+
 type argTemplate struct {
 	Name string
 	Type string
@@ -89,7 +107,7 @@ func New{{.Name}}() *{{.Name}} {
 func ({{.LocalName}} *{{.Name}}) Header() *Header {
 	header := {{.LocalName}}.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func ({{.LocalName}} *{{.Name}}) HeaderBuilder() messages.HeaderBuilder {
@@ -99,10 +117,34 @@ func ({{.LocalName}} *{{.Name}}) HeaderBuilder() messages.HeaderBuilder {
 func ({{.LocalName}} *{{.Name}}) Trailer() *Trailer {
 	trailer := {{.LocalName}}.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 {{.GetterSetters}}
+
+{{.Validate}}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func ({{.LocalName}} *{{.Name}}) MarshalJSON() ([]byte, error) {
+	return {{.LocalName}}.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func ({{.LocalName}} *{{.Name}}) RedactedJSON() ([]byte, error) {
+	return {{.LocalName}}.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func ({{.LocalName}} *{{.Name}}) UnmarshalJSON(data []byte) error {
+	msg := make{{.Name}}()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*{{.LocalName}} = *msg
+
+	return nil
+}
 `
 
 type messageTemplate struct {
@@ -113,6 +155,7 @@ type messageTemplate struct {
 	Fields        string
 	Setters       string
 	GetterSetters string
+	Validate      string
 }
 
 type defaultFlowMessage struct {
@@ -168,24 +211,74 @@ type fieldGetterSetterTemplate struct {
 
 type enumVariantTemplate struct {
 	Name  string
+	Enum  string
 	Value string
 }
 
-var enumVariantTemplateFormat = `{{.Name}} string = "{{.Value}}"`
+var enumVariantTemplateFormat = `{{.Name}} {{.Enum}} = "{{.Value}}"`
 
 type enumTemplate struct {
-	Name     string
-	FixType  string
-	Variants string
+	Name      string
+	FieldName string
+	Tag       string
+	Variants  string
+	// Descriptions are the cases of the String method.
+	Descriptions string
+	// Values are the constants of the valid values.
+	Values        string
+	MultipleValue bool
 }
 
 var enumTemplateFormat = `
-// Enum type {{.Name}}
+// {{.Name}} is the type of the {{.FieldName}}({{.Tag}}) field values.
+type {{.Name}} string
+
 const (
  {{.Variants}}
 )
+
+// String returns the description of the value.
+func (v {{.Name}}) String() string {
+	switch v {
+{{.Descriptions}}
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+{{- if .MultipleValue}}
+// The value is a space-separated list, so each of its items is checked.
+func (v {{.Name}}) IsValid() bool {
+	return fix.IsValidMultipleValue(string(v), func(value string) bool {
+		switch {{.Name}}(value) {
+		case {{.Values}}:
+			return true
+		}
+
+		return false
+	})
+}
+{{- else}}
+func (v {{.Name}}) IsValid() bool {
+	switch v {
+	case {{.Values}}:
+		return true
+	}
+
+	return false
+}
+{{- end}}
 `
 
+type enumDescriptionTemplate struct {
+	Name        string
+	Description string
+}
+
+var enumDescriptionTemplateFormat = `	case {{.Name}}:
+		return {{printf "%q" .Description}}`
+
 var fieldGetterSetterTemplateFormat = `
 func ({{.ComponentName}} *{{.ComponentType}}) {{.Name}}() {{.Type}} {
 	kv := {{.ComponentName}}.Get({{.Index}})
@@ -206,11 +299,23 @@ func ({{.ComponentName}} *{{.ComponentType}}) SetField{{.Name}}({{.LocalName}} {
 }
 `
 
+// defaultEnumFieldTemplateFormat provides the session pipelines with the enum values as strings,
+// since the pipelines do not depend on the generated types.
+var defaultEnumFieldTemplateFormat = `
+func ({{.ComponentName}} *{{.ComponentType}}) SetField{{.Name}}({{.LocalName}} string) messages.{{.ComponentType}}Builder {
+	return {{.ComponentName}}.Set{{.Name}}({{.Type}}({{.LocalName}}))
+}
+
+func ({{.ComponentName}} *{{.ComponentType}}) Field{{.Name}}() string {
+	return string({{.ComponentName}}.{{.Name}}())
+}
+`
+
 var groupGetterSetterTemplateFormat = `
 func ({{.ComponentName}} *{{.ComponentType}}) {{.Name}}() *{{.Type}} {
 	group := {{.ComponentName}}.Get({{.Index}}).(*fix.Group)
 	
-	return &{{.Type}}{group}
+	return &{{.Type}}{Group: group}
 }
 
 func ({{.ComponentName}} *{{.ComponentType}}) Set{{.Name}}({{.LocalName}} *{{.Type}}) *{{.ComponentType}} {
@@ -224,7 +329,7 @@ var componentGetterSetterTemplateFormat = `
 func ({{.ComponentName}} *{{.ComponentType}}) {{.Name}}() *{{.Type}} {
 	component := {{.ComponentName}}.Get({{.Index}}).(*fix.Component)
 	
-	return &{{.Type}}{component}
+	return &{{.Type}}{Component: component}
 }
 
 func ({{.ComponentName}} *{{.ComponentType}}) Set{{.Name}}({{.LocalName}} *{{.Type}}) *{{.ComponentType}} {
@@ -234,6 +339,36 @@ func ({{.ComponentName}} *{{.ComponentType}}) Set{{.Name}}({{.LocalName}} *{{.Ty
 }
 `
 
+type validateTemplate struct {
+	Name      string
+	LocalName string
+	Checks    string
+}
+
+var validateTemplateFormat = `
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func ({{.LocalName}} *{{.Name}}) Validate() error {
+	{{if .Checks}}{{.Checks}}
+
+	{{end}}return nil
+}
+`
+
+var groupValidateTemplateFormat = `
+// Validate checks the entries of the group.
+func (group *{{.Name}}) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+`
+
 type setterCallTemplate struct {
 	Name  string
 	Value string
@@ -311,3 +446,82 @@ import (
 
 {{.Data}}
 `
+
+type routerTemplate struct {
+	Routes             string
+	BusinessRejectFunc string
+	BusinessReject     string
+}
+
+var routerTemplateFormat = `
+// Router unmarshals the incoming messages and passes them to the typed handlers.
+type Router struct {
+	*simplefixgo.MessageRouter
+}
+
+// NewRouter creates a Router attached to a handler, e.g. *simplefixgo.DefaultHandler.
+// The messages are unmarshalled by the session, which also rejects the ones that could not be handled.
+func NewRouter(handler simplefixgo.IncomingHandler, session simplefixgo.RouterSession) *Router {
+	return &Router{
+		MessageRouter: simplefixgo.NewMessageRouter(handler, session, {{.BusinessRejectFunc}}),
+	}
+}
+
+{{.Routes}}
+{{.BusinessReject}}
+`
+
+type routeTemplate struct {
+	Name string
+}
+
+var routeTemplateFormat = `
+// On{{.Name}} registers a handler of the incoming {{.Name}} message.
+func (r *Router) On{{.Name}}(handle func(ctx context.Context, msg *{{.Name}}) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgType{{.Name}}, New{{.Name}}, handle)
+	return r
+}
+`
+
+type businessRejectTemplate struct {
+	Name    string
+	Setters string
+}
+
+var businessRejectTemplateFormat = `
+func makeBusinessReject(reject simplefixgo.BusinessReject) messages.Message {
+	msg := New{{.Name}}()
+	{{.Setters}}
+
+	return msg
+}
+`
+
+type sessionOptsTemplate struct {
+	Builders       string
+	Tags           string
+	EncryptMethods string
+	ErrorCodes     string
+}
+
+var sessionOptsTemplateFormat = `
+// SessionOpts returns the session options filled with the message builders, tags and error codes of the package.
+func SessionOpts() *session.Opts {
+	return &session.Opts{
+		MessageBuilders: session.MessageBuilders{
+			HeaderBuilder:  Header{}.New(),
+			TrailerBuilder: Trailer{}.New(),
+			{{.Builders}}
+		},
+		Tags: &messages.Tags{
+			{{.Tags}}
+		},
+		AllowedEncryptedMethods: map[string]struct{}{
+			{{.EncryptMethods}}
+		},
+		SessionErrorCodes: &messages.SessionErrorCodes{
+			{{.ErrorCodes}}
+		},
+	}
+}
+`
@@ -3,28 +3,54 @@ package generator
 import (
 	"fmt"
 	"strings"
+
+	"github.com/b2broker/simplefix-go/fix"
 )
 
 const (
-	fixFloat  = "Float"
-	fixInt    = "Int"
-	fixRaw    = "Raw"
-	fixBool   = "Bool"
-	fixString = "String"
-	fixTime   = "Time"
+	fixFloat        = "Float"
+	fixInt          = "Int"
+	fixRaw          = "Raw"
+	fixBool         = "Bool"
+	fixString       = "String"
+	fixTime         = "Time"
+	fixData         = "Data"
+	fixDecimal      = "Decimal"
+	fixUTCTimestamp = "UTCTimestamp"
+	fixTZTimestamp  = "TZTimestamp"
+	fixUTCTimeOnly  = "UTCTimeOnly"
+	fixUTCDateOnly  = "UTCDateOnly"
+	fixLocalMktDate = "LocalMktDate"
+	fixMonthYear    = "MonthYearValue"
 )
 
 var allowedTypes = map[string]string{
-	fixFloat:  "float64",
-	fixInt:    "int",
-	fixRaw:    "[]byte",
-	fixBool:   "bool",
-	fixString: "string",
-	fixTime:   "time.Time",
+	fixFloat:        "float64",
+	fixInt:          "int",
+	fixRaw:          "[]byte",
+	fixBool:         "bool",
+	fixString:       "string",
+	fixTime:         "time.Time",
+	fixData:         "[]byte",
+	fixDecimal:      "fix.Decimal",
+	fixUTCTimestamp: "time.Time",
+	fixTZTimestamp:  "time.Time",
+	fixUTCTimeOnly:  "time.Time",
+	fixUTCDateOnly:  "fix.Date",
+	fixLocalMktDate: "fix.Date",
+	fixMonthYear:    "fix.MonthYear",
+}
+
+// precisionTypes are the types whose precision is specified by the format attribute.
+var precisionTypes = map[string]bool{
+	fixUTCTimestamp: true,
+	fixTZTimestamp:  true,
+	fixUTCTimeOnly:  true,
 }
 
 func (g *Generator) initTypes() {
 	g.typeCast = make(map[string]string, len(g.config.Types))
+	g.typePrecision = make(map[string]string)
 
 	for _, tp := range g.config.Types {
 		if tp.CastType == "" {
@@ -44,17 +70,69 @@ func (g *Generator) initTypes() {
 		}
 
 		g.typeCast[tp.Name] = tp.CastType
+
+		if tp.Format != "" && precisionTypes[tp.CastType] {
+			precision, err := formatPrecision(tp.Format)
+			if err != nil {
+				panic(fmt.Errorf("unexpected format attribute of type %s: %w", tp.Name, err))
+			}
+			if precision != fix.Milliseconds {
+				g.typePrecision[tp.Name] = "fix." + precision.String()
+			}
+		}
 	}
 }
 
+// formatPrecision returns the precision of a Go time layout by the number of the fractional digits of seconds.
+func formatPrecision(layout string) (fix.TimePrecision, error) {
+	i := strings.Index(layout, "15:04:05")
+	if i == -1 {
+		return 0, fmt.Errorf("the layout %s has no 15:04:05 clock", layout)
+	}
+
+	var digits int
+	if fraction := layout[i+len("15:04:05"):]; strings.HasPrefix(fraction, ".") {
+		for _, c := range fraction[1:] {
+			if c != '0' && c != '9' {
+				break
+			}
+			digits++
+		}
+	}
+
+	return fix.PrecisionOf(digits)
+}
+
+// multipleValueTypes are the types of the fields whose values are space-separated lists.
+var multipleValueTypes = map[string]bool{
+	"MULTIPLEVALUESTRING": true,
+	"MULTIPLESTRINGVALUE": true,
+	"MULTIPLECHARVALUE":   true,
+}
+
 func (g *Generator) fixTypeToGo(t string) string {
 	if tp, ok := allowedTypes[t]; ok {
 		return tp
 	}
 
+	if g.isEnumType(t) {
+		return t
+	}
+
 	return "string"
 }
 
+// isEnumType returns true if the type is an enum made by makeEnumName.
+func (g *Generator) isEnumType(t string) bool {
+	name, ok := strings.CutPrefix(t, "Enum")
+	if !ok {
+		return false
+	}
+
+	_, ok = g.enums[name]
+	return ok
+}
+
 func (g *Generator) typeToFix(t string) string {
 	if tp, ok := g.typeCast[t]; ok {
 		return tp
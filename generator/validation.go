@@ -0,0 +1,436 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// validationScope resolves the fields referenced by the conditions of a message, component or group entry.
+// The fields of the nested components are in the scope as well, while the fields of the group entries are not.
+type validationScope struct {
+	items  map[string]string
+	fields map[string]*Field
+}
+
+// makeValidationScope collects the fields of the members, the items are accessed through the receiver.
+func (g *Generator) makeValidationScope(receiver string, members []*ComponentMember) *validationScope {
+	scope := &validationScope{items: make(map[string]string), fields: make(map[string]*Field)}
+	g.collectScope(scope, receiver, members)
+
+	return scope
+}
+
+func (g *Generator) collectScope(scope *validationScope, parent string, members []*ComponentMember) {
+	for i, member := range members {
+		item := fmt.Sprintf("%s.Get(%d)", parent, i)
+
+		switch member.XMLName.Local {
+		case FieldItem, GroupItem:
+			if _, ok := scope.items[member.Name]; ok {
+				continue
+			}
+
+			field, ok := g.fields[member.Name]
+			if !ok {
+				field = g.enums[member.Name]
+			}
+
+			scope.items[member.Name] = item
+			scope.fields[member.Name] = field
+
+		case ComponentItem:
+			if component, ok := g.components[member.Name]; ok {
+				g.collectScope(scope, item+".(*fix.Component)", g.componentMembers(component))
+			}
+		}
+	}
+}
+
+// componentMembers returns the members of a component in the order of its items.
+func (g *Generator) componentMembers(component *Component) []*ComponentMember {
+	return g.includedMembers(component.Members)
+}
+
+// includedMembers returns the members without the excluded fields, so their indexes are the indexes of the items.
+func (g *Generator) includedMembers(members []*ComponentMember) []*ComponentMember {
+	included := make([]*ComponentMember, 0, len(members))
+	for _, member := range members {
+		if !g.isFieldExcluded(member.Name) {
+			included = append(included, member)
+		}
+	}
+
+	return included
+}
+
+// makeValidate makes the Validate method checking the required members, including the ones of the nested
+// components and group entries, and the conditionally required members.
+func (g *Generator) makeValidate(typeName string, members []*ComponentMember) (string, error) {
+	receiver := g.makeLocalName(typeName)
+	scope := g.makeValidationScope(receiver, members)
+
+	checks := make([]string, 0, len(members))
+	for i, member := range members {
+		item := fmt.Sprintf("%s.Get(%d)", receiver, i)
+
+		var tag, getter string
+		switch member.XMLName.Local {
+		case FieldItem:
+			tag = g.makeFieldName(member.Name)
+		case GroupItem:
+			tag, getter = g.makeFieldName(member.Name), g.makeGroupTypeName(member.Name)
+		case ComponentItem:
+			tag, getter = `""`, member.Name
+		}
+
+		if member.Required == "Y" {
+			checks = append(checks, fmt.Sprintf(
+				"if !fix.IsSet(%s) {\nreturn fix.NewValidationError(%s, %q, \"\")\n}", item, tag, member.Name))
+		}
+
+		if member.RequiredWhen != "" {
+			condition, err := compileCondition(member.RequiredWhen, scope)
+			if err != nil {
+				return "", fmt.Errorf("%s: the condition of %s: %w", typeName, member.Name, err)
+			}
+
+			checks = append(checks, fmt.Sprintf(
+				"if %s && !fix.IsSet(%s) {\nreturn fix.NewValidationError(%s, %q, %q)\n}",
+				condition, item, tag, member.Name, member.RequiredWhen))
+		}
+
+		switch {
+		case member.XMLName.Local == GroupItem:
+			checks = append(checks, fmt.Sprintf(
+				"if err := %s.%s().Validate(); err != nil {\nreturn err\n}", receiver, getter))
+
+		case member.XMLName.Local == ComponentItem && member.Required == "Y":
+			checks = append(checks, fmt.Sprintf(
+				"if err := %s.%s().Validate(); err != nil {\nreturn err\n}", receiver, getter))
+
+		case member.XMLName.Local == ComponentItem:
+			checks = append(checks, fmt.Sprintf(
+				"if fix.IsSet(%s) {\nif err := %s.%s().Validate(); err != nil {\nreturn err\n}\n}", item, receiver, getter))
+		}
+	}
+
+	return g.mustExecuteTemplate(validateTemplateFormat, validateTemplate{
+		Name:      typeName,
+		LocalName: receiver,
+		Checks:    strings.Join(checks, "\n\n"),
+	}), nil
+}
+
+// mustMakeValidate makes the Validate method, the conditions are checked by checkConditions beforehand.
+func (g *Generator) mustMakeValidate(typeName string, members []*ComponentMember) string {
+	validate, err := g.makeValidate(typeName, members)
+	if err != nil {
+		panic(err)
+	}
+
+	return validate
+}
+
+// checkConditions makes sure that the conditions of all members could be compiled before writing any files.
+func (g *Generator) checkConditions() error {
+	for _, message := range g.doc.Messages {
+		if _, err := g.makeValidate(message.Name, g.includedMembers(message.Members)); err != nil {
+			return err
+		}
+	}
+	for _, component := range g.components {
+		if _, err := g.makeValidate(component.Name, g.componentMembers(component)); err != nil {
+			return err
+		}
+	}
+	for _, group := range g.groups {
+		if _, err := g.makeValidate(g.makeGroupEntryTypeName(group.Name), g.includedMembers(group.Members)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compileCondition compiles a condition into a Go expression. The conditions follow a subset
+// of the FIX Orchestra Score syntax:
+//   - OrdType == 2, OrdType != ^Market, OrdType in {^Stop, ^StopLimit}, exists StopPx;
+//   - the values are the wire values, quoted strings, or the names of the enum values prefixed by ^,
+//     e.g. ^StopLimit or ^STOP_LIMIT;
+//   - the conditions are combined with &&, || and !, or with and, or and not, and grouped with parentheses.
+func compileCondition(condition string, scope *validationScope) (string, error) {
+	tokens, err := tokenizeCondition(condition)
+	if err != nil {
+		return "", err
+	}
+
+	p := &conditionParser{tokens: tokens, scope: scope}
+	expr, err := p.or()
+	if err != nil {
+		return "", err
+	}
+	if p.pos != len(p.tokens) {
+		return "", fmt.Errorf("unexpected %q in %q", p.tokens[p.pos], condition)
+	}
+
+	return expr, nil
+}
+
+func tokenizeCondition(condition string) ([]string, error) {
+	var tokens []string
+	r := []rune(condition)
+	for i := 0; i < len(r); {
+		switch c := r[i]; {
+		case unicode.IsSpace(c):
+			i++
+
+		case strings.HasPrefix(string(r[i:]), "&&"), strings.HasPrefix(string(r[i:]), "||"),
+			strings.HasPrefix(string(r[i:]), "=="), strings.HasPrefix(string(r[i:]), "!="):
+			tokens = append(tokens, string(r[i:i+2]))
+			i += 2
+
+		case strings.ContainsRune("!=(){},", c):
+			tokens = append(tokens, string(c))
+			i++
+
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(r) && r[end] != c {
+				end++
+			}
+			if end == len(r) {
+				return nil, fmt.Errorf("unterminated string in %q", condition)
+			}
+
+			tokens = append(tokens, string(r[i:end+1]))
+			i = end + 1
+
+		case c == '^' || c == '_' || c == '.' || c == '-' || unicode.IsLetter(c) || unicode.IsDigit(c):
+			end := i + 1
+			for end < len(r) && (r[end] == '_' || r[end] == '.' || r[end] == '-' ||
+				unicode.IsLetter(r[end]) || unicode.IsDigit(r[end])) {
+				end++
+			}
+
+			tokens = append(tokens, string(r[i:end]))
+			i = end
+
+		default:
+			return nil, fmt.Errorf("unexpected %q in %q", c, condition)
+		}
+	}
+
+	return tokens, nil
+}
+
+type conditionParser struct {
+	tokens []string
+	pos    int
+	scope  *validationScope
+}
+
+func (p *conditionParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return ""
+}
+
+func (p *conditionParser) next() (string, error) {
+	if p.pos == len(p.tokens) {
+		return "", fmt.Errorf("unexpected end of the condition")
+	}
+
+	p.pos++
+
+	return p.tokens[p.pos-1], nil
+}
+
+func (p *conditionParser) expect(token string) error {
+	next, err := p.next()
+	if err != nil {
+		return err
+	}
+	if next != token {
+		return fmt.Errorf("%q is expected instead of %q", token, next)
+	}
+
+	return nil
+}
+
+func (p *conditionParser) or() (string, error) {
+	expr, err := p.and()
+	if err != nil {
+		return "", err
+	}
+
+	for p.peek() == "||" || p.peek() == "or" {
+		p.pos++
+
+		right, err := p.and()
+		if err != nil {
+			return "", err
+		}
+
+		expr = fmt.Sprintf("(%s || %s)", expr, right)
+	}
+
+	return expr, nil
+}
+
+func (p *conditionParser) and() (string, error) {
+	expr, err := p.unary()
+	if err != nil {
+		return "", err
+	}
+
+	for p.peek() == "&&" || p.peek() == "and" {
+		p.pos++
+
+		right, err := p.unary()
+		if err != nil {
+			return "", err
+		}
+
+		expr = fmt.Sprintf("%s && %s", expr, right)
+	}
+
+	return expr, nil
+}
+
+func (p *conditionParser) unary() (string, error) {
+	token, err := p.next()
+	if err != nil {
+		return "", err
+	}
+
+	switch token {
+	case "!", "not":
+		expr, err := p.unary()
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("!(%s)", expr), nil
+
+	case "(":
+		expr, err := p.or()
+		if err != nil {
+			return "", err
+		}
+		if err = p.expect(")"); err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("(%s)", expr), nil
+
+	case "exists":
+		name, err := p.next()
+		if err != nil {
+			return "", err
+		}
+
+		item, _, err := p.field(name)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("fix.IsSet(%s)", item), nil
+	}
+
+	item, field, err := p.field(token)
+	if err != nil {
+		return "", err
+	}
+	value := fmt.Sprintf("fix.FieldValue(%s)", item)
+
+	op, err := p.next()
+	if err != nil {
+		return "", err
+	}
+
+	switch op {
+	case "==", "=", "!=":
+		literal, err := p.value(field)
+		if err != nil {
+			return "", err
+		}
+
+		if op == "=" {
+			op = "=="
+		}
+
+		return fmt.Sprintf("%s %s %s", value, op, literal), nil
+
+	case "in":
+		if err = p.expect("{"); err != nil {
+			return "", err
+		}
+
+		var alternatives []string
+		for {
+			literal, err := p.value(field)
+			if err != nil {
+				return "", err
+			}
+			alternatives = append(alternatives, fmt.Sprintf("%s == %s", value, literal))
+
+			separator, err := p.next()
+			if err != nil {
+				return "", err
+			}
+			if separator == "}" {
+				break
+			}
+			if separator != "," {
+				return "", fmt.Errorf("\",\" or \"}\" is expected instead of %q", separator)
+			}
+		}
+
+		return fmt.Sprintf("(%s)", strings.Join(alternatives, " || ")), nil
+	}
+
+	return "", fmt.Errorf("unsupported operator %q", op)
+}
+
+func (p *conditionParser) field(name string) (string, *Field, error) {
+	item, ok := p.scope.items[name]
+	if !ok {
+		return "", nil, fmt.Errorf("the field %s is not found", name)
+	}
+
+	return item, p.scope.fields[name], nil
+}
+
+// value returns the literal of a wire value, the names of the enum values are resolved by the field.
+func (p *conditionParser) value(field *Field) (string, error) {
+	token, err := p.next()
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case strings.HasPrefix(token, "^"):
+		name := strings.ReplaceAll(token[1:], "_", "")
+		if field != nil {
+			for _, value := range field.Values {
+				if strings.EqualFold(strings.ReplaceAll(value.Description, "_", ""), name) {
+					return strconv.Quote(value.Enum), nil
+				}
+			}
+		}
+
+		return "", fmt.Errorf("the value %s is not defined", token)
+
+	case strings.HasPrefix(token, `"`), strings.HasPrefix(token, "'"):
+		return strconv.Quote(token[1 : len(token)-1]), nil
+
+	case strings.ContainsAny(token, "(){},!=&|"):
+		return "", fmt.Errorf("a value is expected instead of %q", token)
+	}
+
+	return strconv.Quote(token), nil
+}
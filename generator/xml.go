@@ -44,6 +44,8 @@ type ComponentMember struct {
 	XMLName  xml.Name
 	Name     string `xml:"name,attr"`
 	Required string `xml:"required,attr"`
+	// RequiredWhen is the condition making an optional member required, e.g. OrdType in {^Stop, ^StopLimit}.
+	RequiredWhen string `xml:"requiredWhen,attr"`
 
 	Members []*ComponentMember `xml:",any"`
 }
@@ -57,4 +59,7 @@ type Type struct {
 
 	Name     string `xml:"name,attr"`
 	CastType string `xml:"cast,attr"`
+	// Format is the Go layout of the temporal types, which specifies the precision of written values,
+	// e.g. 20060102-15:04:05.000000 for microseconds.
+	Format string `xml:"format,attr"`
 }
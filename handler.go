@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/b2broker/simplefix-go/fix"
 	"github.com/b2broker/simplefix-go/fix/buffer"
@@ -28,6 +30,8 @@ type DefaultHandler struct {
 
 	out      chan []byte
 	incoming chan []byte
+	// garbled receives the reasons of discarding the messages which could not be framed by a connection.
+	garbled chan error
 
 	incomingHandlers IncomingHandlerPool
 	outgoingHandlers OutgoingHandlerPool
@@ -36,6 +40,17 @@ type DefaultHandler struct {
 	messageConverter *fix.MessageByteConverter
 
 	msgTypeTag string
+	logger     Logger
+	metrics    sessionMetrics
+
+	// logMessages is false if the logger discards the messages, so they are not redacted.
+	logMessages bool
+
+	skipIntegrityCheck bool
+	garbledLimit       int64
+	garbledMessages    atomic.Int64
+	// garbledInRow is the number of consecutive garbled messages compared with garbledLimit.
+	garbledInRow int64
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -50,11 +65,13 @@ func NewAcceptorHandler(ctx context.Context, msgTypeTag string, bufferSize int)
 
 		out:      make(chan []byte, bufferSize),
 		incoming: make(chan []byte, bufferSize),
+		garbled:  make(chan error, bufferSize),
 		errors:   make(chan error),
 
 		incomingHandlers: NewIncomingHandlerPool(),
 		outgoingHandlers: NewOutgoingHandlerPool(),
 		messageConverter: fix.NewMessageByteConverter(500),
+		logger:           NopLogger{},
 	}
 
 	sh.ctx, sh.cancel = context.WithCancel(ctx)
@@ -70,11 +87,13 @@ func NewInitiatorHandler(ctx context.Context, msgTypeTag string, bufferSize int)
 
 		out:      make(chan []byte, bufferSize),
 		incoming: make(chan []byte, bufferSize),
+		garbled:  make(chan error, bufferSize),
 		errors:   make(chan error),
 
 		incomingHandlers: NewIncomingHandlerPool(),
 		outgoingHandlers: NewOutgoingHandlerPool(),
 		messageConverter: fix.NewMessageByteConverter(500),
+		logger:           NopLogger{},
 	}
 
 	sh.ctx, sh.cancel = context.WithCancel(ctx)
@@ -88,9 +107,65 @@ func (h *DefaultHandler) sendRaw(data []byte) error {
 	case <-h.ctx.Done():
 		return fmt.Errorf("the handler is stopped")
 	}
+	if h.logMessages {
+		h.logger.OnOutgoing(fix.Redact(data))
+	}
+	h.metrics.add(MetricMessagesSent, 1)
+
 	return nil
 }
 
+// SetLogger sets a logger recording all incoming and outgoing messages and the handler events.
+// It could be called only before starting the handler.
+func (h *DefaultHandler) SetLogger(logger Logger) {
+	h.logger = logger
+
+	// The messages are redacted only to be logged, so it is skipped if the logger discards them.
+	switch logger.(type) {
+	case NopLogger, *NopLogger:
+		h.logMessages = false
+	default:
+		h.logMessages = true
+	}
+}
+
+// SetMetrics sets a sink for the handler metrics reported under the specified session ID.
+// Nothing is reported while the session ID is empty.
+// It could be called only before starting the handler.
+func (h *DefaultHandler) SetMetrics(metrics Metrics, sessionID string) {
+	h.metrics.set(metrics, sessionID)
+}
+
+// setMetricsSessionID assigns the session ID of an accepted connection unless it has been set explicitly.
+func (h *DefaultHandler) setMetricsSessionID(sessionID string) {
+	if h.metrics.id() == "" {
+		h.metrics.setSessionID(sessionID)
+	}
+}
+
+// SetIntegrityCheck enables or disables the verification of the BodyLength and CheckSum fields
+// of incoming messages. It is enabled by default.
+// Garbled messages are discarded before reaching any incoming handler, so the sequence number is not incremented.
+// It could be called only before starting the handler.
+func (h *DefaultHandler) SetIntegrityCheck(enabled bool) {
+	h.skipIntegrityCheck = !enabled
+}
+
+// SetGarbledMessagesLimit sets the number of consecutive garbled messages after which the handler is stopped
+// with ErrTooManyGarbledMessages, which closes the connection after a Logout is sent by the session.
+// The messages with an invalid BodyLength discarded by the connection are counted as well.
+// The count is reset by each valid message, so the garbled messages spread over a long-running session do not stop it.
+// Zero disables the limit, which is the default.
+// It could be called only before starting the handler.
+func (h *DefaultHandler) SetGarbledMessagesLimit(limit int) {
+	h.garbledLimit = int64(limit)
+}
+
+// GarbledMessages returns the total number of discarded garbled messages.
+func (h *DefaultHandler) GarbledMessages() int64 {
+	return h.garbledMessages.Load()
+}
+
 func (h *DefaultHandler) send(msg SendingMessage) error {
 	ok := h.outgoingHandlers.Range(AllMsgTypes, func(handle OutgoingHandlerFunc) bool {
 		return handle(msg)
@@ -202,7 +277,31 @@ func (h *DefaultHandler) ServeIncoming(msg []byte) {
 	h.incoming <- msg
 }
 
+// ServeGarbled is an internal method for counting the incoming messages with an invalid BodyLength
+// discarded by a connection before reaching the handler.
+func (h *DefaultHandler) ServeGarbled(reason error) {
+	h.garbled <- reason
+}
+
 func (h *DefaultHandler) serve(msg []byte) (err error) {
+	if h.logMessages {
+		h.logger.OnIncoming(fix.Redact(msg))
+	}
+
+	if !h.skipIntegrityCheck {
+		if err = fix.VerifyIntegrity(msg); err != nil {
+			return h.discardGarbled(err)
+		}
+	}
+	h.garbledInRow = 0
+
+	h.metrics.add(MetricMessagesReceived, 1)
+
+	start := time.Now()
+	defer func() {
+		h.metrics.observe(MetricHandlingLatency, time.Since(start))
+	}()
+
 	msgTypeB, err := fix.ValueByTag(msg, h.msgTypeTag)
 	if err != nil {
 		return fmt.Errorf("msg type: %w", err)
@@ -220,8 +319,28 @@ func (h *DefaultHandler) serve(msg []byte) (err error) {
 	return nil
 }
 
+func (h *DefaultHandler) discardGarbled(reason error) error {
+	h.garbledMessages.Add(1)
+	h.garbledInRow++
+	garbled := h.garbledInRow
+
+	h.metrics.add(MetricGarbledMessages, 1)
+	h.logger.OnEvent(fmt.Sprintf("garbled message discarded: %s", reason))
+	h.eventHandlers.Trigger(utils.EventGarbled)
+
+	if h.garbledLimit > 0 && garbled >= h.garbledLimit {
+		// The session sends a Logout in response, which is written before the connection is closed.
+		h.eventHandlers.Trigger(utils.EventGarbledLimit)
+
+		return fmt.Errorf("%w: %d", ErrTooManyGarbledMessages, garbled)
+	}
+
+	return nil
+}
+
 // Run is a function that is used for listening and processing messages.
 func (h *DefaultHandler) Run() (err error) {
+	h.logger.OnEvent("connected")
 	h.eventHandlers.Trigger(utils.EventConnect)
 	defer h.processRemainingErrors()
 
@@ -234,12 +353,21 @@ func (h *DefaultHandler) Run() (err error) {
 
 			err = h.serve(msg)
 			if err != nil {
+				h.logger.OnEvent(fmt.Sprintf("stopped: %s", err))
+				return err
+			}
+
+		case reason := <-h.garbled:
+			err = h.discardGarbled(reason)
+			if err != nil {
+				h.logger.OnEvent(fmt.Sprintf("stopped: %s", err))
 				return err
 			}
 
 		case <-h.ctx.Done():
 			h.processRemainingIncoming()
 
+			h.logger.OnEvent("stopped")
 			h.eventHandlers.Trigger(utils.EventStopped)
 
 			return
@@ -248,7 +376,10 @@ func (h *DefaultHandler) Run() (err error) {
 			h.processRemainingIncoming()
 
 			if errors.Is(err, ErrConnClosed) {
+				h.logger.OnEvent("disconnected")
 				h.eventHandlers.Trigger(utils.EventDisconnect)
+			} else if err != nil {
+				h.logger.OnEvent(fmt.Sprintf("stopped: %s", err))
 			}
 
 			return err
@@ -315,6 +446,16 @@ func (h *DefaultHandler) OnConnect(handlerFunc utils.EventHandlerFunc) {
 	h.eventHandlers.Handle(utils.EventConnect, handlerFunc)
 }
 
+// OnGarbled handles the events of discarding garbled messages.
+func (h *DefaultHandler) OnGarbled(handlerFunc utils.EventHandlerFunc) {
+	h.eventHandlers.Handle(utils.EventGarbled, handlerFunc)
+}
+
+// OnGarbledLimit handles the event of reaching the limit of garbled messages right before the handler is stopped.
+func (h *DefaultHandler) OnGarbledLimit(handlerFunc utils.EventHandlerFunc) {
+	h.eventHandlers.Handle(utils.EventGarbledLimit, handlerFunc)
+}
+
 // OnStopped handles session termination events.
 func (h *DefaultHandler) OnStopped(handlerFunc utils.EventHandlerFunc) {
 	h.eventHandlers.Handle(utils.EventStopped, handlerFunc)
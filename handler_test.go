@@ -0,0 +1,122 @@
+package simplefixgo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type recordingLogger struct {
+	NopLogger
+	outgoing [][]byte
+}
+
+func (l *recordingLogger) OnOutgoing(msg []byte) {
+	l.outgoing = append(l.outgoing, msg)
+}
+
+func TestDefaultHandler_LoggerRedaction(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := NewAcceptorHandler(ctx, "35", 10)
+	if handler.logMessages {
+		t.Fatalf("the messages are redacted for the NopLogger")
+	}
+
+	logger := &recordingLogger{}
+	handler.SetLogger(logger)
+
+	if err := handler.sendRaw([]byte("35=A\x01554=secret\x01")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(logger.outgoing) != 1 || string(logger.outgoing[0]) != "35=A\x01554=***\x01" {
+		t.Fatalf("unexpected logged messages: %q", logger.outgoing)
+	}
+
+	handler.SetLogger(NopLogger{})
+	if handler.logMessages {
+		t.Fatalf("the messages are redacted for the NopLogger")
+	}
+}
+
+func TestDefaultHandler_Garbled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := NewAcceptorHandler(ctx, "35", 10)
+	handler.SetGarbledMessagesLimit(2)
+
+	garbledEvents := make(chan struct{}, 10)
+	handler.OnGarbled(func() bool {
+		garbledEvents <- struct{}{}
+		return true
+	})
+
+	received := make(chan []byte, 10)
+	handler.HandleIncoming(AllMsgTypes, func(msg []byte) bool {
+		received <- msg
+		return true
+	})
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- handler.Run()
+	}()
+
+	heartbeat := makeFrame("35=0\x0134=1\x01")
+	garbled := strings.Replace(heartbeat, "34=1", "34=2", 1)
+
+	handler.ServeIncoming([]byte(garbled))
+	handler.ServeIncoming([]byte(heartbeat))
+
+	select {
+	case msg := <-received:
+		if string(msg) != heartbeat {
+			t.Fatalf("the garbled message is not discarded: %q", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("the valid message is not received")
+	}
+
+	select {
+	case <-garbledEvents:
+	case <-time.After(time.Second):
+		t.Fatalf("no garbled message event")
+	}
+
+	if n := handler.GarbledMessages(); n != 1 {
+		t.Fatalf("unexpected number of garbled messages: %d", n)
+	}
+
+	// The valid message resets the count of the consecutive garbled messages.
+	handler.ServeIncoming([]byte(garbled))
+	select {
+	case <-garbledEvents:
+	case <-time.After(time.Second):
+		t.Fatalf("no garbled message event")
+	}
+
+	select {
+	case err := <-runErr:
+		t.Fatalf("the handler is stopped after a single garbled message in a row: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if n := handler.GarbledMessages(); n != 2 {
+		t.Fatalf("unexpected number of garbled messages: %d", n)
+	}
+
+	handler.ServeIncoming([]byte(garbled))
+
+	select {
+	case err := <-runErr:
+		if !errors.Is(err, ErrTooManyGarbledMessages) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("the handler is not stopped")
+	}
+}
@@ -2,6 +2,7 @@ package simplefixgo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"sync"
@@ -42,6 +43,19 @@ func NewInitiator(conn net.Conn, handler InitiatorHandler, bufSize int, writeDea
 	return c
 }
 
+// SetMetrics sets a sink for the connection metrics reported under the specified session ID.
+// It could be called only before calling Serve.
+func (c *Initiator) SetMetrics(metrics Metrics, sessionID string) {
+	c.conn.SetMetrics(metrics, sessionID)
+}
+
+// SetMaxMessageSize limits the size of incoming messages, the larger ones are discarded.
+// Zero disables the limit. DefaultMaxMessageSize is used by default.
+// It could be called only before calling Serve.
+func (c *Initiator) SetMaxMessageSize(size int) {
+	c.conn.SetMaxMessageSize(size)
+}
+
 // Close is used to cancel the specified Initiator context.
 func (c *Initiator) Close() {
 	c.conn.Close()
@@ -75,10 +89,24 @@ func (c *Initiator) Serve() error {
 		return err
 	})
 
+	// drain is closed when the handler is stopped after too many garbled messages,
+	// so that the Logout sent in response is written before the connection is closed.
+	drain := make(chan struct{})
+	drained := make(chan struct{})
+
 	eg.Go(func() error {
 		defer c.Close()
 
-		return c.handler.Run()
+		err := c.handler.Run()
+		if errors.Is(err, ErrTooManyGarbledMessages) {
+			close(drain)
+			select {
+			case <-drained:
+			case <-c.ctx.Done():
+			}
+		}
+
+		return err
 	})
 
 	eg.Go(func() error {
@@ -89,6 +117,11 @@ func (c *Initiator) Serve() error {
 			case <-c.ctx.Done():
 				return nil
 
+			case <-drain:
+				defer close(drained)
+
+				return c.conn.writeQueued(c.handler.Outgoing())
+
 			case msg, ok := <-c.handler.Outgoing():
 				if !ok {
 					return fmt.Errorf("outgoing chan is closed")
@@ -100,6 +133,7 @@ func (c *Initiator) Serve() error {
 
 					return ErrConnClosed
 				}
+				c.conn.metrics.gauge(MetricOutboundQueueDepth, int64(len(c.handler.Outgoing())))
 			}
 		}
 	})
@@ -131,6 +165,11 @@ func (c *Initiator) Serve() error {
 					continue
 				}
 				c.handler.ServeIncoming(msg)
+
+			case reason := <-c.conn.Garbled():
+				if h, ok := c.handler.(garbledServer); ok {
+					h.ServeGarbled(reason)
+				}
 			}
 		}
 	})
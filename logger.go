@@ -0,0 +1,21 @@
+package simplefixgo
+
+// Logger records the raw messages and events of a FIX session.
+// Implementations must be safe for concurrent use.
+type Logger interface {
+	// OnIncoming is called for each received message.
+	OnIncoming(msg []byte)
+	// OnOutgoing is called for each message passed to the connection.
+	OnOutgoing(msg []byte)
+	// OnEvent is called for session events, such as connections, state changes and errors.
+	OnEvent(text string)
+}
+
+// NopLogger is a Logger that discards everything. It is used by default.
+type NopLogger struct{}
+
+func (NopLogger) OnIncoming([]byte) {}
+
+func (NopLogger) OnOutgoing([]byte) {}
+
+func (NopLogger) OnEvent(string) {}
@@ -0,0 +1,208 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+const (
+	messagesLog = "messages"
+	eventLog    = "event"
+)
+
+// Settings describe where the log files are created and how they are rotated.
+type Settings struct {
+	// Dir is the directory the log files are created in.
+	Dir string
+
+	// MaxSize is the size in bytes after which a log file is rotated.
+	// Zero disables rotation.
+	MaxSize int64
+
+	// MaxBackups is the number of rotated files kept for each log.
+	// Zero keeps all of them.
+	MaxBackups int
+}
+
+// Logger writes the messages and events of a single session
+// to the files following the QuickFIX layout:
+//
+//	FIX.4.4-SENDER-TARGET.messages.current.log
+//	FIX.4.4-SENDER-TARGET.event.current.log
+//
+// Each line consists of the UTC time and the logged text separated by " : ".
+// Rotated files are named FIX.4.4-SENDER-TARGET.messages.backup.N.log,
+// where the most recent backup has N equal to 1.
+type Logger struct {
+	messages *rotatingFile
+	events   *rotatingFile
+}
+
+// NewLogger creates the log directory if it is missing and opens the log files of a session in the append mode.
+func NewLogger(settings Settings, beginString, senderCompID, targetCompID string) (*Logger, error) {
+	if err := os.MkdirAll(settings.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create log directory: %w", err)
+	}
+
+	prefix := filepath.Join(settings.Dir, fmt.Sprintf("%s-%s-%s", beginString, senderCompID, targetCompID))
+
+	messages, err := openRotatingFile(prefix+"."+messagesLog, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := openRotatingFile(prefix+"."+eventLog, settings)
+	if err != nil {
+		_ = messages.close()
+		return nil, err
+	}
+
+	return &Logger{messages: messages, events: events}, nil
+}
+
+// OnIncoming writes a received message to the messages log.
+func (l *Logger) OnIncoming(msg []byte) {
+	l.messages.write(msg)
+}
+
+// OnOutgoing writes a sent message to the messages log.
+func (l *Logger) OnOutgoing(msg []byte) {
+	l.messages.write(msg)
+}
+
+// OnEvent writes a session event to the event log.
+func (l *Logger) OnEvent(text string) {
+	l.events.write([]byte(text))
+}
+
+// Close closes the log files.
+func (l *Logger) Close() error {
+	return errors.Join(l.messages.close(), l.events.close())
+}
+
+type rotatingFile struct {
+	mu sync.Mutex
+
+	prefix   string
+	settings Settings
+
+	file *os.File
+	size int64
+}
+
+func openRotatingFile(prefix string, settings Settings) (*rotatingFile, error) {
+	f := &rotatingFile{prefix: prefix, settings: settings}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *rotatingFile) currentPath() string {
+	return f.prefix + ".current.log"
+}
+
+func (f *rotatingFile) backupPath(n int) string {
+	return fmt.Sprintf("%s.backup.%d.log", f.prefix, n)
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.currentPath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open log file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("stat log file: %w", err)
+	}
+
+	f.file = file
+	f.size = info.Size()
+
+	return nil
+}
+
+// write appends a line to the file. Logging must not break a session, so the errors are dropped.
+func (f *rotatingFile) write(text []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return
+	}
+
+	line := make([]byte, 0, len(fix.TimeLayout)+len(text)+4)
+	line = time.Now().UTC().AppendFormat(line, fix.TimeLayout)
+	line = append(line, " : "...)
+	line = append(line, text...)
+	line = append(line, '\n')
+
+	if f.settings.MaxSize > 0 && f.size > 0 && f.size+int64(len(line)) > f.settings.MaxSize {
+		if err := f.rotate(); err != nil {
+			return
+		}
+	}
+
+	n, _ := f.file.Write(line)
+	f.size += int64(n)
+}
+
+// rotate renames the current file to the first backup shifting the existing backups.
+func (f *rotatingFile) rotate() error {
+	if err := f.closeFile(); err != nil {
+		return err
+	}
+
+	last := 1
+	for f.settings.MaxBackups == 0 || last < f.settings.MaxBackups {
+		if _, err := os.Stat(f.backupPath(last)); err != nil {
+			break
+		}
+		last++
+	}
+
+	if f.settings.MaxBackups > 0 && last == f.settings.MaxBackups {
+		_ = os.Remove(f.backupPath(last))
+	}
+
+	for n := last - 1; n >= 1; n-- {
+		if err := os.Rename(f.backupPath(n), f.backupPath(n+1)); err != nil {
+			// The current file is kept to continue logging.
+			return errors.Join(fmt.Errorf("rotate log file: %w", err), f.open())
+		}
+	}
+
+	if err := os.Rename(f.currentPath(), f.backupPath(1)); err != nil {
+		return errors.Join(fmt.Errorf("rotate log file: %w", err), f.open())
+	}
+
+	return f.open()
+}
+
+func (f *rotatingFile) close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.closeFile()
+}
+
+func (f *rotatingFile) closeFile() error {
+	if f.file == nil {
+		return nil
+	}
+
+	err := f.file.Close()
+	f.file = nil
+
+	return err
+}
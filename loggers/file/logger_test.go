@@ -0,0 +1,84 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readLines(t *testing.T, path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("could not read %s: %s", path, err)
+	}
+
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
+
+func TestLogger(t *testing.T) {
+	dir := t.TempDir()
+
+	logger, err := NewLogger(Settings{Dir: dir}, "FIX.4.4", "SENDER", "TARGET")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	logger.OnIncoming([]byte("8=FIX.4.4\x0135=0\x01"))
+	logger.OnOutgoing([]byte("8=FIX.4.4\x0135=1\x01"))
+	logger.OnEvent("connected")
+
+	if err = logger.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	messages := readLines(t, filepath.Join(dir, "FIX.4.4-SENDER-TARGET.messages.current.log"))
+	if len(messages) != 2 || !strings.HasSuffix(messages[0], " : 8=FIX.4.4\x0135=0\x01") ||
+		!strings.HasSuffix(messages[1], " : 8=FIX.4.4\x0135=1\x01") {
+		t.Fatalf("unexpected messages log: %q", messages)
+	}
+
+	events := readLines(t, filepath.Join(dir, "FIX.4.4-SENDER-TARGET.event.current.log"))
+	if len(events) != 1 || !strings.HasSuffix(events[0], " : connected") {
+		t.Fatalf("unexpected event log: %q", events)
+	}
+}
+
+func TestLoggerRotation(t *testing.T) {
+	dir := t.TempDir()
+
+	// Each line takes 21 bytes of the timestamp, 3 bytes of the separator, 5 bytes of the text and a line break.
+	logger, err := NewLogger(Settings{Dir: dir, MaxSize: 60, MaxBackups: 2}, "FIX.4.4", "SENDER", "TARGET")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, text := range []string{"evt-1", "evt-2", "evt-3", "evt-4", "evt-5", "evt-6", "evt-7"} {
+		logger.OnEvent(text)
+	}
+
+	if err = logger.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string][]string{
+		"FIX.4.4-SENDER-TARGET.event.current.log":  {"evt-7"},
+		"FIX.4.4-SENDER-TARGET.event.backup.1.log": {"evt-5", "evt-6"},
+		"FIX.4.4-SENDER-TARGET.event.backup.2.log": {"evt-3", "evt-4"},
+	}
+	for name, texts := range expected {
+		lines := readLines(t, filepath.Join(dir, name))
+		if len(lines) != len(texts) {
+			t.Fatalf("unexpected %s content: %q", name, lines)
+		}
+		for i, text := range texts {
+			if !strings.HasSuffix(lines[i], " : "+text) {
+				t.Fatalf("unexpected %s content: %q", name, lines)
+			}
+		}
+	}
+
+	if _, err = os.Stat(filepath.Join(dir, "FIX.4.4-SENDER-TARGET.event.backup.3.log")); !os.IsNotExist(err) {
+		t.Fatalf("the backups are not limited: %v", err)
+	}
+}
@@ -0,0 +1,207 @@
+package simplefixgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/b2broker/simplefix-go/session/messages"
+)
+
+// BusinessRejectReasonOther is the BusinessRejectReason used when a handler error does not specify one.
+const BusinessRejectReasonOther = 0
+
+// IncomingHandler is a source of incoming messages for the MessageRouter, e.g. *DefaultHandler.
+type IncomingHandler interface {
+	HandleIncoming(msgType string, handle IncomingHandlerFunc) (id int64)
+	Context() context.Context
+}
+
+// RouterSession unmarshals the incoming messages and responds to the ones which could not be handled,
+// e.g. *session.Session.
+type RouterSession interface {
+	Unmarshal(msg messages.Builder, data []byte) error
+	Send(msg messages.Message) error
+	RejectMessageWithError(msg []byte, err error)
+}
+
+// tagError is implemented by the unmarshalling errors, e.g. *encoding.Error.
+type tagError interface {
+	error
+	TagID() int
+}
+
+// BusinessReject contains the fields of a BusinessMessageReject sent in response to a message
+// whose handler returned an error.
+type BusinessReject struct {
+	RefSeqNum  int
+	RefMsgType string
+	RefID      string
+	Reason     int
+	Text       string
+}
+
+// BusinessRejectError may be returned by a message handler to specify
+// the BusinessRejectReason and BusinessRejectRefID of the BusinessMessageReject.
+type BusinessRejectError struct {
+	Reason int
+	RefID  string
+	Err    error
+}
+
+// NewBusinessRejectError wraps an error with a BusinessRejectReason code.
+func NewBusinessRejectError(reason int, refID string, err error) *BusinessRejectError {
+	return &BusinessRejectError{Reason: reason, RefID: refID, Err: err}
+}
+
+func (e *BusinessRejectError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("business reject reason %d", e.Reason)
+	}
+	return e.Err.Error()
+}
+
+func (e *BusinessRejectError) Unwrap() error {
+	return e.Err
+}
+
+type messageRoute struct {
+	newMessage func() messages.PipelineBuilder
+	handlers   []func(ctx context.Context, msg messages.PipelineBuilder) error
+}
+
+// MessageRouter unmarshals each incoming message once and passes it to the typed handlers registered with Route.
+// It is used by the routers generated by fixgen.
+//
+// If a message cannot be unmarshalled, or its handler returns an unmarshalling error such as *encoding.Error,
+// the message is rejected with a session-level Reject.
+// Other handler errors are answered with a BusinessMessageReject
+// if the dictionary defines it, otherwise with a Reject as well.
+type MessageRouter struct {
+	mu sync.Mutex
+
+	handler        IncomingHandler
+	session        RouterSession
+	businessReject func(reject BusinessReject) messages.Message
+	onError        func(msg []byte, err error)
+
+	routes map[string]*messageRoute
+}
+
+// NewMessageRouter creates a MessageRouter attached to a handler.
+// The businessReject function builds a BusinessMessageReject, it may be nil.
+func NewMessageRouter(
+	handler IncomingHandler,
+	session RouterSession,
+	businessReject func(reject BusinessReject) messages.Message,
+) *MessageRouter {
+	return &MessageRouter{
+		handler:        handler,
+		session:        session,
+		businessReject: businessReject,
+		routes:         make(map[string]*messageRoute),
+	}
+}
+
+// OnError sets a function called when a reject could not be sent.
+func (r *MessageRouter) OnError(handle func(msg []byte, err error)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.onError = handle
+}
+
+// Route registers a handler of the messages of a type, which are unmarshalled into the messages created by newMessage.
+// All handlers of a type receive the same message, the first handler error stops the dispatching.
+func Route[T messages.PipelineBuilder](
+	r *MessageRouter,
+	msgType string,
+	newMessage func() T,
+	handle func(ctx context.Context, msg T) error,
+) {
+	r.add(msgType,
+		func() messages.PipelineBuilder {
+			return newMessage()
+		},
+		func(ctx context.Context, msg messages.PipelineBuilder) error {
+			return handle(ctx, msg.(T))
+		},
+	)
+}
+
+func (r *MessageRouter) add(
+	msgType string,
+	newMessage func() messages.PipelineBuilder,
+	handle func(ctx context.Context, msg messages.PipelineBuilder) error,
+) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	route, ok := r.routes[msgType]
+	if ok {
+		route.handlers = append(route.handlers, handle)
+		return
+	}
+
+	route = &messageRoute{
+		newMessage: newMessage,
+		handlers:   []func(ctx context.Context, msg messages.PipelineBuilder) error{handle},
+	}
+	r.routes[msgType] = route
+
+	r.handler.HandleIncoming(msgType, func(data []byte) bool {
+		r.serve(route, data)
+		return true
+	})
+}
+
+func (r *MessageRouter) serve(route *messageRoute, data []byte) {
+	r.mu.Lock()
+	handlers := route.handlers
+	r.mu.Unlock()
+
+	msg := route.newMessage()
+	if err := r.session.Unmarshal(msg, data); err != nil {
+		r.session.RejectMessageWithError(data, err)
+		return
+	}
+
+	ctx := r.handler.Context()
+	for _, handle := range handlers {
+		if err := handle(ctx, msg); err != nil {
+			r.reject(data, msg, err)
+			return
+		}
+	}
+}
+
+func (r *MessageRouter) reject(data []byte, msg messages.PipelineBuilder, err error) {
+	var fixErr tagError
+	if errors.As(err, &fixErr) || r.businessReject == nil {
+		r.session.RejectMessageWithError(data, err)
+		return
+	}
+
+	reject := BusinessReject{
+		RefSeqNum:  msg.HeaderBuilder().MsgSeqNum(),
+		RefMsgType: msg.MsgType(),
+		Reason:     BusinessRejectReasonOther,
+		Text:       err.Error(),
+	}
+
+	var businessErr *BusinessRejectError
+	if errors.As(err, &businessErr) {
+		reject.Reason, reject.RefID = businessErr.Reason, businessErr.RefID
+	}
+
+	if err = r.session.Send(r.businessReject(reject)); err != nil {
+		r.mu.Lock()
+		onError := r.onError
+		r.mu.Unlock()
+
+		if onError != nil {
+			onError(data, err)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package simplefixgo
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Names of the metrics reported by the connections, handlers and sessions.
+const (
+	// MetricBytesRead counts the bytes read from a connection.
+	MetricBytesRead = "bytes_read"
+	// MetricBytesWritten counts the bytes written to a connection.
+	MetricBytesWritten = "bytes_written"
+	// MetricBytesDiscarded counts the bytes read from a connection that do not form a valid message frame.
+	MetricBytesDiscarded = "bytes_discarded"
+	// MetricWriteLatency measures writing a message to a connection.
+	MetricWriteLatency = "write_latency"
+
+	// MetricMessagesReceived counts the incoming messages.
+	MetricMessagesReceived = "messages_received"
+	// MetricMessagesSent counts the outgoing messages.
+	MetricMessagesSent = "messages_sent"
+	// MetricOutboundQueueDepth is the number of outgoing messages waiting to be written to a connection.
+	// It is sampled each time a message is written to a connection.
+	MetricOutboundQueueDepth = "outbound_queue_depth"
+	// MetricGarbledMessages counts the discarded incoming messages with an invalid BodyLength or CheckSum.
+	MetricGarbledMessages = "garbled_messages"
+	// MetricHandlingLatency measures processing an incoming message by the handlers.
+	MetricHandlingLatency = "handling_latency"
+
+	// MetricResendRequests counts the received ResendRequest messages.
+	MetricResendRequests = "resend_requests"
+	// MetricMessagesResent counts the messages sent in response to ResendRequest messages.
+	MetricMessagesResent = "messages_resent"
+	// MetricRejectsSent counts the sent Reject messages.
+	MetricRejectsSent = "rejects_sent"
+	// MetricRejectsReceived counts the received Reject messages.
+	MetricRejectsReceived = "rejects_received"
+	// MetricHeartbeatMisses counts the heartbeat intervals passed without any incoming messages.
+	MetricHeartbeatMisses = "heartbeat_misses"
+	// MetricStorageFailures counts the failed storage operations.
+	MetricStorageFailures = "storage_failures"
+)
+
+// Metrics receives the measurements of FIX sessions keyed by a session ID.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// Add increases a counter.
+	Add(sessionID, name string, delta int64)
+	// Set assigns a value to a gauge.
+	Set(sessionID, name string, value int64)
+	// Observe records a latency.
+	Observe(sessionID, name string, d time.Duration)
+	// Remove deletes the metrics of a session after it has been disconnected.
+	Remove(sessionID string)
+}
+
+// NopMetrics is a Metrics sink that discards everything. It is used by default.
+type NopMetrics struct{}
+
+func (NopMetrics) Add(string, string, int64) {}
+
+func (NopMetrics) Set(string, string, int64) {}
+
+func (NopMetrics) Observe(string, string, time.Duration) {}
+
+func (NopMetrics) Remove(string) {}
+
+// MetricsSessionID returns the session ID the metrics of an accepted session are reported under.
+func MetricsSessionID(senderCompID, targetCompID string) string {
+	return senderCompID + "-" + targetCompID
+}
+
+// sessionMetrics reports to a Metrics sink under a session ID which could be assigned
+// after the reporting has started, e.g. once a Logon is accepted.
+// Nothing is reported while the session ID is empty.
+type sessionMetrics struct {
+	metrics   Metrics
+	sessionID atomic.Pointer[string]
+}
+
+func (m *sessionMetrics) set(metrics Metrics, sessionID string) {
+	m.metrics = metrics
+	m.sessionID.Store(&sessionID)
+}
+
+func (m *sessionMetrics) setSessionID(sessionID string) {
+	m.sessionID.Store(&sessionID)
+}
+
+func (m *sessionMetrics) id() string {
+	if id := m.sessionID.Load(); id != nil {
+		return *id
+	}
+
+	return ""
+}
+
+func (m *sessionMetrics) add(name string, delta int64) {
+	if id := m.id(); id != "" && m.metrics != nil {
+		m.metrics.Add(id, name, delta)
+	}
+}
+
+func (m *sessionMetrics) gauge(name string, value int64) {
+	if id := m.id(); id != "" && m.metrics != nil {
+		m.metrics.Set(id, name, value)
+	}
+}
+
+func (m *sessionMetrics) observe(name string, d time.Duration) {
+	if id := m.id(); id != "" && m.metrics != nil {
+		m.metrics.Observe(id, name, d)
+	}
+}
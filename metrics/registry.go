@@ -0,0 +1,300 @@
+package metrics
+
+import (
+	"bufio"
+	"expvar"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBuckets are the upper bounds of the latency histogram buckets used by NewRegistry.
+var DefaultBuckets = []time.Duration{
+	100 * time.Microsecond,
+	500 * time.Microsecond,
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+}
+
+// Registry is an in-process metrics sink.
+// It keeps counters, gauges and latency histograms for each session
+// and exposes them as a Snapshot, an expvar variable and a Prometheus text exposition.
+type Registry struct {
+	mu       sync.Mutex
+	buckets  []time.Duration
+	sessions map[string]*sessionMetrics
+}
+
+type sessionMetrics struct {
+	counters   map[string]int64
+	gauges     map[string]int64
+	histograms map[string]*histogram
+}
+
+type histogram struct {
+	// counts contains the number of observations for each bucket and one more for the ones above the last bucket.
+	counts []uint64
+	count  uint64
+	sum    time.Duration
+}
+
+// Snapshot contains the values of all metrics at a point in time.
+type Snapshot struct {
+	Sessions map[string]SessionSnapshot `json:"sessions"`
+}
+
+// SessionSnapshot contains the values of the metrics of a session.
+type SessionSnapshot struct {
+	Counters   map[string]int64             `json:"counters"`
+	Gauges     map[string]int64             `json:"gauges"`
+	Histograms map[string]HistogramSnapshot `json:"histograms"`
+}
+
+// HistogramSnapshot contains the state of a latency histogram.
+type HistogramSnapshot struct {
+	Count   uint64           `json:"count"`
+	Sum     time.Duration    `json:"sum"`
+	Buckets []BucketSnapshot `json:"buckets"`
+}
+
+// BucketSnapshot contains the cumulative number of observations not exceeding UpperBound.
+type BucketSnapshot struct {
+	UpperBound time.Duration `json:"upper_bound"`
+	Count      uint64        `json:"count"`
+}
+
+// NewRegistry creates a Registry with DefaultBuckets.
+func NewRegistry() *Registry {
+	return NewRegistryWithBuckets(DefaultBuckets)
+}
+
+// NewRegistryWithBuckets creates a Registry with custom histogram buckets.
+func NewRegistryWithBuckets(buckets []time.Duration) *Registry {
+	sorted := append([]time.Duration(nil), buckets...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	return &Registry{
+		buckets:  sorted,
+		sessions: map[string]*sessionMetrics{},
+	}
+}
+
+func (r *Registry) session(sessionID string) *sessionMetrics {
+	s, ok := r.sessions[sessionID]
+	if !ok {
+		s = &sessionMetrics{
+			counters:   map[string]int64{},
+			gauges:     map[string]int64{},
+			histograms: map[string]*histogram{},
+		}
+		r.sessions[sessionID] = s
+	}
+
+	return s
+}
+
+// Add increases a counter.
+func (r *Registry) Add(sessionID, name string, delta int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.session(sessionID).counters[name] += delta
+}
+
+// Set assigns a value to a gauge.
+func (r *Registry) Set(sessionID, name string, value int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.session(sessionID).gauges[name] = value
+}
+
+// Observe records a latency in a histogram.
+func (r *Registry) Observe(sessionID, name string, d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := r.session(sessionID)
+	h, ok := s.histograms[name]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(r.buckets)+1)}
+		s.histograms[name] = h
+	}
+
+	i := sort.Search(len(r.buckets), func(i int) bool { return d <= r.buckets[i] })
+	h.counts[i]++
+	h.count++
+	h.sum += d
+}
+
+// Remove deletes the metrics of a session, e.g. after it has been closed.
+func (r *Registry) Remove(sessionID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.sessions, sessionID)
+}
+
+// Snapshot returns a copy of the current values of all metrics.
+func (r *Registry) Snapshot() Snapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshot := Snapshot{Sessions: make(map[string]SessionSnapshot, len(r.sessions))}
+	for sessionID, s := range r.sessions {
+		sessionSnapshot := SessionSnapshot{
+			Counters:   make(map[string]int64, len(s.counters)),
+			Gauges:     make(map[string]int64, len(s.gauges)),
+			Histograms: make(map[string]HistogramSnapshot, len(s.histograms)),
+		}
+
+		for name, v := range s.counters {
+			sessionSnapshot.Counters[name] = v
+		}
+
+		for name, v := range s.gauges {
+			sessionSnapshot.Gauges[name] = v
+		}
+
+		for name, h := range s.histograms {
+			histogramSnapshot := HistogramSnapshot{
+				Count:   h.count,
+				Sum:     h.sum,
+				Buckets: make([]BucketSnapshot, len(r.buckets)),
+			}
+
+			var cumulative uint64
+			for i, upperBound := range r.buckets {
+				cumulative += h.counts[i]
+				histogramSnapshot.Buckets[i] = BucketSnapshot{UpperBound: upperBound, Count: cumulative}
+			}
+
+			sessionSnapshot.Histograms[name] = histogramSnapshot
+		}
+
+		snapshot.Sessions[sessionID] = sessionSnapshot
+	}
+
+	return snapshot
+}
+
+// Var returns an expvar variable rendering the snapshot as JSON.
+func (r *Registry) Var() expvar.Var {
+	return expvar.Func(func() any {
+		return r.Snapshot()
+	})
+}
+
+// Publish publishes the registry as an expvar variable with the specified name.
+// Like expvar.Publish, it panics if the name is already registered.
+func (r *Registry) Publish(name string) {
+	expvar.Publish(name, r.Var())
+}
+
+// WritePrometheus writes all metrics in the Prometheus text exposition format.
+// Each metric name is prefixed with namespace and labeled with the session ID.
+// Counters get the _total suffix, latencies are exposed in seconds.
+func (r *Registry) WritePrometheus(w io.Writer, namespace string) error {
+	snapshot := r.Snapshot()
+
+	counters := map[string]map[string]int64{}
+	gauges := map[string]map[string]int64{}
+	histograms := map[string]map[string]HistogramSnapshot{}
+
+	for sessionID, s := range snapshot.Sessions {
+		for name, v := range s.Counters {
+			if counters[name] == nil {
+				counters[name] = map[string]int64{}
+			}
+			counters[name][sessionID] = v
+		}
+
+		for name, v := range s.Gauges {
+			if gauges[name] == nil {
+				gauges[name] = map[string]int64{}
+			}
+			gauges[name][sessionID] = v
+		}
+
+		for name, v := range s.Histograms {
+			if histograms[name] == nil {
+				histograms[name] = map[string]HistogramSnapshot{}
+			}
+			histograms[name][sessionID] = v
+		}
+	}
+
+	prefix := ""
+	if namespace != "" {
+		prefix = namespace + "_"
+	}
+
+	bw := bufio.NewWriter(w)
+
+	for _, name := range sortedKeys(counters) {
+		metric := prefix + name + "_total"
+		fmt.Fprintf(bw, "# TYPE %s counter\n", metric)
+		for _, sessionID := range sortedKeys(counters[name]) {
+			fmt.Fprintf(bw, "%s{session=%s} %d\n", metric, quoteLabel(sessionID), counters[name][sessionID])
+		}
+	}
+
+	for _, name := range sortedKeys(gauges) {
+		metric := prefix + name
+		fmt.Fprintf(bw, "# TYPE %s gauge\n", metric)
+		for _, sessionID := range sortedKeys(gauges[name]) {
+			fmt.Fprintf(bw, "%s{session=%s} %d\n", metric, quoteLabel(sessionID), gauges[name][sessionID])
+		}
+	}
+
+	for _, name := range sortedKeys(histograms) {
+		metric := prefix + name + "_seconds"
+		fmt.Fprintf(bw, "# TYPE %s histogram\n", metric)
+		for _, sessionID := range sortedKeys(histograms[name]) {
+			h := histograms[name][sessionID]
+			label := quoteLabel(sessionID)
+			for _, bucket := range h.Buckets {
+				fmt.Fprintf(bw, "%s_bucket{session=%s,le=\"%g\"} %d\n", metric, label, bucket.UpperBound.Seconds(), bucket.Count)
+			}
+			fmt.Fprintf(bw, "%s_bucket{session=%s,le=\"+Inf\"} %d\n", metric, label, h.Count)
+			fmt.Fprintf(bw, "%s_sum{session=%s} %g\n", metric, label, h.Sum.Seconds())
+			fmt.Fprintf(bw, "%s_count{session=%s} %d\n", metric, label, h.Count)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Handler returns an HTTP handler serving the metrics in the Prometheus text exposition format.
+func (r *Registry) Handler(namespace string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = r.WritePrometheus(w, namespace)
+	})
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+var labelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func quoteLabel(v string) string {
+	return `"` + labelReplacer.Replace(v) + `"`
+}
@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestRegistry_Snapshot(t *testing.T) {
+	r := NewRegistryWithBuckets([]time.Duration{time.Second, time.Millisecond})
+
+	r.Add("first", "messages_sent", 1)
+	r.Add("first", "messages_sent", 2)
+	r.Set("first", "outbound_queue_depth", 5)
+	r.Set("first", "outbound_queue_depth", 3)
+	r.Observe("first", "write_latency", 500*time.Microsecond)
+	r.Observe("first", "write_latency", 10*time.Millisecond)
+	r.Observe("first", "write_latency", 2*time.Second)
+	r.Add("second", "messages_sent", 7)
+
+	snapshot := r.Snapshot()
+
+	if v := snapshot.Sessions["first"].Counters["messages_sent"]; v != 3 {
+		t.Fatalf("unexpected counter value: %d", v)
+	}
+	if v := snapshot.Sessions["second"].Counters["messages_sent"]; v != 7 {
+		t.Fatalf("unexpected counter value: %d", v)
+	}
+	if v := snapshot.Sessions["first"].Gauges["outbound_queue_depth"]; v != 3 {
+		t.Fatalf("unexpected gauge value: %d", v)
+	}
+
+	h := snapshot.Sessions["first"].Histograms["write_latency"]
+	if h.Count != 3 || h.Sum != 2*time.Second+10*time.Millisecond+500*time.Microsecond {
+		t.Fatalf("unexpected histogram: %+v", h)
+	}
+	expectedBuckets := []BucketSnapshot{{UpperBound: time.Millisecond, Count: 1}, {UpperBound: time.Second, Count: 2}}
+	if len(h.Buckets) != len(expectedBuckets) {
+		t.Fatalf("unexpected buckets: %+v", h.Buckets)
+	}
+	for i, bucket := range expectedBuckets {
+		if h.Buckets[i] != bucket {
+			t.Fatalf("unexpected buckets: %+v", h.Buckets)
+		}
+	}
+
+	r.Remove("second")
+	if _, ok := r.Snapshot().Sessions["second"]; ok {
+		t.Fatalf("the session is not removed")
+	}
+}
+
+func TestRegistry_WritePrometheus(t *testing.T) {
+	r := NewRegistryWithBuckets([]time.Duration{time.Millisecond})
+
+	r.Add(`FIX.4.4-"A"-B`, "messages_sent", 2)
+	r.Set("session", "outbound_queue_depth", 1)
+	r.Observe("session", "write_latency", 500*time.Microsecond)
+	r.Observe("session", "write_latency", 2*time.Millisecond)
+
+	var buf bytes.Buffer
+	if err := r.WritePrometheus(&buf, "simplefix"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `# TYPE simplefix_messages_sent_total counter
+simplefix_messages_sent_total{session="FIX.4.4-\"A\"-B"} 2
+# TYPE simplefix_outbound_queue_depth gauge
+simplefix_outbound_queue_depth{session="session"} 1
+# TYPE simplefix_write_latency_seconds histogram
+simplefix_write_latency_seconds_bucket{session="session",le="0.001"} 1
+simplefix_write_latency_seconds_bucket{session="session",le="+Inf"} 2
+simplefix_write_latency_seconds_sum{session="session"} 0.0025
+simplefix_write_latency_seconds_count{session="session"} 2
+`
+	if buf.String() != expected {
+		t.Fatalf("unexpected output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestRegistry_Var(t *testing.T) {
+	r := NewRegistry()
+	r.Add("session", "messages_sent", 1)
+
+	var snapshot Snapshot
+	if err := json.Unmarshal([]byte(r.Var().String()), &snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v := snapshot.Sessions["session"].Counters["messages_sent"]; v != 1 {
+		t.Fatalf("unexpected counter value: %d", v)
+	}
+}
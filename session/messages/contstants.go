@@ -24,6 +24,11 @@ type SessionErrorCodes struct {
 	DecryptionProblem           int
 	SignatureProblem            int
 	CompIDProblem               int
+	TagAppearsMoreThanOnce      int
+	IncorrectNumInGroupCount    int
+	InvalidMsgType              int
+	TagSpecifiedOutOfOrder      int
+	GroupFieldsOutOfOrder       int
 	Other                       int
 }
 
@@ -34,3 +39,9 @@ type Message interface {
 	ToBytes() ([]byte, error)
 	ToBytesBuffered(buffers *buffer.MessageByteBuffers) ([]byte, error)
 }
+
+// Validatable is implemented by the messages checking their required fields and conditional rules,
+// e.g. the generated ones.
+type Validatable interface {
+	Validate() error
+}
@@ -19,6 +19,10 @@ type HeaderBuilder interface {
 	SetFieldMsgSeqNum(msgSeqNum int) HeaderBuilder
 	SendingTime() string
 	SetFieldSendingTime(string) HeaderBuilder
+	PossDupFlag() bool
+	SetFieldPossDupFlag(possDupFlag bool) HeaderBuilder
+	OrigSendingTime() string
+	SetFieldOrigSendingTime(origSendingTime string) HeaderBuilder
 
 	AsComponent() *fix.Component
 }
@@ -3,7 +3,7 @@ package messages
 type Logon interface {
 	New() LogonBuilder
 	Build() LogonBuilder
-	EncryptMethod() string
+	FieldEncryptMethod() string
 	SetFieldEncryptMethod(string) LogonBuilder
 	HeartBtInt() int
 	SetFieldHeartBtInt(int) LogonBuilder
@@ -7,8 +7,12 @@ type Reject interface {
 	SetFieldRefTagID(int) RejectBuilder
 	RefSeqNum() int
 	SetFieldRefSeqNum(int) RejectBuilder
-	SessionRejectReason() string
+	FieldSessionRejectReason() string
 	SetFieldSessionRejectReason(string) RejectBuilder
+	RefMsgType() string
+	SetFieldRefMsgType(string) RejectBuilder
+	Text() string
+	SetFieldText(string) RejectBuilder
 }
 
 // RejectBuilder is an interface providing functionality to a builder of auto-generated Reject messages.
@@ -57,6 +57,24 @@ const (
 	Disconnect
 )
 
+var logonStateNames = map[LogonState]string{
+	WaitingLogon:         "waiting logon",
+	SuccessfulLogged:     "successful logged",
+	WaitingLogonAnswer:   "waiting logon answer",
+	WaitingLogoutAnswer:  "waiting logout answer",
+	ReceivedLogoutAnswer: "received logout answer",
+	WaitingTestReqAnswer: "waiting test request answer",
+	Disconnect:           "disconnect",
+}
+
+func (s LogonState) String() string {
+	if name, ok := logonStateNames[s]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("unknown state %d", int64(s))
+}
+
 const (
 	MinLogonTimeout = time.Millisecond
 )
@@ -94,9 +112,17 @@ type Session struct {
 	Router       Handler
 	unmarshaller Unmarshaller
 
-	messageStorage MessageStorage
-	counter        CounterStorage
+	messageStorage ContextMessageStorage
+	counter        ContextCounterStorage
 	eventHandler   *utils.EventHandlerPool
+	logger         simplefixgo.Logger
+	metrics        simplefixgo.Metrics
+	sessionID      atomic.Pointer[string]
+
+	storageFailurePolicy StorageFailurePolicy
+	storageFailed        atomic.Bool
+
+	validateOutgoing bool
 
 	// Parameters:
 	LogonHandler  logonHandler
@@ -179,15 +205,19 @@ func newSession(opts *Opts, handler Handler, settings *LogonSettings, cs Counter
 	session = &Session{
 		Opts:           opts,
 		Router:         handler,
-		messageStorage: ms,
-		counter:        cs,
+		messageStorage: NewMessageStorageAdapter(ms),
+		counter:        NewCounterStorageAdapter(cs),
 		eventHandler:   utils.NewEventHandlerPool(),
+		logger:         simplefixgo.NopLogger{},
+		metrics:        simplefixgo.NopMetrics{},
 		unmarshaller:   encoding.NewDefaultUnmarshaller(true),
 
 		LogonSettings: settings,
 	}
 
 	session.setStorageCallbacks()
+	session.setMetricsCallbacks()
+	session.setGarbledCallbacks()
 
 	if opts.Location != "" {
 		session.timeLocation, err = time.LoadLocation(opts.Location)
@@ -204,7 +234,9 @@ func newSession(opts *Opts, handler Handler, settings *LogonSettings, cs Counter
 }
 
 func (s *Session) changeState(state LogonState, isEventTriggerRequired bool) {
-	s.state.Store(int64(state))
+	if prev := LogonState(s.state.Swap(int64(state))); prev != state {
+		s.logger.OnEvent(fmt.Sprintf("session state changed from %s to %s", prev, state))
+	}
 
 	if !isEventTriggerRequired {
 		return
@@ -223,7 +255,7 @@ func (s *Session) changeState(state LogonState, isEventTriggerRequired bool) {
 }
 
 func (s *Session) checkLogonParams(incoming messages.LogonBuilder) (ok bool, tag, reasonCode int) {
-	if _, ok := s.AllowedEncryptedMethods[incoming.EncryptMethod()]; !ok {
+	if _, ok := s.AllowedEncryptedMethods[incoming.FieldEncryptMethod()]; !ok {
 		return false, s.Tags.EncryptedMethod, s.SessionErrorCodes.IncorrectValue
 	}
 
@@ -241,12 +273,18 @@ func (s *Session) checkLogonParams(incoming messages.LogonBuilder) (ok bool, tag
 
 func (s *Session) setStorageCallbacks() {
 	s.Router.HandleOutgoing(simplefixgo.AllMsgTypes, func(msg simplefixgo.SendingMessage) bool {
-		err := s.messageStorage.Save(fix.StorageID{
-			Sender: s.LogonSettings.SenderCompID,
-			Target: s.LogonSettings.TargetCompID,
-			Side:   fix.Outgoing,
-		}, msg, msg.HeaderBuilder().MsgSeqNum())
-		return err == nil
+		storageID := s.storageID(fix.Outgoing)
+		err := s.messageStorage.Save(s.ctx, storageID, msg, msg.HeaderBuilder().MsgSeqNum())
+		if err != nil {
+			_ = s.storageFailure("save", storageID, err)
+
+			// A message that could not be saved could not be resent either, so it is not sent.
+			// The Logout message terminating the session after the failure is the only exception.
+			return s.storageFailurePolicy == StorageFailureLogout &&
+				msg.MsgType() == s.MessageBuilders.LogoutBuilder.MsgType()
+		}
+
+		return true
 	})
 
 	s.Router.HandleIncoming(simplefixgo.AllMsgTypes, func(msg []byte) bool {
@@ -266,13 +304,15 @@ func (s *Session) setStorageCallbacks() {
 			}
 			msgTypeStr := string(msgType)
 			if s.MessageBuilders.SequenceResetBuilder == nil || msgTypeStr != s.MessageBuilders.SequenceResetBuilder.MsgType() {
-				err = s.counter.SetSeqNum(fix.StorageID{
-					Sender: s.LogonSettings.SenderCompID,
-					Target: s.LogonSettings.TargetCompID,
-					Side:   fix.Incoming,
-				}, seqNumInt)
+				storageID := s.storageID(fix.Incoming)
+				err = s.counter.SetSeqNum(s.ctx, storageID, seqNumInt)
+				if err != nil {
+					_ = s.storageFailure("set seq num", storageID, err)
+
+					return s.storageFailurePolicy != StorageFailureStop
+				}
 			}
-			return err == nil
+			return true
 		}
 
 		return true
@@ -282,25 +322,117 @@ func (s *Session) setStorageCallbacks() {
 		resendMsg := s.MessageBuilders.ResendRequestBuilder.New()
 		err := s.unmarshaller.Unmarshal(resendMsg, data)
 		if err != nil {
-			s.RejectMessage(data)
+			s.RejectMessageWithError(data, err)
 			return true
 		}
 
-		resendMessages, err := s.messageStorage.Messages(fix.StorageID{
-			Sender: s.LogonSettings.SenderCompID,
-			Target: s.LogonSettings.TargetCompID,
-			Side:   fix.Outgoing,
-		}, resendMsg.BeginSeqNo(), resendMsg.EndSeqNo())
+		storageID := s.storageID(fix.Outgoing)
+
+		resendMessages, err := s.messageStorage.Messages(s.ctx, storageID, resendMsg.BeginSeqNo(), resendMsg.EndSeqNo())
+		if errors.Is(err, simplefixgo.ErrMessagesTrimmed) {
+			resendMessages, err = s.gapFillTrimmed(storageID, resendMsg.BeginSeqNo(), resendMsg.EndSeqNo())
+		}
 		if err != nil {
+			if isStorageFailure(err) {
+				_ = s.storageFailure("messages", storageID, err)
+			} else {
+				s.HandlerError(fmt.Errorf("resend messages %d-%d: %w", resendMsg.BeginSeqNo(), resendMsg.EndSeqNo(), err))
+			}
+
 			return true
 		}
 
+		s.addMetric(simplefixgo.MetricMessagesResent, int64(len(resendMessages)))
 		_ = s.Router.SendBatch(resendMessages)
 
 		return true
 	})
 }
 
+func (s *Session) setMetricsCallbacks() {
+	s.Router.HandleIncoming(s.MessageBuilders.ResendRequestBuilder.MsgType(), func(data []byte) bool {
+		s.addMetric(simplefixgo.MetricResendRequests, 1)
+		return true
+	})
+
+	s.Router.HandleIncoming(s.MessageBuilders.RejectBuilder.MsgType(), func(data []byte) bool {
+		s.addMetric(simplefixgo.MetricRejectsReceived, 1)
+		return true
+	})
+
+	s.Router.HandleOutgoing(s.MessageBuilders.RejectBuilder.MsgType(), func(msg simplefixgo.SendingMessage) bool {
+		s.addMetric(simplefixgo.MetricRejectsSent, 1)
+		return true
+	})
+}
+
+// garbledLimitHandler is implemented by the handlers stopped after too many garbled messages,
+// such as simplefixgo.DefaultHandler.
+type garbledLimitHandler interface {
+	OnGarbledLimit(handlerFunc utils.EventHandlerFunc)
+}
+
+// setGarbledCallbacks logs the session out before the handler is stopped after too many garbled messages.
+func (s *Session) setGarbledCallbacks() {
+	handler, ok := s.Router.(garbledLimitHandler)
+	if !ok {
+		return
+	}
+
+	handler.OnGarbledLimit(func() bool {
+		if s.IsLogged() {
+			_ = s.Logout()
+		}
+		return true
+	})
+}
+
+// gapFillTrimmed replaces the part of a resend range that has been trimmed by the storage retention policy
+// with a SequenceReset-GapFill message and returns it along with the messages that are still retained.
+func (s *Session) gapFillTrimmed(storageID fix.StorageID, beginSeqNo, endSeqNo int) ([]simplefixgo.SendingMessage, error) {
+	if s.MessageBuilders.SequenceResetBuilder == nil {
+		return nil, fmt.Errorf("%w: sequence reset", ErrMissingMessageBuilder)
+	}
+
+	newSeqNo := endSeqNo + 1
+	if storage, ok := s.messageStorage.(ContextRetainingStorage); ok {
+		firstSeqNum, err := storage.FirstSeqNum(s.ctx, storageID)
+		if err != nil {
+			return nil, err
+		}
+
+		if firstSeqNum > beginSeqNo && firstSeqNum <= endSeqNo {
+			newSeqNo = firstSeqNum
+		}
+	}
+
+	// The gap fill reuses the sequence number of a message sent before, so it is marked as a possible duplicate,
+	// otherwise the counterparty would treat the sequence number lower than expected as a fatal error.
+	sendingTime := s.CurrentTime().Format(fix.TimeLayout)
+	gapFill := s.MessageBuilders.SequenceResetBuilder.Build().
+		SetFieldGapFillFlag(true).
+		SetFieldNewSeqNo(newSeqNo)
+	gapFill.HeaderBuilder().
+		SetFieldMsgSeqNum(beginSeqNo).
+		SetFieldTargetCompID(s.LogonSettings.TargetCompID).
+		SetFieldSenderCompID(s.LogonSettings.SenderCompID).
+		SetFieldSendingTime(sendingTime).
+		SetFieldPossDupFlag(true).
+		SetFieldOrigSendingTime(sendingTime)
+
+	resendMessages := []simplefixgo.SendingMessage{gapFill}
+	if newSeqNo > endSeqNo {
+		return resendMessages, nil
+	}
+
+	retained, err := s.messageStorage.Messages(s.ctx, storageID, newSeqNo, endSeqNo)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(resendMessages, retained...), nil
+}
+
 func (s *Session) SetLogonRequest(logonRequest func(*Session) error) {
 	s.logonRequest = logonRequest
 }
@@ -338,7 +470,13 @@ func (s *Session) LogonRequest() error {
 }
 
 func (s *Session) HandlerError(err error) {
-	if s.errorHandler != nil && err != nil {
+	if err == nil {
+		return
+	}
+
+	s.logger.OnEvent(fmt.Sprintf("error: %s", err))
+
+	if s.errorHandler != nil {
 		s.errorHandler(err)
 	}
 }
@@ -350,6 +488,8 @@ func (s *Session) OnError(handler func(error)) {
 }
 
 func (s *Session) Run() (err error) {
+	// The storage failure of the previous connection must not prevent the policy from being applied again.
+	s.storageFailed.Store(false)
 	s.changeState(WaitingLogon, true)
 	s.OnChangeState(utils.EventDisconnect, func() bool {
 		s.cancel()
@@ -374,7 +514,7 @@ func (s *Session) Run() (err error) {
 		incomingLogon := s.MessageBuilders.LogonBuilder.New()
 		err := s.unmarshaller.Unmarshal(incomingLogon, data)
 		if err != nil {
-			s.RejectMessage(data)
+			s.RejectMessageWithError(data, err)
 			return true
 		}
 
@@ -382,7 +522,7 @@ func (s *Session) Run() (err error) {
 		case WaitingLogon:
 			s.LogonSettings = &LogonSettings{
 				HeartBtInt:      incomingLogon.HeartBtInt(),
-				EncryptMethod:   incomingLogon.EncryptMethod(),
+				EncryptMethod:   incomingLogon.FieldEncryptMethod(),
 				Password:        incomingLogon.Password(),
 				Username:        incomingLogon.Username(),
 				ResetSeqNumFlag: incomingLogon.ResetSeqNumFlag(),
@@ -408,6 +548,11 @@ func (s *Session) Run() (err error) {
 				return true
 			}
 
+			if s.side == sideAcceptor && s.metricsSessionID() == "" {
+				sessionID := simplefixgo.MetricsSessionID(s.LogonSettings.SenderCompID, s.LogonSettings.TargetCompID)
+				s.sessionID.Store(&sessionID)
+			}
+
 			err = s.start()
 			if err != nil {
 				s.sendWithErrorCheck(s.MakeReject(s.SessionErrorCodes.IncorrectValue, s.Tags.HeartBtInt, incomingLogon.HeaderBuilder().MsgSeqNum()))
@@ -436,7 +581,7 @@ func (s *Session) Run() (err error) {
 	s.Router.HandleIncoming(s.MessageBuilders.LogoutBuilder.MsgType(), func(data []byte) bool {
 		err := s.unmarshaller.Unmarshal(s.MessageBuilders.LogoutBuilder.New(), data)
 		if err != nil {
-			s.RejectMessage(data)
+			s.RejectMessageWithError(data, err)
 			return true
 		}
 
@@ -466,7 +611,7 @@ func (s *Session) Run() (err error) {
 		heartbeat := s.MessageBuilders.HeartbeatBuilder.New()
 		err := s.unmarshaller.Unmarshal(heartbeat, data)
 		if err != nil {
-			s.RejectMessage(data)
+			s.RejectMessageWithError(data, err)
 			return true
 		}
 
@@ -486,7 +631,7 @@ func (s *Session) Run() (err error) {
 		testRequest := s.MessageBuilders.TestRequestBuilder.New()
 		err := s.unmarshaller.Unmarshal(testRequest, data)
 		if err != nil {
-			s.RejectMessage(data)
+			s.RejectMessageWithError(data, err)
 			return true
 		}
 
@@ -506,12 +651,10 @@ func (s *Session) Run() (err error) {
 
 func (s *Session) processIncSeq(incomingLogon messages.LogonBuilder) {
 	incSeqNum := incomingLogon.HeaderBuilder().MsgSeqNum()
-	currSeqNum, err := s.counter.GetCurrSeqNum(fix.StorageID{
-		Sender: s.LogonSettings.SenderCompID,
-		Target: s.LogonSettings.TargetCompID,
-		Side:   fix.Incoming,
-	})
+	storageID := s.storageID(fix.Incoming)
+	currSeqNum, err := s.counter.GetCurrSeqNum(s.ctx, storageID)
 	if err != nil {
+		_ = s.storageFailure("get current seq num", storageID, err)
 		return
 	}
 
@@ -522,11 +665,10 @@ func (s *Session) processIncSeq(incomingLogon messages.LogonBuilder) {
 		s.sendWithErrorCheck(resendMsg)
 	}
 
-	_ = s.counter.SetSeqNum(fix.StorageID{
-		Sender: s.LogonSettings.SenderCompID,
-		Target: s.LogonSettings.TargetCompID,
-		Side:   fix.Incoming,
-	}, incSeqNum)
+	err = s.counter.SetSeqNum(s.ctx, storageID, incSeqNum)
+	if err != nil {
+		_ = s.storageFailure("set seq num", storageID, err)
+	}
 }
 
 func (s *Session) start() error {
@@ -566,6 +708,8 @@ func (s *Session) start() error {
 			default:
 			}
 
+			s.addMetric(simplefixgo.MetricHeartbeatMisses, 1)
+
 			if s.State() == WaitingTestReqAnswer {
 				s.changeState(Disconnect, true)
 				return
@@ -601,20 +745,44 @@ func (s *Session) start() error {
 	return nil
 }
 
+// RejectMessage sends a Reject message in response to an incoming message.
 func (s *Session) RejectMessage(msg []byte) {
+	s.RejectMessageWithError(msg, nil)
+}
+
+// RejectMessageWithError sends a Reject message in response to an incoming message which could not be processed.
+// The SessionRejectReason, RefTagID and Text fields are taken from an *encoding.Error,
+// otherwise the Other reason code is used.
+func (s *Session) RejectMessageWithError(msg []byte, err error) {
 	reject := s.MakeReject(s.SessionErrorCodes.Other, 0, 0)
 
+	var fixErr *encoding.Error
+	if errors.As(err, &fixErr) {
+		reject.SetFieldSessionRejectReason(strconv.Itoa(s.rejectReasonCode(fixErr.Reason)))
+		if tag := fixErr.TagID(); tag != 0 {
+			reject.SetFieldRefTagID(tag)
+		}
+		reject.SetFieldText(fixErr.Reason.Error())
+	}
+
+	if msgType, err := fix.ValueByTag(msg, strconv.Itoa(s.Tags.MsgType)); err == nil {
+		reject.SetFieldRefMsgType(string(msgType))
+	}
+
 	seqNumB, err := fix.ValueByTag(msg, strconv.Itoa(s.Tags.MsgSeqNum))
 	if err != nil {
+		reject.SetFieldSessionRejectReason(strconv.Itoa(s.rejectReasonCode(encoding.ErrRequiredTagMissing)))
 		reject.SetFieldRefTagID(s.Tags.MsgSeqNum)
+		reject.SetFieldText(encoding.ErrRequiredTagMissing.Error())
 		s.sendWithErrorCheck(reject)
 		return
 	}
 
 	seqNum, err := strconv.Atoi(string(seqNumB))
 	if err != nil {
-		reject.SetFieldSessionRejectReason(strconv.Itoa(5)) // An incorrect (out of range) value for this tag.
+		reject.SetFieldSessionRejectReason(strconv.Itoa(s.rejectReasonCode(encoding.ErrIncorrectDataFormat)))
 		reject.SetFieldRefTagID(s.Tags.MsgSeqNum)
+		reject.SetFieldText(encoding.ErrIncorrectDataFormat.Error())
 		s.sendWithErrorCheck(reject)
 		return
 	}
@@ -624,6 +792,45 @@ func (s *Session) RejectMessage(msg []byte) {
 	s.sendWithErrorCheck(reject)
 }
 
+// rejectReasonCode returns the SessionRejectReason code corresponding to an unmarshalling failure reason.
+// The Other code is returned if the reason is unknown or its code is not specified.
+func (s *Session) rejectReasonCode(reason error) int {
+	var code int
+	switch {
+	case errors.Is(reason, encoding.ErrInvalidTagNumber):
+		// The code of this reason is zero.
+		return s.SessionErrorCodes.InvalidTagNumber
+	case errors.Is(reason, encoding.ErrRequiredTagMissing):
+		code = s.SessionErrorCodes.RequiredTagMissing
+	case errors.Is(reason, encoding.ErrTagNotDefinedForMessageType):
+		code = s.SessionErrorCodes.TagNotDefinedForMessageType
+	case errors.Is(reason, encoding.ErrUndefinedTag):
+		code = s.SessionErrorCodes.UndefinedTag
+	case errors.Is(reason, encoding.ErrTagSpecifiedWithoutValue):
+		code = s.SessionErrorCodes.TagSpecialWithoutValue
+	case errors.Is(reason, encoding.ErrIncorrectValue):
+		code = s.SessionErrorCodes.IncorrectValue
+	case errors.Is(reason, encoding.ErrIncorrectDataFormat):
+		code = s.SessionErrorCodes.IncorrectDataFormatValue
+	case errors.Is(reason, encoding.ErrTagAppearsMoreThanOnce):
+		code = s.SessionErrorCodes.TagAppearsMoreThanOnce
+	case errors.Is(reason, encoding.ErrIncorrectNumInGroupCount):
+		code = s.SessionErrorCodes.IncorrectNumInGroupCount
+	case errors.Is(reason, encoding.ErrInvalidMsgType):
+		code = s.SessionErrorCodes.InvalidMsgType
+	case errors.Is(reason, encoding.ErrTagSpecifiedOutOfOrder):
+		code = s.SessionErrorCodes.TagSpecifiedOutOfOrder
+	case errors.Is(reason, encoding.ErrGroupFieldsOutOfOrder):
+		code = s.SessionErrorCodes.GroupFieldsOutOfOrder
+	}
+
+	if code == 0 {
+		return s.SessionErrorCodes.Other
+	}
+
+	return code
+}
+
 func (s *Session) CurrentTime() time.Time {
 	return time.Now().In(s.timeLocation)
 }
@@ -633,21 +840,36 @@ func (s *Session) CurrentTime() time.Time {
 // - the targetCompID and senderCompID fields
 // - the sending time, with the current time zone indicated
 // To send a message with custom fields, call the Send method for a Handler instead.
+// If the outgoing validation is enabled, an invalid message is not sent and the validation error is returned.
 func (s *Session) Send(msg messages.Message) error {
 	return s.send(msg)
 }
 
+// validate checks an outgoing message if the outgoing validation is enabled.
+func (s *Session) validate(msg messages.Message) error {
+	if !s.validateOutgoing {
+		return nil
+	}
+
+	if validatable, ok := msg.(messages.Validatable); ok {
+		return validatable.Validate()
+	}
+
+	return nil
+}
+
 func (s *Session) send(msg messages.Message) error {
+	if err := s.validate(msg); err != nil {
+		return err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	nextSeqNum, err := s.counter.GetNextSeqNum(fix.StorageID{
-		Sender: s.LogonSettings.SenderCompID,
-		Target: s.LogonSettings.TargetCompID,
-		Side:   fix.Outgoing,
-	})
+	storageID := s.storageID(fix.Outgoing)
+	nextSeqNum, err := s.counter.GetNextSeqNum(s.ctx, storageID)
 	if err != nil {
-		return err
+		return s.storageFailure("get next seq num", storageID, err)
 	}
 	msg.HeaderBuilder().
 		SetFieldMsgSeqNum(nextSeqNum).
@@ -663,16 +885,17 @@ func (s *Session) SendBuffered(msg messages.Message) error {
 }
 
 func (s *Session) sendBuffered(msg messages.Message) error {
+	if err := s.validate(msg); err != nil {
+		return err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	nextSeqNum, err := s.counter.GetNextSeqNum(fix.StorageID{
-		Sender: s.LogonSettings.SenderCompID,
-		Target: s.LogonSettings.TargetCompID,
-		Side:   fix.Outgoing,
-	})
+	storageID := s.storageID(fix.Outgoing)
+	nextSeqNum, err := s.counter.GetNextSeqNum(s.ctx, storageID)
 	if err != nil {
-		return err
+		return s.storageFailure("get next seq num", storageID, err)
 	}
 	msg.HeaderBuilder().
 		SetFieldMsgSeqNum(nextSeqNum).
@@ -684,7 +907,54 @@ func (s *Session) sendBuffered(msg messages.Message) error {
 }
 
 func (s *Session) sendWithErrorCheck(msg messages.Message) {
-	s.HandlerError(s.send(msg))
+	err := s.send(msg)
+	if errors.Is(err, ErrStorageFailure) {
+		// Storage failures have already been reported.
+		return
+	}
+
+	s.HandlerError(err)
+}
+
+func (s *Session) storageID(side fix.StorageSide) fix.StorageID {
+	return fix.StorageID{
+		Sender: s.LogonSettings.SenderCompID,
+		Target: s.LogonSettings.TargetCompID,
+		Side:   side,
+	}
+}
+
+// storageFailure reports a failed storage operation to the error handler
+// and reacts to it according to the storage failure policy.
+func (s *Session) storageFailure(op string, storageID fix.StorageID, err error) error {
+	storageErr := &StorageError{Op: op, StorageID: storageID, Err: err}
+	s.addMetric(simplefixgo.MetricStorageFailures, 1)
+	s.HandlerError(storageErr)
+
+	switch s.storageFailurePolicy {
+	case StorageFailureLogout:
+		// The failure might be caused by the Logout message itself, so the session is logged out only once.
+		// The logout is asynchronous because the session and handler locks may be held by the caller.
+		if s.storageFailed.CompareAndSwap(false, true) {
+			go func() {
+				s.HandlerError(s.Stop())
+			}()
+		}
+	case StorageFailureStop:
+		if s.storageFailed.CompareAndSwap(false, true) {
+			s.cancel()
+			s.Router.Stop()
+		}
+	}
+
+	return storageErr
+}
+
+// isStorageFailure distinguishes the errors of a storage itself from the errors caused by invalid requests.
+func isStorageFailure(err error) bool {
+	return !errors.Is(err, simplefixgo.ErrNotEnoughMessages) &&
+		!errors.Is(err, simplefixgo.ErrInvalidBoundaries) &&
+		!errors.Is(err, simplefixgo.ErrMessagesTrimmed)
 }
 
 func (s *Session) IsLogged() bool {
@@ -707,12 +977,81 @@ func (s *Session) MakeReject(reasonCode, tag, seqNum int) messages.RejectBuilder
 	return msg
 }
 
+// SetMessageStorage replaces the message storage passed to the constructor with a context-aware one.
+// It could be called only before starting Session
+func (s *Session) SetMessageStorage(storage ContextMessageStorage) {
+	s.messageStorage = storage
+}
+
+// SetCounterStorage replaces the counter storage passed to the constructor with a context-aware one.
+// It could be called only before starting Session
+func (s *Session) SetCounterStorage(storage ContextCounterStorage) {
+	s.counter = storage
+}
+
+// SetStorageFailurePolicy defines how the session reacts to storage errors,
+// which are reported to the error handler as *StorageError.
+// StorageFailureContinue is used by default.
+func (s *Session) SetStorageFailurePolicy(policy StorageFailurePolicy) {
+	s.storageFailurePolicy = policy
+}
+
+// SetOutgoingValidation enables the validation of the outgoing messages implementing messages.Validatable,
+// e.g. the generated messages checking their required fields and conditional rules. It is disabled by default.
+// It could be called only before starting Session
+func (s *Session) SetOutgoingValidation(enabled bool) {
+	s.validateOutgoing = enabled
+}
+
+// SetLogger sets a logger recording the session state changes and errors.
+// Messages are recorded by the logger of the handler.
+// It could be called only before starting Session
+func (s *Session) SetLogger(logger simplefixgo.Logger) {
+	s.logger = logger
+}
+
+// SetMetrics sets a sink for the session metrics reported under the specified session ID.
+// If the session ID of an acceptor session is empty, the metrics are reported
+// under simplefixgo.MetricsSessionID once the Logon is accepted.
+// It could be called only before starting Session
+func (s *Session) SetMetrics(metrics simplefixgo.Metrics, sessionID string) {
+	s.metrics = metrics
+	s.sessionID.Store(&sessionID)
+}
+
+func (s *Session) metricsSessionID() string {
+	if sessionID := s.sessionID.Load(); sessionID != nil {
+		return *sessionID
+	}
+
+	return ""
+}
+
+// addMetric increases a counter unless the session ID is still unknown.
+func (s *Session) addMetric(name string, delta int64) {
+	if sessionID := s.metricsSessionID(); sessionID != "" {
+		s.metrics.Add(sessionID, name, delta)
+	}
+}
+
+// SetValidator replaces the validator of incoming session messages, e.g. by a dictionary.Validator.
+// It could be called only before starting Session
+func (s *Session) SetValidator(validator encoding.Validator) {
+	s.unmarshaller = &encoding.DefaultUnmarshaller{Validator: validator, Strict: true}
+}
+
 // SetUnmarshaller replaces current unmarshaller buy custom one
 // It could be called only before starting Session
 func (s *Session) SetUnmarshaller(unmarshaller Unmarshaller) {
 	s.unmarshaller = unmarshaller
 }
 
+// Unmarshal parses an incoming message with the unmarshaller of the session,
+// so the application messages are validated the same way as the session ones.
+func (s *Session) Unmarshal(msg messages.Builder, data []byte) error {
+	return s.unmarshaller.Unmarshal(msg, data)
+}
+
 func (s *Session) Stop() (err error) {
 	defer func() {
 		s.eventHandler.Clean()
@@ -3,12 +3,15 @@ package session
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/b2broker/simplefix-go/storages/memory"
 
 	simplefixgo "github.com/b2broker/simplefix-go"
+	"github.com/b2broker/simplefix-go/fix"
+	"github.com/b2broker/simplefix-go/fix/encoding"
 	"github.com/b2broker/simplefix-go/session/messages"
 	fixgen "github.com/b2broker/simplefix-go/tests/fix44"
 )
@@ -407,3 +410,322 @@ func TestNewAcceptorSessionLogonSettings(t *testing.T) {
 		}
 	}
 }
+
+func runTestHandler(ctx context.Context) *simplefixgo.DefaultHandler {
+	handler := simplefixgo.NewAcceptorHandler(ctx, fixgen.FieldMsgType, 100)
+	go func() {
+		_ = handler.Run()
+	}()
+
+	return handler
+}
+
+func takeOutgoing(t *testing.T, handler *simplefixgo.DefaultHandler) []byte {
+	select {
+	case msg := <-handler.Outgoing():
+		return msg
+	case <-time.After(time.Second):
+		t.Fatalf("no outgoing message")
+	}
+
+	return nil
+}
+
+func mustValueByTag(t *testing.T, msg []byte, tag string) string {
+	v, err := fix.ValueByTag(msg, tag)
+	if err != nil {
+		t.Fatalf("could not find tag %s: %s", tag, err)
+	}
+
+	return string(v)
+}
+
+func TestResendRequestTrimmedRange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testStorage := memory.NewStorage()
+	testStorage.SetRetentionPolicy(fix.RetentionPolicy{MaxMessages: 2})
+
+	builders := validMessageBuilders
+	builders.SequenceResetBuilder = fixgen.SequenceReset{}.New()
+
+	handler := runTestHandler(ctx)
+	session, err := NewAcceptorSession(&Opts{
+		MessageBuilders:         builders,
+		Tags:                    validTags,
+		AllowedEncryptedMethods: validEncryptedMethod,
+		SessionErrorCodes:       validSessionErrorCodes,
+	}, handler, &validLogonSettings, func(request *LogonSettings) (err error) { return nil },
+		testStorage,
+		testStorage,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if err = session.Send(fixgen.Heartbeat{}.New()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		takeOutgoing(t, handler)
+	}
+
+	resendRequest := fixgen.ResendRequest{}.New().SetFieldBeginSeqNo(1).SetFieldEndSeqNo(5)
+	resendRequest.HeaderBuilder().
+		SetFieldMsgSeqNum(1).
+		SetFieldSenderCompID(validLogonSettings.TargetCompID).
+		SetFieldTargetCompID(validLogonSettings.SenderCompID).
+		SetFieldSendingTime(time.Now().UTC().Format(fix.TimeLayout))
+	data, err := resendRequest.ToBytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	handler.ServeIncoming(data)
+
+	gapFill := takeOutgoing(t, handler)
+	if msgType := mustValueByTag(t, gapFill, fixgen.FieldMsgType); msgType != fixgen.MsgTypeSequenceReset {
+		t.Fatalf("unexpected message type: %s", msgType)
+	}
+	if seqNum := mustValueByTag(t, gapFill, fixgen.FieldMsgSeqNum); seqNum != "1" {
+		t.Fatalf("unexpected gap fill sequence number: %s", seqNum)
+	}
+	if newSeqNo := mustValueByTag(t, gapFill, fixgen.FieldNewSeqNo); newSeqNo != "4" {
+		t.Fatalf("unexpected new sequence number: %s", newSeqNo)
+	}
+	if gapFillFlag := mustValueByTag(t, gapFill, fixgen.FieldGapFillFlag); gapFillFlag != "Y" {
+		t.Fatalf("unexpected gap fill flag: %s", gapFillFlag)
+	}
+	if possDupFlag := mustValueByTag(t, gapFill, fixgen.FieldPossDupFlag); possDupFlag != "Y" {
+		t.Fatalf("unexpected poss dup flag: %s", possDupFlag)
+	}
+	if origSendingTime := mustValueByTag(t, gapFill, fixgen.FieldOrigSendingTime); origSendingTime == "" ||
+		origSendingTime != mustValueByTag(t, gapFill, fixgen.FieldSendingTime) {
+		t.Fatalf("unexpected original sending time: %s", origSendingTime)
+	}
+
+	for _, expected := range []string{"4", "5"} {
+		msg := takeOutgoing(t, handler)
+		if seqNum := mustValueByTag(t, msg, fixgen.FieldMsgSeqNum); seqNum != expected {
+			t.Fatalf("unexpected resent sequence number: %s, expected: %s", seqNum, expected)
+		}
+	}
+}
+
+var errTestStorage = errors.New("test storage error")
+
+type failingMessageStorage struct{}
+
+func (failingMessageStorage) Save(_ context.Context, _ fix.StorageID, _ simplefixgo.SendingMessage, _ int) error {
+	return errTestStorage
+}
+
+func (failingMessageStorage) Messages(_ context.Context, _ fix.StorageID, _, _ int) ([]simplefixgo.SendingMessage, error) {
+	return nil, errTestStorage
+}
+
+func newStorageFailureSession(t *testing.T, ctx context.Context, policy StorageFailurePolicy) (*Session, *simplefixgo.DefaultHandler, chan error) {
+	testStorage := memory.NewStorage()
+
+	handler := runTestHandler(ctx)
+	session, err := NewAcceptorSession(&Opts{
+		MessageBuilders:         validMessageBuilders,
+		Tags:                    validTags,
+		AllowedEncryptedMethods: validEncryptedMethod,
+		SessionErrorCodes:       validSessionErrorCodes,
+	}, handler, &validLogonSettings, func(request *LogonSettings) (err error) { return nil },
+		testStorage,
+		testStorage,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	errs := make(chan error, 10)
+	session.OnError(func(err error) {
+		errs <- err
+	})
+	session.SetMessageStorage(failingMessageStorage{})
+	session.SetStorageFailurePolicy(policy)
+
+	return session, handler, errs
+}
+
+func takeStorageError(t *testing.T, errs chan error) *StorageError {
+	select {
+	case err := <-errs:
+		var storageErr *StorageError
+		if !errors.As(err, &storageErr) {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !errors.Is(err, ErrStorageFailure) || !errors.Is(err, errTestStorage) {
+			t.Fatalf("the storage error does not wrap the cause: %s", err)
+		}
+
+		return storageErr
+	case <-time.After(time.Second):
+		t.Fatalf("no storage error")
+	}
+
+	return nil
+}
+
+func TestStorageFailureContinue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	session, handler, errs := newStorageFailureSession(t, ctx, StorageFailureContinue)
+
+	if err := session.Send(fixgen.Heartbeat{}.New()); err == nil {
+		t.Fatalf("the message is sent without being saved")
+	}
+
+	storageErr := takeStorageError(t, errs)
+	if storageErr.Op != "save" || storageErr.StorageID.Side != fix.Outgoing {
+		t.Fatalf("unexpected storage error: %s", storageErr)
+	}
+
+	if session.Context().Err() != nil {
+		t.Fatalf("the session is stopped")
+	}
+
+	select {
+	case msg := <-handler.Outgoing():
+		t.Fatalf("unexpected outgoing message: %s", msg)
+	default:
+	}
+}
+
+func TestStorageFailureStop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	session, handler, errs := newStorageFailureSession(t, ctx, StorageFailureStop)
+
+	if err := session.Send(fixgen.Heartbeat{}.New()); err == nil {
+		t.Fatalf("the message is sent without being saved")
+	}
+	takeStorageError(t, errs)
+
+	select {
+	case <-session.Context().Done():
+	case <-time.After(time.Second):
+		t.Fatalf("the session is not stopped")
+	}
+
+	select {
+	case msg := <-handler.Outgoing():
+		t.Fatalf("unexpected outgoing message: %s", msg)
+	default:
+	}
+}
+
+func TestRejectMessageWithError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testStorage := memory.NewStorage()
+	handler := runTestHandler(ctx)
+	session, err := NewAcceptorSession(&Opts{
+		MessageBuilders:         validMessageBuilders,
+		Tags:                    validTags,
+		AllowedEncryptedMethods: validEncryptedMethod,
+		SessionErrorCodes: &messages.SessionErrorCodes{
+			IncorrectNumInGroupCount: 16,
+			Other:                    99,
+		},
+	}, handler, &validLogonSettings, func(request *LogonSettings) (err error) { return nil },
+		testStorage,
+		testStorage,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	msg := []byte("8=FIX.4.4\x019=5\x0135=V\x01123=7\x01146=2\x0110=000\x01")
+
+	session.RejectMessageWithError(msg, fmt.Errorf("could not unmarshal items: %w",
+		&encoding.Error{Reason: encoding.ErrIncorrectNumInGroupCount, Tag: "146"}))
+	reject := takeOutgoing(t, handler)
+
+	expected := map[string]string{
+		fixgen.FieldSessionRejectReason: "16",
+		fixgen.FieldRefTagID:            "146",
+		fixgen.FieldRefMsgType:          "V",
+		fixgen.FieldRefSeqNum:           "7",
+		fixgen.FieldText:                encoding.ErrIncorrectNumInGroupCount.Error(),
+	}
+	for tag, value := range expected {
+		if v := mustValueByTag(t, reject, tag); v != value {
+			t.Fatalf("unexpected value of tag %s: %s, expected: %s", tag, v, value)
+		}
+	}
+
+	// The reasons without codes are reported as Other.
+	session.RejectMessageWithError(msg, &encoding.Error{Reason: encoding.ErrTagAppearsMoreThanOnce, Tag: "146"})
+	reject = takeOutgoing(t, handler)
+	if v := mustValueByTag(t, reject, fixgen.FieldSessionRejectReason); v != "99" {
+		t.Fatalf("unexpected reject reason: %s", v)
+	}
+}
+
+func TestOutgoingValidation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testStorage := memory.NewStorage()
+	handler := runTestHandler(ctx)
+	session, err := NewAcceptorSession(&Opts{
+		MessageBuilders:         validMessageBuilders,
+		Tags:                    validTags,
+		AllowedEncryptedMethods: validEncryptedMethod,
+		SessionErrorCodes:       validSessionErrorCodes,
+	}, handler, &validLogonSettings, func(request *LogonSettings) (err error) { return nil },
+		testStorage,
+		testStorage,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	request := fixgen.CreateMarketDataRequest("req", fixgen.EnumSubscriptionRequestTypeSnapshotupdate, 1,
+		fixgen.NewMDEntryTypesGrp().AddEntry(fixgen.NewMDEntryTypesEntry().SetMDEntryType(fixgen.EnumMDEntryTypeBid)),
+		fixgen.NewRelatedSymGrp().AddEntry(fixgen.NewRelatedSymEntry().SetInstrument(fixgen.NewInstrument().SetSymbol("BTC/USD"))),
+	)
+
+	// The validation is disabled by default.
+	if err = session.Send(request); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	takeOutgoing(t, handler)
+
+	session.SetOutgoingValidation(true)
+
+	var validationErr *fix.ValidationError
+	err = session.Send(request)
+	if !errors.Is(err, fix.ErrConditionalFieldMissing) || !errors.As(err, &validationErr) ||
+		validationErr.Tag != fixgen.FieldMDUpdateType {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	request.SetMDUpdateType(fixgen.EnumMDUpdateTypeFull)
+	request.MDEntryTypesGrp().AddEntry(fixgen.NewMDEntryTypesEntry())
+	err = session.Send(request)
+	if !errors.Is(err, fix.ErrRequiredFieldMissing) || !errors.As(err, &validationErr) ||
+		validationErr.Tag != fixgen.FieldMDEntryType {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case msg := <-handler.Outgoing():
+		t.Fatalf("the invalid message is sent: %s", msg)
+	default:
+	}
+
+	request.SetMDEntryTypesGrp(fixgen.NewMDEntryTypesGrp().AddEntry(
+		fixgen.NewMDEntryTypesEntry().SetMDEntryType(fixgen.EnumMDEntryTypeOffer)))
+	if err = session.Send(request); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	takeOutgoing(t, handler)
+}
@@ -1,19 +1,152 @@
 package session
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	simplefixgo "github.com/b2broker/simplefix-go"
 	"github.com/b2broker/simplefix-go/fix"
 )
 
+// ErrStorageFailure is matched by every StorageError.
+var ErrStorageFailure = errors.New("storage failure")
+
 // MessageStorage is an interface providing a basic method for storing messages awaiting to be sent.
 type MessageStorage interface {
 	Save(storageID fix.StorageID, msg simplefixgo.SendingMessage, msgSeqNum int) error
 	Messages(storageID fix.StorageID, msgSeqNumFrom, msgSeqNumTo int) ([]simplefixgo.SendingMessage, error)
 }
 
+// RetainingStorage is implemented by a MessageStorage that trims old messages according to a retention policy.
+// Its Messages method returns simplefixgo.ErrMessagesTrimmed if a requested range has been trimmed.
+type RetainingStorage interface {
+	// FirstSeqNum returns the lowest sequence number of the messages that are still retained.
+	FirstSeqNum(storageID fix.StorageID) (int, error)
+}
+
 type CounterStorage interface {
 	GetNextSeqNum(storageID fix.StorageID) (int, error)
 	GetCurrSeqNum(storageID fix.StorageID) (int, error)
 	ResetSeqNum(storageID fix.StorageID) error
 	SetSeqNum(storageID fix.StorageID, seqNum int) error
 }
+
+// ContextMessageStorage is a MessageStorage whose methods accept a context,
+// which is canceled when the session is stopped.
+type ContextMessageStorage interface {
+	Save(ctx context.Context, storageID fix.StorageID, msg simplefixgo.SendingMessage, msgSeqNum int) error
+	Messages(ctx context.Context, storageID fix.StorageID, msgSeqNumFrom, msgSeqNumTo int) ([]simplefixgo.SendingMessage, error)
+}
+
+// ContextRetainingStorage is a context-aware version of RetainingStorage.
+type ContextRetainingStorage interface {
+	FirstSeqNum(ctx context.Context, storageID fix.StorageID) (int, error)
+}
+
+// ContextCounterStorage is a CounterStorage whose methods accept a context,
+// which is canceled when the session is stopped.
+type ContextCounterStorage interface {
+	GetNextSeqNum(ctx context.Context, storageID fix.StorageID) (int, error)
+	GetCurrSeqNum(ctx context.Context, storageID fix.StorageID) (int, error)
+	ResetSeqNum(ctx context.Context, storageID fix.StorageID) error
+	SetSeqNum(ctx context.Context, storageID fix.StorageID, seqNum int) error
+}
+
+type messageStorageAdapter struct {
+	storage MessageStorage
+}
+
+type retainingStorageAdapter struct {
+	messageStorageAdapter
+	retaining RetainingStorage
+}
+
+// NewMessageStorageAdapter turns a MessageStorage into a ContextMessageStorage ignoring the context.
+// The result implements ContextRetainingStorage if the storage implements RetainingStorage.
+func NewMessageStorageAdapter(storage MessageStorage) ContextMessageStorage {
+	adapter := messageStorageAdapter{storage: storage}
+
+	if retaining, ok := storage.(RetainingStorage); ok {
+		return &retainingStorageAdapter{messageStorageAdapter: adapter, retaining: retaining}
+	}
+
+	return &adapter
+}
+
+func (a *messageStorageAdapter) Save(_ context.Context, storageID fix.StorageID, msg simplefixgo.SendingMessage, msgSeqNum int) error {
+	return a.storage.Save(storageID, msg, msgSeqNum)
+}
+
+func (a *messageStorageAdapter) Messages(_ context.Context, storageID fix.StorageID, msgSeqNumFrom, msgSeqNumTo int) ([]simplefixgo.SendingMessage, error) {
+	return a.storage.Messages(storageID, msgSeqNumFrom, msgSeqNumTo)
+}
+
+func (a *retainingStorageAdapter) FirstSeqNum(_ context.Context, storageID fix.StorageID) (int, error) {
+	return a.retaining.FirstSeqNum(storageID)
+}
+
+type counterStorageAdapter struct {
+	storage CounterStorage
+}
+
+// NewCounterStorageAdapter turns a CounterStorage into a ContextCounterStorage ignoring the context.
+func NewCounterStorageAdapter(storage CounterStorage) ContextCounterStorage {
+	return &counterStorageAdapter{storage: storage}
+}
+
+func (a *counterStorageAdapter) GetNextSeqNum(_ context.Context, storageID fix.StorageID) (int, error) {
+	return a.storage.GetNextSeqNum(storageID)
+}
+
+func (a *counterStorageAdapter) GetCurrSeqNum(_ context.Context, storageID fix.StorageID) (int, error) {
+	return a.storage.GetCurrSeqNum(storageID)
+}
+
+func (a *counterStorageAdapter) ResetSeqNum(_ context.Context, storageID fix.StorageID) error {
+	return a.storage.ResetSeqNum(storageID)
+}
+
+func (a *counterStorageAdapter) SetSeqNum(_ context.Context, storageID fix.StorageID, seqNum int) error {
+	return a.storage.SetSeqNum(storageID, seqNum)
+}
+
+// StorageError is passed to the Session error handler when a storage operation fails.
+type StorageError struct {
+	// Op is the name of the failed storage method.
+	Op        string
+	StorageID fix.StorageID
+	Err       error
+}
+
+func (e *StorageError) Error() string {
+	return fmt.Sprintf("%s: %s %s storage of %s-%s: %s",
+		ErrStorageFailure, e.Op, e.StorageID.Side, e.StorageID.Sender, e.StorageID.Target, e.Err)
+}
+
+func (e *StorageError) Unwrap() error {
+	return e.Err
+}
+
+// Is makes every StorageError match ErrStorageFailure.
+func (e *StorageError) Is(target error) bool {
+	return target == ErrStorageFailure
+}
+
+// StorageFailurePolicy defines how a Session reacts to a failed storage operation.
+type StorageFailurePolicy int64
+
+const (
+	// StorageFailureContinue reports the error and keeps the session working.
+	// An outgoing message that could not be saved is not sent, since it could not be resent later,
+	// while an incoming message is processed even if its sequence number could not be saved.
+	StorageFailureContinue StorageFailurePolicy = iota
+
+	// StorageFailureLogout reports the error and gracefully terminates the session
+	// by sending a Logout message, which is sent even if it could not be saved.
+	StorageFailureLogout
+
+	// StorageFailureStop reports the error and stops the session immediately.
+	// An outgoing message that could not be saved is not sent.
+	StorageFailureStop
+)
@@ -5,24 +5,62 @@ import (
 	"github.com/b2broker/simplefix-go/fix"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
+type storedMessage struct {
+	msg     simplefixgo.SendingMessage
+	savedAt time.Time
+}
+
 // Storage is used to store the most recent messages.
 type Storage struct {
 	counterIncoming int64
 	counterOutgoing int64
-	messages        map[int]simplefixgo.SendingMessage
+	messages        map[int]storedMessage
 	mu              sync.Mutex
+
+	retention fix.RetentionPolicy
+	// order contains the sequence numbers of stored messages in the order they were saved.
+	order []int
+	// trimmedTo is the highest sequence number removed by the retention policy.
+	trimmedTo int
 }
 
 // NewStorage is a constructor for creation of a new in-memory Storage.
 func NewStorage() *Storage {
 	return &Storage{
-		messages: map[int]simplefixgo.SendingMessage{},
+		messages: map[int]storedMessage{},
 		mu:       sync.Mutex{},
 	}
 }
 
+// SetRetentionPolicy specifies which of the stored messages are trimmed.
+// The policy is applied immediately and after each saved message.
+func (s *Storage) SetRetentionPolicy(policy fix.RetentionPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.retention = policy
+	s.trim(time.Now())
+}
+
+// Trim removes the messages that are no longer retained by the retention policy.
+func (s *Storage) Trim() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.trim(time.Now())
+}
+
+// FirstSeqNum returns the lowest sequence number that has not been trimmed yet.
+func (s *Storage) FirstSeqNum(_ fix.StorageID) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.trimmedTo + 1, nil
+}
+
 func (s *Storage) GetNextSeqNum(storageID fix.StorageID) (int, error) {
 	if storageID.Side == fix.Incoming {
 		return int(atomic.AddInt64(&s.counterIncoming, 1)), nil
@@ -44,6 +82,15 @@ func (s *Storage) ResetSeqNum(storageID fix.StorageID) error {
 		s.counterIncoming = 0
 	} else {
 		s.counterOutgoing = 0
+
+		// The messages saved before the reset could not be resent under the new sequence numbers,
+		// and the trimmed range must not hide the messages saved after it, so all of them are dropped
+		// whatever the retention policy is.
+		s.mu.Lock()
+		s.messages = map[int]storedMessage{}
+		s.order = nil
+		s.trimmedTo = 0
+		s.mu.Unlock()
 	}
 	return nil
 }
@@ -61,12 +108,29 @@ func (s *Storage) SetSeqNum(storageID fix.StorageID, seqNum int) error {
 func (s *Storage) Save(_ fix.StorageID, msg simplefixgo.SendingMessage, msgSeqNum int) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.messages[msgSeqNum] = msg
+
+	// The message has already been trimmed, e.g. it is a gap fill sent instead of trimmed messages.
+	if msgSeqNum <= s.trimmedTo {
+		return nil
+	}
+
+	now := time.Now()
+	if stored, ok := s.messages[msgSeqNum]; ok {
+		stored.msg = msg
+		s.messages[msgSeqNum] = stored
+	} else {
+		s.messages[msgSeqNum] = storedMessage{msg: msg, savedAt: now}
+		s.order = append(s.order, msgSeqNum)
+	}
+
+	s.trim(now)
+
 	return nil
 }
 
 // Messages returns a message list, in a sequential order
 // (starting with msgSeqNumFrom and ending with msgSeqNumTo).
+// ErrMessagesTrimmed is returned if a part of the range has been trimmed by the retention policy.
 func (s *Storage) Messages(_ fix.StorageID, msgSeqNumFrom, msgSeqNumTo int) ([]simplefixgo.SendingMessage, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -79,13 +143,44 @@ func (s *Storage) Messages(_ fix.StorageID, msgSeqNumFrom, msgSeqNumTo int) ([]s
 		return nil, simplefixgo.ErrNotEnoughMessages
 	}
 
+	s.trim(time.Now())
+	if msgSeqNumFrom <= s.trimmedTo {
+		return nil, simplefixgo.ErrMessagesTrimmed
+	}
+
 	var sendingMessages []simplefixgo.SendingMessage
 	for i := msgSeqNumFrom; i <= msgSeqNumTo; i++ {
-		if _, ok := s.messages[i]; !ok {
+		stored, ok := s.messages[i]
+		if !ok {
 			return nil, simplefixgo.ErrNotEnoughMessages
 		}
-		sendingMessages = append(sendingMessages, s.messages[i])
+		sendingMessages = append(sendingMessages, stored.msg)
 	}
 
 	return sendingMessages, nil
 }
+
+// trim removes the oldest messages exceeding the limits of the retention policy.
+func (s *Storage) trim(now time.Time) {
+	if s.retention.MaxMessages > 0 {
+		for len(s.order) > s.retention.MaxMessages {
+			s.dropOldest()
+		}
+	}
+
+	if s.retention.MaxAge > 0 {
+		for len(s.order) > 0 && now.Sub(s.messages[s.order[0]].savedAt) > s.retention.MaxAge {
+			s.dropOldest()
+		}
+	}
+}
+
+func (s *Storage) dropOldest() {
+	seqNum := s.order[0]
+	s.order = s.order[1:]
+
+	delete(s.messages, seqNum)
+	if seqNum > s.trimmedTo {
+		s.trimmedTo = seqNum
+	}
+}
@@ -0,0 +1,109 @@
+package memory
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	simplefixgo "github.com/b2broker/simplefix-go"
+	"github.com/b2broker/simplefix-go/fix"
+	"github.com/b2broker/simplefix-go/session/messages"
+)
+
+var outgoing = fix.StorageID{Sender: "sender", Target: "target", Side: fix.Outgoing}
+
+func saveMessages(t *testing.T, s *Storage, count int) {
+	for i := 0; i < count; i++ {
+		seqNum, err := s.GetNextSeqNum(outgoing)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		err = s.Save(outgoing, messages.NewMockMessage("0", nil, nil), seqNum)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func TestStorage_RetentionMaxMessages(t *testing.T) {
+	s := NewStorage()
+	s.SetRetentionPolicy(fix.RetentionPolicy{MaxMessages: 2})
+
+	saveMessages(t, s, 5)
+
+	if _, err := s.Messages(outgoing, 1, 5); !errors.Is(err, simplefixgo.ErrMessagesTrimmed) {
+		t.Fatalf("expected error: %s, returned: %v", simplefixgo.ErrMessagesTrimmed, err)
+	}
+
+	first, _ := s.FirstSeqNum(outgoing)
+	if first != 4 {
+		t.Fatalf("unexpected first sequence number: %d", first)
+	}
+
+	msgs, err := s.Messages(outgoing, 4, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("unexpected messages count: %d", len(msgs))
+	}
+}
+
+func TestStorage_RetentionMaxAge(t *testing.T) {
+	s := NewStorage()
+	s.SetRetentionPolicy(fix.RetentionPolicy{MaxAge: time.Millisecond * 10})
+
+	saveMessages(t, s, 3)
+	time.Sleep(time.Millisecond * 20)
+	saveMessages(t, s, 1)
+
+	if _, err := s.Messages(outgoing, 3, 4); !errors.Is(err, simplefixgo.ErrMessagesTrimmed) {
+		t.Fatalf("expected error: %s, returned: %v", simplefixgo.ErrMessagesTrimmed, err)
+	}
+
+	if _, err := s.Messages(outgoing, 4, 4); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestStorage_RetentionSinceLastReset(t *testing.T) {
+	s := NewStorage()
+	s.SetRetentionPolicy(fix.RetentionPolicy{SinceLastReset: true})
+
+	saveMessages(t, s, 3)
+	if err := s.ResetSeqNum(outgoing); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := s.SetSeqNum(outgoing, 3); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := s.Messages(outgoing, 1, 3); !errors.Is(err, simplefixgo.ErrNotEnoughMessages) {
+		t.Fatalf("expected error: %s, returned: %v", simplefixgo.ErrNotEnoughMessages, err)
+	}
+}
+
+func TestStorage_RetentionMaxMessagesReset(t *testing.T) {
+	s := NewStorage()
+	s.SetRetentionPolicy(fix.RetentionPolicy{MaxMessages: 2})
+
+	saveMessages(t, s, 5)
+	if err := s.ResetSeqNum(outgoing); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	first, _ := s.FirstSeqNum(outgoing)
+	if first != 1 {
+		t.Fatalf("unexpected first sequence number: %d", first)
+	}
+
+	saveMessages(t, s, 2)
+	msgs, err := s.Messages(outgoing, 1, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("unexpected messages count: %d", len(msgs))
+	}
+}
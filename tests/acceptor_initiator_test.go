@@ -6,9 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"github.com/b2broker/simplefix-go/storages/memory"
+	"io"
 	"net"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -18,6 +20,7 @@ import (
 
 	simplefixgo "github.com/b2broker/simplefix-go"
 	"github.com/b2broker/simplefix-go/fix"
+	"github.com/b2broker/simplefix-go/metrics"
 	"github.com/b2broker/simplefix-go/session"
 	fixgen "github.com/b2broker/simplefix-go/tests/fix44"
 	"github.com/b2broker/simplefix-go/utils"
@@ -43,7 +46,7 @@ func TestHeartbeat(t *testing.T) {
 		TargetCompID:  "Server",
 		SenderCompID:  "Client",
 		HeartBtInt:    heartBtInt,
-		EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+		EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 	})
 
 	waitHeartbeats := utils.TimedWaitGroup{}
@@ -150,7 +153,7 @@ func TestGroup(t *testing.T) {
 		TargetCompID:  "Server",
 		SenderCompID:  "Client",
 		HeartBtInt:    heartBtInt,
-		EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+		EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 	})
 
 	initiatorSession.OnChangeState(utils.EventLogon, func() bool {
@@ -206,7 +209,7 @@ func TestTestRequest(t *testing.T) {
 		TargetCompID:  "Server",
 		SenderCompID:  "Client",
 		HeartBtInt:    heartBtInt,
-		EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+		EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 	})
 
 	waitHeartbeats := utils.TimedWaitGroup{}
@@ -271,7 +274,7 @@ func TestResendSequence(t *testing.T) {
 		TargetCompID:  "Server",
 		SenderCompID:  "Client",
 		HeartBtInt:    1,
-		EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+		EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 	})
 
 	waitRepeats := utils.TimedWaitGroup{}
@@ -381,7 +384,7 @@ func TestCloseInitiatorConn(t *testing.T) {
 			TargetCompID:  "Server",
 			SenderCompID:  "Client",
 			HeartBtInt:    1,
-			EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+			EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 		},
 		testStorage,
 		testStorage,
@@ -472,7 +475,7 @@ func TestCloseAcceptorConn(t *testing.T) {
 			TargetCompID:  "Server",
 			SenderCompID:  "Client",
 			HeartBtInt:    1,
-			EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+			EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 		},
 		testStorage,
 		testStorage,
@@ -588,7 +591,7 @@ func TestLookAtClosingOfInitiator(t *testing.T) {
 			TargetCompID:  "Server",
 			SenderCompID:  "Client",
 			HeartBtInt:    1,
-			EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+			EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 		},
 		testStorage,
 		testStorage,
@@ -696,7 +699,7 @@ func TestInterruptHandling(t *testing.T) {
 			TargetCompID:  "Server",
 			SenderCompID:  "Client",
 			HeartBtInt:    1,
-			EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+			EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 		},
 		testStorage,
 		testStorage,
@@ -753,7 +756,7 @@ func TestSequenceNumHighload(t *testing.T) {
 		TargetCompID:  "Server",
 		SenderCompID:  "Client",
 		HeartBtInt:    heartBtInt,
-		EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+		EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 	})
 
 	waitSnapshots := utils.TimedWaitGroup{}
@@ -844,7 +847,7 @@ func TestSessionClosing(t *testing.T) {
 		TargetCompID:  "Server",
 		SenderCompID:  "Client",
 		HeartBtInt:    heartBtInt,
-		EncryptMethod: fixgen.EnumEncryptMethodNoneother,
+		EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
 	})
 
 	triesNum := 5
@@ -899,3 +902,212 @@ func TestSessionClosing(t *testing.T) {
 	}
 
 }
+
+func TestAcceptorMetrics(t *testing.T) {
+	registry := metrics.NewRegistry()
+
+	acceptor, addr := RunAcceptor(0, t)
+	acceptor.SetMetrics(registry)
+	defer acceptor.Close()
+	go func() {
+		err := acceptor.ListenAndServe()
+		if err != nil && !errors.Is(err, simplefixgo.ErrConnClosed) {
+			panic(err)
+		}
+	}()
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("could not dial: %s", err)
+	}
+
+	handler := simplefixgo.NewInitiatorHandler(context.Background(), fixgen.FieldMsgType, 10)
+	client := simplefixgo.NewInitiator(conn, handler, 10, time.Second*5)
+
+	testStorage := memory.NewStorage()
+	s, err := session.NewInitiatorSession(
+		handler,
+		&pseudoGeneratedOpts,
+		&session.LogonSettings{
+			TargetCompID:  "Server",
+			SenderCompID:  "Client",
+			HeartBtInt:    1,
+			EncryptMethod: string(fixgen.EnumEncryptMethodNoneother),
+		},
+		testStorage,
+		testStorage,
+	)
+	if err != nil {
+		t.Fatalf("could not create the session: %s", err)
+	}
+
+	waitLogon := make(chan struct{})
+	s.OnChangeState(utils.EventLogon, func() bool {
+		close(waitLogon)
+		return true
+	})
+
+	go func() {
+		err := client.Serve()
+		if err != nil && !errors.Is(err, simplefixgo.ErrConnClosed) {
+			panic(fmt.Errorf("could not serve the client: %s", err))
+		}
+	}()
+
+	err = s.Run()
+	if err != nil {
+		t.Fatalf("could not run the session: %s", err)
+	}
+
+	select {
+	case <-waitLogon:
+	case <-time.After(time.Second * 3):
+		t.Fatalf("awaiting logon for too long")
+	}
+
+	sessionID := simplefixgo.MetricsSessionID("Server", "Client")
+	waitMetrics := func(cond func(snapshot metrics.Snapshot) bool) {
+		deadline := time.Now().Add(time.Second * 3)
+		for !cond(registry.Snapshot()) {
+			if time.Now().After(deadline) {
+				t.Fatalf("unexpected metrics: %+v", registry.Snapshot().Sessions)
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+	}
+
+	waitMetrics(func(snapshot metrics.Snapshot) bool {
+		return snapshot.Sessions[sessionID].Counters[simplefixgo.MetricMessagesReceived] > 0 &&
+			snapshot.Sessions[sessionID].Counters[simplefixgo.MetricBytesWritten] > 0
+	})
+	if len(registry.Snapshot().Sessions) != 1 {
+		t.Fatalf("unexpected sessions: %+v", registry.Snapshot().Sessions)
+	}
+
+	client.Close()
+
+	waitMetrics(func(snapshot metrics.Snapshot) bool {
+		return len(snapshot.Sessions) == 0
+	})
+}
+
+// rawFrame returns a message with the specified body, a valid BodyLength and a CheckSum.
+func rawFrame(body string) string {
+	msg := fmt.Sprintf("8=FIX.4.4\x019=%d\x01%s", len(body), body)
+
+	var sum int
+	for i := 0; i < len(msg); i++ {
+		sum += int(msg[i])
+	}
+
+	return fmt.Sprintf("%s10=%03d\x01", msg, sum%256)
+}
+
+func TestGarbledMessagesLimit(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("listening error: %s", err)
+	}
+
+	testStorage := memory.NewStorage()
+	garbled := make(chan struct{}, 10)
+
+	handlerFactory := simplefixgo.NewAcceptorHandlerFactory(fixgen.FieldMsgType, 10)
+	acceptor := simplefixgo.NewAcceptor(listener, handlerFactory, time.Second*5, func(handler simplefixgo.AcceptorHandler) {
+		defaultHandler := handler.(*simplefixgo.DefaultHandler)
+		defaultHandler.SetGarbledMessagesLimit(2)
+		defaultHandler.OnGarbled(func() bool {
+			garbled <- struct{}{}
+			return true
+		})
+
+		s, err := session.NewAcceptorSession(
+			&pseudoGeneratedOpts,
+			handler,
+			&session.LogonSettings{HeartBtLimits: &session.IntLimits{
+				Min: 1,
+				Max: 60,
+			}, LogonTimeout: time.Second * 30},
+			func(request *session.LogonSettings) (err error) { return nil },
+			testStorage,
+			testStorage,
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		err = s.Run()
+		if err != nil {
+			panic(err)
+		}
+	})
+	defer acceptor.Close()
+
+	go func() {
+		err := acceptor.ListenAndServe()
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatalf("could not dial: %s", err)
+	}
+	defer conn.Close()
+
+	const header = "49=Client\x0156=Server\x0152=20260101-00:00:00.000\x01"
+	heartbeatBody := "35=0\x0134=2\x01" + header
+	heartbeat := rawFrame(heartbeatBody)
+
+	var received []byte
+	waitReceived := func(expected string) {
+		buf := make([]byte, 1024)
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second * 3))
+		for !bytes.Contains(received, []byte(expected)) {
+			n, err := conn.Read(buf)
+			if err != nil {
+				t.Fatalf("%q is not received: %s, received: %q", expected, err, received)
+			}
+			received = append(received, buf[:n]...)
+		}
+	}
+
+	_, err = conn.Write([]byte(rawFrame("35=A\x0134=1\x01" + header + "98=0\x01108=30\x01")))
+	if err != nil {
+		t.Fatalf("could not write: %s", err)
+	}
+	waitReceived("\x0135=A\x01")
+
+	// The BodyLength is too small to find the CheckSum field, so the message could not be framed.
+	_, err = conn.Write([]byte(strings.Replace(heartbeat, fmt.Sprintf("9=%d", len(heartbeatBody)), "9=5", 1)))
+	if err != nil {
+		t.Fatalf("could not write: %s", err)
+	}
+
+	select {
+	case <-garbled:
+	case <-time.After(time.Second * 3):
+		t.Fatalf("the message with an invalid BodyLength is not counted as garbled")
+	}
+
+	// The CheckSum is invalid, so the message is framed and then discarded by the handler.
+	_, err = conn.Write([]byte(strings.Replace(heartbeat, "34=2", "34=3", 1)))
+	if err != nil {
+		t.Fatalf("could not write: %s", err)
+	}
+
+	waitReceived("\x0135=5\x01")
+
+	buf := make([]byte, 1024)
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second * 3))
+	for {
+		_, err = conn.Read(buf)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("the connection is not closed after the garbled messages limit: %s", err)
+		}
+	}
+}
@@ -34,6 +34,17 @@ func (group *AltMDSourceGrp) Entries() []*AltMDSourceEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *AltMDSourceGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type AltMDSourceEntry struct {
 	*fix.Component
 }
@@ -59,3 +70,10 @@ func (altMDSourceEntry *AltMDSourceEntry) SetAltMDSourceID(altMDSourceID string)
 	_ = kv.Load().Set(altMDSourceID)
 	return altMDSourceEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (altMDSourceEntry *AltMDSourceEntry) Validate() error {
+	return nil
+}
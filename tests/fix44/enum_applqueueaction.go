@@ -2,10 +2,41 @@
 
 package fix44
 
-// Enum type EnumApplQueueAction
+// EnumApplQueueAction is the type of the ApplQueueAction(815) field values.
+type EnumApplQueueAction string
+
 const (
-	EnumApplQueueActionNoactiontaken string = "0"
-	EnumApplQueueActionQueueflushed  string = "1"
-	EnumApplQueueActionOverlaylast   string = "2"
-	EnumApplQueueActionEndsession    string = "3"
+	EnumApplQueueActionNoactiontaken EnumApplQueueAction = "0"
+	EnumApplQueueActionQueueflushed  EnumApplQueueAction = "1"
+	EnumApplQueueActionOverlaylast   EnumApplQueueAction = "2"
+	EnumApplQueueActionEndsession    EnumApplQueueAction = "3"
 )
+
+// String returns the description of the value.
+func (v EnumApplQueueAction) String() string {
+	switch v {
+	case EnumApplQueueActionNoactiontaken:
+		return "NOACTIONTAKEN"
+	case EnumApplQueueActionQueueflushed:
+		return "QUEUEFLUSHED"
+	case EnumApplQueueActionOverlaylast:
+		return "OVERLAYLAST"
+	case EnumApplQueueActionEndsession:
+		return "ENDSESSION"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumApplQueueAction) IsValid() bool {
+	switch v {
+	case EnumApplQueueActionNoactiontaken,
+		EnumApplQueueActionQueueflushed,
+		EnumApplQueueActionOverlaylast,
+		EnumApplQueueActionEndsession:
+		return true
+	}
+
+	return false
+}
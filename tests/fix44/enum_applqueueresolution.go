@@ -2,10 +2,41 @@
 
 package fix44
 
-// Enum type EnumApplQueueResolution
+// EnumApplQueueResolution is the type of the ApplQueueResolution(814) field values.
+type EnumApplQueueResolution string
+
 const (
-	EnumApplQueueResolutionNoactiontaken string = "0"
-	EnumApplQueueResolutionQueueflushed  string = "1"
-	EnumApplQueueResolutionOverlaylast   string = "2"
-	EnumApplQueueResolutionEndsession    string = "3"
+	EnumApplQueueResolutionNoactiontaken EnumApplQueueResolution = "0"
+	EnumApplQueueResolutionQueueflushed  EnumApplQueueResolution = "1"
+	EnumApplQueueResolutionOverlaylast   EnumApplQueueResolution = "2"
+	EnumApplQueueResolutionEndsession    EnumApplQueueResolution = "3"
 )
+
+// String returns the description of the value.
+func (v EnumApplQueueResolution) String() string {
+	switch v {
+	case EnumApplQueueResolutionNoactiontaken:
+		return "NOACTIONTAKEN"
+	case EnumApplQueueResolutionQueueflushed:
+		return "QUEUEFLUSHED"
+	case EnumApplQueueResolutionOverlaylast:
+		return "OVERLAYLAST"
+	case EnumApplQueueResolutionEndsession:
+		return "ENDSESSION"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumApplQueueResolution) IsValid() bool {
+	switch v {
+	case EnumApplQueueResolutionNoactiontaken,
+		EnumApplQueueResolutionQueueflushed,
+		EnumApplQueueResolutionOverlaylast,
+		EnumApplQueueResolutionEndsession:
+		return true
+	}
+
+	return false
+}
@@ -2,11 +2,52 @@
 
 package fix44
 
-// Enum type EnumCorporateAction
+import (
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// EnumCorporateAction is the type of the CorporateAction(292) field values.
+type EnumCorporateAction string
+
 const (
-	EnumCorporateActionExdividend string = "A"
-	EnumCorporateActionExdist     string = "B"
-	EnumCorporateActionExrights   string = "C"
-	EnumCorporateActionNew        string = "D"
-	EnumCorporateActionExinterest string = "E"
+	EnumCorporateActionExdividend EnumCorporateAction = "A"
+	EnumCorporateActionExdist     EnumCorporateAction = "B"
+	EnumCorporateActionExrights   EnumCorporateAction = "C"
+	EnumCorporateActionNew        EnumCorporateAction = "D"
+	EnumCorporateActionExinterest EnumCorporateAction = "E"
 )
+
+// String returns the description of the value.
+func (v EnumCorporateAction) String() string {
+	switch v {
+	case EnumCorporateActionExdividend:
+		return "EXDIVIDEND"
+	case EnumCorporateActionExdist:
+		return "EXDIST"
+	case EnumCorporateActionExrights:
+		return "EXRIGHTS"
+	case EnumCorporateActionNew:
+		return "NEW"
+	case EnumCorporateActionExinterest:
+		return "EXINTEREST"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+// The value is a space-separated list, so each of its items is checked.
+func (v EnumCorporateAction) IsValid() bool {
+	return fix.IsValidMultipleValue(string(v), func(value string) bool {
+		switch EnumCorporateAction(value) {
+		case EnumCorporateActionExdividend,
+			EnumCorporateActionExdist,
+			EnumCorporateActionExrights,
+			EnumCorporateActionNew,
+			EnumCorporateActionExinterest:
+			return true
+		}
+
+		return false
+	})
+}
@@ -2,9 +2,37 @@
 
 package fix44
 
-// Enum type EnumCPProgram
+// EnumCPProgram is the type of the CPProgram(875) field values.
+type EnumCPProgram string
+
 const (
-	EnumCPProgram3a3   string = "1"
-	EnumCPProgram42    string = "2"
-	EnumCPProgramOther string = "99"
+	EnumCPProgram3a3   EnumCPProgram = "1"
+	EnumCPProgram42    EnumCPProgram = "2"
+	EnumCPProgramOther EnumCPProgram = "99"
 )
+
+// String returns the description of the value.
+func (v EnumCPProgram) String() string {
+	switch v {
+	case EnumCPProgram3a3:
+		return "3A3"
+	case EnumCPProgram42:
+		return "42"
+	case EnumCPProgramOther:
+		return "OTHER"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumCPProgram) IsValid() bool {
+	switch v {
+	case EnumCPProgram3a3,
+		EnumCPProgram42,
+		EnumCPProgramOther:
+		return true
+	}
+
+	return false
+}
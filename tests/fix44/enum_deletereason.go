@@ -2,8 +2,33 @@
 
 package fix44
 
-// Enum type EnumDeleteReason
+// EnumDeleteReason is the type of the DeleteReason(285) field values.
+type EnumDeleteReason string
+
 const (
-	EnumDeleteReasonCanceltradebust string = "0"
-	EnumDeleteReasonError           string = "1"
+	EnumDeleteReasonCanceltradebust EnumDeleteReason = "0"
+	EnumDeleteReasonError           EnumDeleteReason = "1"
 )
+
+// String returns the description of the value.
+func (v EnumDeleteReason) String() string {
+	switch v {
+	case EnumDeleteReasonCanceltradebust:
+		return "CANCELTRADEBUST"
+	case EnumDeleteReasonError:
+		return "ERROR"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumDeleteReason) IsValid() bool {
+	switch v {
+	case EnumDeleteReasonCanceltradebust,
+		EnumDeleteReasonError:
+		return true
+	}
+
+	return false
+}
@@ -2,13 +2,53 @@
 
 package fix44
 
-// Enum type EnumEncryptMethod
+// EnumEncryptMethod is the type of the EncryptMethod(98) field values.
+type EnumEncryptMethod string
+
 const (
-	EnumEncryptMethodNoneother                                        string = "0"
-	EnumEncryptMethodPkcsproprietary                                  string = "1"
-	EnumEncryptMethodDesecbmode                                       string = "2"
-	EnumEncryptMethodPkcsdesproprietary                               string = "3"
-	EnumEncryptMethodPgpdesdefunct                                    string = "4"
-	EnumEncryptMethodPgpdesmd5seeappnoteonfixwebsite                  string = "5"
-	EnumEncryptMethodPemdesmd5seeappnoteonfixwebsitenaforfixmlnotused string = "6"
+	EnumEncryptMethodNoneother                                        EnumEncryptMethod = "0"
+	EnumEncryptMethodPkcsproprietary                                  EnumEncryptMethod = "1"
+	EnumEncryptMethodDesecbmode                                       EnumEncryptMethod = "2"
+	EnumEncryptMethodPkcsdesproprietary                               EnumEncryptMethod = "3"
+	EnumEncryptMethodPgpdesdefunct                                    EnumEncryptMethod = "4"
+	EnumEncryptMethodPgpdesmd5seeappnoteonfixwebsite                  EnumEncryptMethod = "5"
+	EnumEncryptMethodPemdesmd5seeappnoteonfixwebsitenaforfixmlnotused EnumEncryptMethod = "6"
 )
+
+// String returns the description of the value.
+func (v EnumEncryptMethod) String() string {
+	switch v {
+	case EnumEncryptMethodNoneother:
+		return "NONEOTHER"
+	case EnumEncryptMethodPkcsproprietary:
+		return "PKCSPROPRIETARY"
+	case EnumEncryptMethodDesecbmode:
+		return "DESECBMODE"
+	case EnumEncryptMethodPkcsdesproprietary:
+		return "PKCSDESPROPRIETARY"
+	case EnumEncryptMethodPgpdesdefunct:
+		return "PGPDESDEFUNCT"
+	case EnumEncryptMethodPgpdesmd5seeappnoteonfixwebsite:
+		return "PGPDESMD5SEEAPPNOTEONFIXWEBSITE"
+	case EnumEncryptMethodPemdesmd5seeappnoteonfixwebsitenaforfixmlnotused:
+		return "PEMDESMD5SEEAPPNOTEONFIXWEBSITENAFORFIXMLNOTUSED"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumEncryptMethod) IsValid() bool {
+	switch v {
+	case EnumEncryptMethodNoneother,
+		EnumEncryptMethodPkcsproprietary,
+		EnumEncryptMethodDesecbmode,
+		EnumEncryptMethodPkcsdesproprietary,
+		EnumEncryptMethodPgpdesdefunct,
+		EnumEncryptMethodPgpdesmd5seeappnoteonfixwebsite,
+		EnumEncryptMethodPemdesmd5seeappnoteonfixwebsitenaforfixmlnotused:
+		return true
+	}
+
+	return false
+}
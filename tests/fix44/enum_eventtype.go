@@ -2,11 +2,45 @@
 
 package fix44
 
-// Enum type EnumEventType
+// EnumEventType is the type of the EventType(865) field values.
+type EnumEventType string
+
 const (
-	EnumEventTypePut             string = "1"
-	EnumEventTypeCall            string = "2"
-	EnumEventTypeTender          string = "3"
-	EnumEventTypeSinkingfundcall string = "4"
-	EnumEventTypeOther           string = "99"
+	EnumEventTypePut             EnumEventType = "1"
+	EnumEventTypeCall            EnumEventType = "2"
+	EnumEventTypeTender          EnumEventType = "3"
+	EnumEventTypeSinkingfundcall EnumEventType = "4"
+	EnumEventTypeOther           EnumEventType = "99"
 )
+
+// String returns the description of the value.
+func (v EnumEventType) String() string {
+	switch v {
+	case EnumEventTypePut:
+		return "PUT"
+	case EnumEventTypeCall:
+		return "CALL"
+	case EnumEventTypeTender:
+		return "TENDER"
+	case EnumEventTypeSinkingfundcall:
+		return "SINKINGFUNDCALL"
+	case EnumEventTypeOther:
+		return "OTHER"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumEventType) IsValid() bool {
+	switch v {
+	case EnumEventTypePut,
+		EnumEventTypeCall,
+		EnumEventTypeTender,
+		EnumEventTypeSinkingfundcall,
+		EnumEventTypeOther:
+		return true
+	}
+
+	return false
+}
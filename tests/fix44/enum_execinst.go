@@ -2,46 +2,192 @@
 
 package fix44
 
-// Enum type EnumExecInst
+import (
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// EnumExecInst is the type of the ExecInst(18) field values.
+type EnumExecInst string
+
 const (
-	EnumExecInstStayoffer            string = "0"
-	EnumExecInstNotheld              string = "1"
-	EnumExecInstWork                 string = "2"
-	EnumExecInstGoalong              string = "3"
-	EnumExecInstOverday              string = "4"
-	EnumExecInstHeld                 string = "5"
-	EnumExecInstPartnotinit          string = "6"
-	EnumExecInstStrictscale          string = "7"
-	EnumExecInstTrytoscale           string = "8"
-	EnumExecInstStaybid              string = "9"
-	EnumExecInstNocross              string = "A"
-	EnumExecInstTrailstoppeg         string = "a"
-	EnumExecInstOkcross              string = "B"
-	EnumExecInstStrictlimit          string = "b"
-	EnumExecInstIgnorepricechk       string = "c"
-	EnumExecInstCallfirst            string = "C"
-	EnumExecInstPegtolimit           string = "d"
-	EnumExecInstPercvol              string = "D"
-	EnumExecInstDni                  string = "E"
-	EnumExecInstWorktostrategy       string = "e"
-	EnumExecInstDnr                  string = "F"
-	EnumExecInstAon                  string = "G"
-	EnumExecInstRestateonsysfail     string = "H"
-	EnumExecInstInstitonly           string = "I"
-	EnumExecInstRestateontradinghalt string = "J"
-	EnumExecInstCancelontradinghalt  string = "K"
-	EnumExecInstLastpeg              string = "L"
-	EnumExecInstMidprcpeg            string = "M"
-	EnumExecInstNonnego              string = "N"
-	EnumExecInstOpenpeg              string = "O"
-	EnumExecInstMarkpeg              string = "P"
-	EnumExecInstCancelonsysfail      string = "Q"
-	EnumExecInstPrimpeg              string = "R"
-	EnumExecInstSuspend              string = "S"
-	EnumExecInstCustdispinst         string = "U"
-	EnumExecInstNetting              string = "V"
-	EnumExecInstPegvwap              string = "W"
-	EnumExecInstTradealong           string = "X"
-	EnumExecInstTrytostop            string = "Y"
-	EnumExecInstCxlifnotbest         string = "Z"
+	EnumExecInstStayoffer            EnumExecInst = "0"
+	EnumExecInstNotheld              EnumExecInst = "1"
+	EnumExecInstWork                 EnumExecInst = "2"
+	EnumExecInstGoalong              EnumExecInst = "3"
+	EnumExecInstOverday              EnumExecInst = "4"
+	EnumExecInstHeld                 EnumExecInst = "5"
+	EnumExecInstPartnotinit          EnumExecInst = "6"
+	EnumExecInstStrictscale          EnumExecInst = "7"
+	EnumExecInstTrytoscale           EnumExecInst = "8"
+	EnumExecInstStaybid              EnumExecInst = "9"
+	EnumExecInstNocross              EnumExecInst = "A"
+	EnumExecInstTrailstoppeg         EnumExecInst = "a"
+	EnumExecInstOkcross              EnumExecInst = "B"
+	EnumExecInstStrictlimit          EnumExecInst = "b"
+	EnumExecInstIgnorepricechk       EnumExecInst = "c"
+	EnumExecInstCallfirst            EnumExecInst = "C"
+	EnumExecInstPegtolimit           EnumExecInst = "d"
+	EnumExecInstPercvol              EnumExecInst = "D"
+	EnumExecInstDni                  EnumExecInst = "E"
+	EnumExecInstWorktostrategy       EnumExecInst = "e"
+	EnumExecInstDnr                  EnumExecInst = "F"
+	EnumExecInstAon                  EnumExecInst = "G"
+	EnumExecInstRestateonsysfail     EnumExecInst = "H"
+	EnumExecInstInstitonly           EnumExecInst = "I"
+	EnumExecInstRestateontradinghalt EnumExecInst = "J"
+	EnumExecInstCancelontradinghalt  EnumExecInst = "K"
+	EnumExecInstLastpeg              EnumExecInst = "L"
+	EnumExecInstMidprcpeg            EnumExecInst = "M"
+	EnumExecInstNonnego              EnumExecInst = "N"
+	EnumExecInstOpenpeg              EnumExecInst = "O"
+	EnumExecInstMarkpeg              EnumExecInst = "P"
+	EnumExecInstCancelonsysfail      EnumExecInst = "Q"
+	EnumExecInstPrimpeg              EnumExecInst = "R"
+	EnumExecInstSuspend              EnumExecInst = "S"
+	EnumExecInstCustdispinst         EnumExecInst = "U"
+	EnumExecInstNetting              EnumExecInst = "V"
+	EnumExecInstPegvwap              EnumExecInst = "W"
+	EnumExecInstTradealong           EnumExecInst = "X"
+	EnumExecInstTrytostop            EnumExecInst = "Y"
+	EnumExecInstCxlifnotbest         EnumExecInst = "Z"
 )
+
+// String returns the description of the value.
+func (v EnumExecInst) String() string {
+	switch v {
+	case EnumExecInstStayoffer:
+		return "STAYOFFER"
+	case EnumExecInstNotheld:
+		return "NOTHELD"
+	case EnumExecInstWork:
+		return "WORK"
+	case EnumExecInstGoalong:
+		return "GOALONG"
+	case EnumExecInstOverday:
+		return "OVERDAY"
+	case EnumExecInstHeld:
+		return "HELD"
+	case EnumExecInstPartnotinit:
+		return "PARTNOTINIT"
+	case EnumExecInstStrictscale:
+		return "STRICTSCALE"
+	case EnumExecInstTrytoscale:
+		return "TRYTOSCALE"
+	case EnumExecInstStaybid:
+		return "STAYBID"
+	case EnumExecInstNocross:
+		return "NOCROSS"
+	case EnumExecInstTrailstoppeg:
+		return "TRAILSTOPPEG"
+	case EnumExecInstOkcross:
+		return "OKCROSS"
+	case EnumExecInstStrictlimit:
+		return "STRICTLIMIT"
+	case EnumExecInstIgnorepricechk:
+		return "IGNOREPRICECHK"
+	case EnumExecInstCallfirst:
+		return "CALLFIRST"
+	case EnumExecInstPegtolimit:
+		return "PEGTOLIMIT"
+	case EnumExecInstPercvol:
+		return "PERCVOL"
+	case EnumExecInstDni:
+		return "DNI"
+	case EnumExecInstWorktostrategy:
+		return "WORKTOSTRATEGY"
+	case EnumExecInstDnr:
+		return "DNR"
+	case EnumExecInstAon:
+		return "AON"
+	case EnumExecInstRestateonsysfail:
+		return "RESTATEONSYSFAIL"
+	case EnumExecInstInstitonly:
+		return "INSTITONLY"
+	case EnumExecInstRestateontradinghalt:
+		return "RESTATEONTRADINGHALT"
+	case EnumExecInstCancelontradinghalt:
+		return "CANCELONTRADINGHALT"
+	case EnumExecInstLastpeg:
+		return "LASTPEG"
+	case EnumExecInstMidprcpeg:
+		return "MIDPRCPEG"
+	case EnumExecInstNonnego:
+		return "NONNEGO"
+	case EnumExecInstOpenpeg:
+		return "OPENPEG"
+	case EnumExecInstMarkpeg:
+		return "MARKPEG"
+	case EnumExecInstCancelonsysfail:
+		return "CANCELONSYSFAIL"
+	case EnumExecInstPrimpeg:
+		return "PRIMPEG"
+	case EnumExecInstSuspend:
+		return "SUSPEND"
+	case EnumExecInstCustdispinst:
+		return "CUSTDISPINST"
+	case EnumExecInstNetting:
+		return "NETTING"
+	case EnumExecInstPegvwap:
+		return "PEGVWAP"
+	case EnumExecInstTradealong:
+		return "TRADEALONG"
+	case EnumExecInstTrytostop:
+		return "TRYTOSTOP"
+	case EnumExecInstCxlifnotbest:
+		return "CXLIFNOTBEST"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+// The value is a space-separated list, so each of its items is checked.
+func (v EnumExecInst) IsValid() bool {
+	return fix.IsValidMultipleValue(string(v), func(value string) bool {
+		switch EnumExecInst(value) {
+		case EnumExecInstStayoffer,
+			EnumExecInstNotheld,
+			EnumExecInstWork,
+			EnumExecInstGoalong,
+			EnumExecInstOverday,
+			EnumExecInstHeld,
+			EnumExecInstPartnotinit,
+			EnumExecInstStrictscale,
+			EnumExecInstTrytoscale,
+			EnumExecInstStaybid,
+			EnumExecInstNocross,
+			EnumExecInstTrailstoppeg,
+			EnumExecInstOkcross,
+			EnumExecInstStrictlimit,
+			EnumExecInstIgnorepricechk,
+			EnumExecInstCallfirst,
+			EnumExecInstPegtolimit,
+			EnumExecInstPercvol,
+			EnumExecInstDni,
+			EnumExecInstWorktostrategy,
+			EnumExecInstDnr,
+			EnumExecInstAon,
+			EnumExecInstRestateonsysfail,
+			EnumExecInstInstitonly,
+			EnumExecInstRestateontradinghalt,
+			EnumExecInstCancelontradinghalt,
+			EnumExecInstLastpeg,
+			EnumExecInstMidprcpeg,
+			EnumExecInstNonnego,
+			EnumExecInstOpenpeg,
+			EnumExecInstMarkpeg,
+			EnumExecInstCancelonsysfail,
+			EnumExecInstPrimpeg,
+			EnumExecInstSuspend,
+			EnumExecInstCustdispinst,
+			EnumExecInstNetting,
+			EnumExecInstPegvwap,
+			EnumExecInstTradealong,
+			EnumExecInstTrytostop,
+			EnumExecInstCxlifnotbest:
+			return true
+		}
+
+		return false
+	})
+}
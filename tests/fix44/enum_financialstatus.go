@@ -2,8 +2,40 @@
 
 package fix44
 
-// Enum type EnumFinancialStatus
+import (
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// EnumFinancialStatus is the type of the FinancialStatus(291) field values.
+type EnumFinancialStatus string
+
 const (
-	EnumFinancialStatusBankrupt         string = "1"
-	EnumFinancialStatusPendingdelisting string = "2"
+	EnumFinancialStatusBankrupt         EnumFinancialStatus = "1"
+	EnumFinancialStatusPendingdelisting EnumFinancialStatus = "2"
 )
+
+// String returns the description of the value.
+func (v EnumFinancialStatus) String() string {
+	switch v {
+	case EnumFinancialStatusBankrupt:
+		return "BANKRUPT"
+	case EnumFinancialStatusPendingdelisting:
+		return "PENDINGDELISTING"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+// The value is a space-separated list, so each of its items is checked.
+func (v EnumFinancialStatus) IsValid() bool {
+	return fix.IsValidMultipleValue(string(v), func(value string) bool {
+		switch EnumFinancialStatus(value) {
+		case EnumFinancialStatusBankrupt,
+			EnumFinancialStatusPendingdelisting:
+			return true
+		}
+
+		return false
+	})
+}
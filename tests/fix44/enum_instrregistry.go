@@ -2,9 +2,37 @@
 
 package fix44
 
-// Enum type EnumInstrRegistry
+// EnumInstrRegistry is the type of the InstrRegistry(543) field values.
+type EnumInstrRegistry string
+
 const (
-	EnumInstrRegistryCustodian string = "BIC"
-	EnumInstrRegistryCountry   string = "ISO"
-	EnumInstrRegistryPhysical  string = "ZZ"
+	EnumInstrRegistryCustodian EnumInstrRegistry = "BIC"
+	EnumInstrRegistryCountry   EnumInstrRegistry = "ISO"
+	EnumInstrRegistryPhysical  EnumInstrRegistry = "ZZ"
 )
+
+// String returns the description of the value.
+func (v EnumInstrRegistry) String() string {
+	switch v {
+	case EnumInstrRegistryCustodian:
+		return "CUSTODIAN"
+	case EnumInstrRegistryCountry:
+		return "COUNTRY"
+	case EnumInstrRegistryPhysical:
+		return "PHYSICAL"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumInstrRegistry) IsValid() bool {
+	switch v {
+	case EnumInstrRegistryCustodian,
+		EnumInstrRegistryCountry,
+		EnumInstrRegistryPhysical:
+		return true
+	}
+
+	return false
+}
@@ -2,19 +2,77 @@
 
 package fix44
 
-// Enum type EnumMDEntryType
+// EnumMDEntryType is the type of the MDEntryType(269) field values.
+type EnumMDEntryType string
+
 const (
-	EnumMDEntryTypeBid          string = "0"
-	EnumMDEntryTypeOffer        string = "1"
-	EnumMDEntryTypeTrade        string = "2"
-	EnumMDEntryTypeIndexvalue   string = "3"
-	EnumMDEntryTypeOpening      string = "4"
-	EnumMDEntryTypeClosing      string = "5"
-	EnumMDEntryTypeSettlement   string = "6"
-	EnumMDEntryTypeTradinghigh  string = "7"
-	EnumMDEntryTypeTradinglow   string = "8"
-	EnumMDEntryTypeTradingvwap  string = "9"
-	EnumMDEntryTypeImbalance    string = "A"
-	EnumMDEntryTypeTradevolume  string = "B"
-	EnumMDEntryTypeOpeninterest string = "C"
+	EnumMDEntryTypeBid          EnumMDEntryType = "0"
+	EnumMDEntryTypeOffer        EnumMDEntryType = "1"
+	EnumMDEntryTypeTrade        EnumMDEntryType = "2"
+	EnumMDEntryTypeIndexvalue   EnumMDEntryType = "3"
+	EnumMDEntryTypeOpening      EnumMDEntryType = "4"
+	EnumMDEntryTypeClosing      EnumMDEntryType = "5"
+	EnumMDEntryTypeSettlement   EnumMDEntryType = "6"
+	EnumMDEntryTypeTradinghigh  EnumMDEntryType = "7"
+	EnumMDEntryTypeTradinglow   EnumMDEntryType = "8"
+	EnumMDEntryTypeTradingvwap  EnumMDEntryType = "9"
+	EnumMDEntryTypeImbalance    EnumMDEntryType = "A"
+	EnumMDEntryTypeTradevolume  EnumMDEntryType = "B"
+	EnumMDEntryTypeOpeninterest EnumMDEntryType = "C"
 )
+
+// String returns the description of the value.
+func (v EnumMDEntryType) String() string {
+	switch v {
+	case EnumMDEntryTypeBid:
+		return "BID"
+	case EnumMDEntryTypeOffer:
+		return "OFFER"
+	case EnumMDEntryTypeTrade:
+		return "TRADE"
+	case EnumMDEntryTypeIndexvalue:
+		return "INDEXVALUE"
+	case EnumMDEntryTypeOpening:
+		return "OPENING"
+	case EnumMDEntryTypeClosing:
+		return "CLOSING"
+	case EnumMDEntryTypeSettlement:
+		return "SETTLEMENT"
+	case EnumMDEntryTypeTradinghigh:
+		return "TRADINGHIGH"
+	case EnumMDEntryTypeTradinglow:
+		return "TRADINGLOW"
+	case EnumMDEntryTypeTradingvwap:
+		return "TRADINGVWAP"
+	case EnumMDEntryTypeImbalance:
+		return "IMBALANCE"
+	case EnumMDEntryTypeTradevolume:
+		return "TRADEVOLUME"
+	case EnumMDEntryTypeOpeninterest:
+		return "OPENINTEREST"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumMDEntryType) IsValid() bool {
+	switch v {
+	case EnumMDEntryTypeBid,
+		EnumMDEntryTypeOffer,
+		EnumMDEntryTypeTrade,
+		EnumMDEntryTypeIndexvalue,
+		EnumMDEntryTypeOpening,
+		EnumMDEntryTypeClosing,
+		EnumMDEntryTypeSettlement,
+		EnumMDEntryTypeTradinghigh,
+		EnumMDEntryTypeTradinglow,
+		EnumMDEntryTypeTradingvwap,
+		EnumMDEntryTypeImbalance,
+		EnumMDEntryTypeTradevolume,
+		EnumMDEntryTypeOpeninterest:
+		return true
+	}
+
+	return false
+}
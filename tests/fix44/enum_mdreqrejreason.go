@@ -2,19 +2,77 @@
 
 package fix44
 
-// Enum type EnumMDReqRejReason
+// EnumMDReqRejReason is the type of the MDReqRejReason(281) field values.
+type EnumMDReqRejReason string
+
 const (
-	EnumMDReqRejReasonUnknownsym                     string = "0"
-	EnumMDReqRejReasonDupid                          string = "1"
-	EnumMDReqRejReasonInsband                        string = "2"
-	EnumMDReqRejReasonInsperm                        string = "3"
-	EnumMDReqRejReasonUnsuppsub                      string = "4"
-	EnumMDReqRejReasonUnsuppmktdepth                 string = "5"
-	EnumMDReqRejReasonUnsuppmdupdate                 string = "6"
-	EnumMDReqRejReasonUnsuppaggbk                    string = "7"
-	EnumMDReqRejReasonUnsuppentry                    string = "8"
-	EnumMDReqRejReasonUnsupptrdsessionid             string = "9"
-	EnumMDReqRejReasonUnsuppscope                    string = "A"
-	EnumMDReqRejReasonUnsupppositioneffectsettleflag string = "B"
-	EnumMDReqRejReasonUnsuppmdimplicitdelete         string = "C"
+	EnumMDReqRejReasonUnknownsym                     EnumMDReqRejReason = "0"
+	EnumMDReqRejReasonDupid                          EnumMDReqRejReason = "1"
+	EnumMDReqRejReasonInsband                        EnumMDReqRejReason = "2"
+	EnumMDReqRejReasonInsperm                        EnumMDReqRejReason = "3"
+	EnumMDReqRejReasonUnsuppsub                      EnumMDReqRejReason = "4"
+	EnumMDReqRejReasonUnsuppmktdepth                 EnumMDReqRejReason = "5"
+	EnumMDReqRejReasonUnsuppmdupdate                 EnumMDReqRejReason = "6"
+	EnumMDReqRejReasonUnsuppaggbk                    EnumMDReqRejReason = "7"
+	EnumMDReqRejReasonUnsuppentry                    EnumMDReqRejReason = "8"
+	EnumMDReqRejReasonUnsupptrdsessionid             EnumMDReqRejReason = "9"
+	EnumMDReqRejReasonUnsuppscope                    EnumMDReqRejReason = "A"
+	EnumMDReqRejReasonUnsupppositioneffectsettleflag EnumMDReqRejReason = "B"
+	EnumMDReqRejReasonUnsuppmdimplicitdelete         EnumMDReqRejReason = "C"
 )
+
+// String returns the description of the value.
+func (v EnumMDReqRejReason) String() string {
+	switch v {
+	case EnumMDReqRejReasonUnknownsym:
+		return "UNKNOWNSYM"
+	case EnumMDReqRejReasonDupid:
+		return "DUPID"
+	case EnumMDReqRejReasonInsband:
+		return "INSBAND"
+	case EnumMDReqRejReasonInsperm:
+		return "INSPERM"
+	case EnumMDReqRejReasonUnsuppsub:
+		return "UNSUPPSUB"
+	case EnumMDReqRejReasonUnsuppmktdepth:
+		return "UNSUPPMKTDEPTH"
+	case EnumMDReqRejReasonUnsuppmdupdate:
+		return "UNSUPPMDUPDATE"
+	case EnumMDReqRejReasonUnsuppaggbk:
+		return "UNSUPPAGGBK"
+	case EnumMDReqRejReasonUnsuppentry:
+		return "UNSUPPENTRY"
+	case EnumMDReqRejReasonUnsupptrdsessionid:
+		return "UNSUPPTRDSESSIONID"
+	case EnumMDReqRejReasonUnsuppscope:
+		return "UNSUPPSCOPE"
+	case EnumMDReqRejReasonUnsupppositioneffectsettleflag:
+		return "UNSUPPPOSITIONEFFECTSETTLEFLAG"
+	case EnumMDReqRejReasonUnsuppmdimplicitdelete:
+		return "UNSUPPMDIMPLICITDELETE"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumMDReqRejReason) IsValid() bool {
+	switch v {
+	case EnumMDReqRejReasonUnknownsym,
+		EnumMDReqRejReasonDupid,
+		EnumMDReqRejReasonInsband,
+		EnumMDReqRejReasonInsperm,
+		EnumMDReqRejReasonUnsuppsub,
+		EnumMDReqRejReasonUnsuppmktdepth,
+		EnumMDReqRejReasonUnsuppmdupdate,
+		EnumMDReqRejReasonUnsuppaggbk,
+		EnumMDReqRejReasonUnsuppentry,
+		EnumMDReqRejReasonUnsupptrdsessionid,
+		EnumMDReqRejReasonUnsuppscope,
+		EnumMDReqRejReasonUnsupppositioneffectsettleflag,
+		EnumMDReqRejReasonUnsuppmdimplicitdelete:
+		return true
+	}
+
+	return false
+}
@@ -2,9 +2,37 @@
 
 package fix44
 
-// Enum type EnumMDUpdateAction
+// EnumMDUpdateAction is the type of the MDUpdateAction(279) field values.
+type EnumMDUpdateAction string
+
 const (
-	EnumMDUpdateActionNew    string = "0"
-	EnumMDUpdateActionChange string = "1"
-	EnumMDUpdateActionDelete string = "2"
+	EnumMDUpdateActionNew    EnumMDUpdateAction = "0"
+	EnumMDUpdateActionChange EnumMDUpdateAction = "1"
+	EnumMDUpdateActionDelete EnumMDUpdateAction = "2"
 )
+
+// String returns the description of the value.
+func (v EnumMDUpdateAction) String() string {
+	switch v {
+	case EnumMDUpdateActionNew:
+		return "NEW"
+	case EnumMDUpdateActionChange:
+		return "CHANGE"
+	case EnumMDUpdateActionDelete:
+		return "DELETE"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumMDUpdateAction) IsValid() bool {
+	switch v {
+	case EnumMDUpdateActionNew,
+		EnumMDUpdateActionChange,
+		EnumMDUpdateActionDelete:
+		return true
+	}
+
+	return false
+}
@@ -2,8 +2,33 @@
 
 package fix44
 
-// Enum type EnumMDUpdateType
+// EnumMDUpdateType is the type of the MDUpdateType(265) field values.
+type EnumMDUpdateType string
+
 const (
-	EnumMDUpdateTypeFull        string = "0"
-	EnumMDUpdateTypeIncremental string = "1"
+	EnumMDUpdateTypeFull        EnumMDUpdateType = "0"
+	EnumMDUpdateTypeIncremental EnumMDUpdateType = "1"
 )
+
+// String returns the description of the value.
+func (v EnumMDUpdateType) String() string {
+	switch v {
+	case EnumMDUpdateTypeFull:
+		return "FULL"
+	case EnumMDUpdateTypeIncremental:
+		return "INCREMENTAL"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumMDUpdateType) IsValid() bool {
+	switch v {
+	case EnumMDUpdateTypeFull,
+		EnumMDUpdateTypeIncremental:
+		return true
+	}
+
+	return false
+}
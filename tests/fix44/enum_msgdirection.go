@@ -2,8 +2,33 @@
 
 package fix44
 
-// Enum type EnumMsgDirection
+// EnumMsgDirection is the type of the MsgDirection(385) field values.
+type EnumMsgDirection string
+
 const (
-	EnumMsgDirectionReceive string = "R"
-	EnumMsgDirectionSend    string = "S"
+	EnumMsgDirectionReceive EnumMsgDirection = "R"
+	EnumMsgDirectionSend    EnumMsgDirection = "S"
 )
+
+// String returns the description of the value.
+func (v EnumMsgDirection) String() string {
+	switch v {
+	case EnumMsgDirectionReceive:
+		return "RECEIVE"
+	case EnumMsgDirectionSend:
+		return "SEND"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumMsgDirection) IsValid() bool {
+	switch v {
+	case EnumMsgDirectionReceive,
+		EnumMsgDirectionSend:
+		return true
+	}
+
+	return false
+}
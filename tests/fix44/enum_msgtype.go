@@ -2,99 +2,397 @@
 
 package fix44
 
-// Enum type EnumMsgType
+// EnumMsgType is the type of the MsgType(35) field values.
+type EnumMsgType string
+
 const (
-	EnumMsgTypeHeartbeat                               string = "0"
-	EnumMsgTypeTestrequest                             string = "1"
-	EnumMsgTypeResendrequest                           string = "2"
-	EnumMsgTypeReject                                  string = "3"
-	EnumMsgTypeSequencereset                           string = "4"
-	EnumMsgTypeLogout                                  string = "5"
-	EnumMsgTypeIoi                                     string = "6"
-	EnumMsgTypeAdvertisement                           string = "7"
-	EnumMsgTypeExecutionreport                         string = "8"
-	EnumMsgTypeOrdercancelreject                       string = "9"
-	EnumMsgTypeQuotestatusrequest                      string = "a"
-	EnumMsgTypeLogon                                   string = "A"
-	EnumMsgTypeDerivativesecuritylist                  string = "AA"
-	EnumMsgTypeNewordermultileg                        string = "AB"
-	EnumMsgTypeMultilegordercancelreplace              string = "AC"
-	EnumMsgTypeTradecapturereportrequest               string = "AD"
-	EnumMsgTypeTradecapturereport                      string = "AE"
-	EnumMsgTypeOrdermassstatusrequest                  string = "AF"
-	EnumMsgTypeQuoterequestreject                      string = "AG"
-	EnumMsgTypeRfqrequest                              string = "AH"
-	EnumMsgTypeQuotestatusreport                       string = "AI"
-	EnumMsgTypeQuoteresponse                           string = "AJ"
-	EnumMsgTypeConfirmation                            string = "AK"
-	EnumMsgTypePositionmaintenancerequest              string = "AL"
-	EnumMsgTypePositionmaintenancereport               string = "AM"
-	EnumMsgTypeRequestforpositions                     string = "AN"
-	EnumMsgTypeRequestforpositionsack                  string = "AO"
-	EnumMsgTypePositionreport                          string = "AP"
-	EnumMsgTypeTradecapturereportrequestack            string = "AQ"
-	EnumMsgTypeTradecapturereportack                   string = "AR"
-	EnumMsgTypeAllocationreport                        string = "AS"
-	EnumMsgTypeAllocationreportack                     string = "AT"
-	EnumMsgTypeConfirmationack                         string = "AU"
-	EnumMsgTypeSettlementinstructionrequest            string = "AV"
-	EnumMsgTypeAssignmentreport                        string = "AW"
-	EnumMsgTypeCollateralrequest                       string = "AX"
-	EnumMsgTypeCollateralassignment                    string = "AY"
-	EnumMsgTypeCollateralresponse                      string = "AZ"
-	EnumMsgTypeNews                                    string = "B"
-	EnumMsgTypeMassquoteacknowledgement                string = "b"
-	EnumMsgTypeCollateralreport                        string = "BA"
-	EnumMsgTypeCollateralinquiry                       string = "BB"
-	EnumMsgTypeNetworkcounterpartysystemstatusrequest  string = "BC"
-	EnumMsgTypeNetworkcounterpartysystemstatusresponse string = "BD"
-	EnumMsgTypeUserrequest                             string = "BE"
-	EnumMsgTypeUserresponse                            string = "BF"
-	EnumMsgTypeCollateralinquiryack                    string = "BG"
-	EnumMsgTypeConfirmationrequest                     string = "BH"
-	EnumMsgTypeEmail                                   string = "C"
-	EnumMsgTypeSecuritydefinitionrequest               string = "c"
-	EnumMsgTypeSecuritydefinition                      string = "d"
-	EnumMsgTypeNewordersingle                          string = "D"
-	EnumMsgTypeSecuritystatusrequest                   string = "e"
-	EnumMsgTypeNeworderlist                            string = "E"
-	EnumMsgTypeOrdercancelrequest                      string = "F"
-	EnumMsgTypeSecuritystatus                          string = "f"
-	EnumMsgTypeOrdercancelreplacerequest               string = "G"
-	EnumMsgTypeTradingsessionstatusrequest             string = "g"
-	EnumMsgTypeOrderstatusrequest                      string = "H"
-	EnumMsgTypeTradingsessionstatus                    string = "h"
-	EnumMsgTypeMassquote                               string = "i"
-	EnumMsgTypeBusinessmessagereject                   string = "j"
-	EnumMsgTypeAllocationinstruction                   string = "J"
-	EnumMsgTypeBidrequest                              string = "k"
-	EnumMsgTypeListcancelrequest                       string = "K"
-	EnumMsgTypeBidresponse                             string = "l"
-	EnumMsgTypeListexecute                             string = "L"
-	EnumMsgTypeListstrikeprice                         string = "m"
-	EnumMsgTypeListstatusrequest                       string = "M"
-	EnumMsgTypeXmlnonfix                               string = "n"
-	EnumMsgTypeListstatus                              string = "N"
-	EnumMsgTypeRegistrationinstructions                string = "o"
-	EnumMsgTypeRegistrationinstructionsresponse        string = "p"
-	EnumMsgTypeAllocationinstructionack                string = "P"
-	EnumMsgTypeOrdermasscancelrequest                  string = "q"
-	EnumMsgTypeDontknowtradedk                         string = "Q"
-	EnumMsgTypeQuoterequest                            string = "R"
-	EnumMsgTypeOrdermasscancelreport                   string = "r"
-	EnumMsgTypeQuote                                   string = "S"
-	EnumMsgTypeNewordercross                           string = "s"
-	EnumMsgTypeSettlementinstructions                  string = "T"
-	EnumMsgTypeCrossordercancelreplacerequest          string = "t"
-	EnumMsgTypeCrossordercancelrequest                 string = "u"
-	EnumMsgTypeMarketdatarequest                       string = "V"
-	EnumMsgTypeSecuritytyperequest                     string = "v"
-	EnumMsgTypeSecuritytypes                           string = "w"
-	EnumMsgTypeMarketdatasnapshotfullrefresh           string = "W"
-	EnumMsgTypeSecuritylistrequest                     string = "x"
-	EnumMsgTypeMarketdataincrementalrefresh            string = "X"
-	EnumMsgTypeMarketdatarequestreject                 string = "Y"
-	EnumMsgTypeSecuritylist                            string = "y"
-	EnumMsgTypeQuotecancel                             string = "Z"
-	EnumMsgTypeDerivativesecuritylistrequest           string = "z"
+	EnumMsgTypeHeartbeat                               EnumMsgType = "0"
+	EnumMsgTypeTestrequest                             EnumMsgType = "1"
+	EnumMsgTypeResendrequest                           EnumMsgType = "2"
+	EnumMsgTypeReject                                  EnumMsgType = "3"
+	EnumMsgTypeSequencereset                           EnumMsgType = "4"
+	EnumMsgTypeLogout                                  EnumMsgType = "5"
+	EnumMsgTypeIoi                                     EnumMsgType = "6"
+	EnumMsgTypeAdvertisement                           EnumMsgType = "7"
+	EnumMsgTypeExecutionreport                         EnumMsgType = "8"
+	EnumMsgTypeOrdercancelreject                       EnumMsgType = "9"
+	EnumMsgTypeQuotestatusrequest                      EnumMsgType = "a"
+	EnumMsgTypeLogon                                   EnumMsgType = "A"
+	EnumMsgTypeDerivativesecuritylist                  EnumMsgType = "AA"
+	EnumMsgTypeNewordermultileg                        EnumMsgType = "AB"
+	EnumMsgTypeMultilegordercancelreplace              EnumMsgType = "AC"
+	EnumMsgTypeTradecapturereportrequest               EnumMsgType = "AD"
+	EnumMsgTypeTradecapturereport                      EnumMsgType = "AE"
+	EnumMsgTypeOrdermassstatusrequest                  EnumMsgType = "AF"
+	EnumMsgTypeQuoterequestreject                      EnumMsgType = "AG"
+	EnumMsgTypeRfqrequest                              EnumMsgType = "AH"
+	EnumMsgTypeQuotestatusreport                       EnumMsgType = "AI"
+	EnumMsgTypeQuoteresponse                           EnumMsgType = "AJ"
+	EnumMsgTypeConfirmation                            EnumMsgType = "AK"
+	EnumMsgTypePositionmaintenancerequest              EnumMsgType = "AL"
+	EnumMsgTypePositionmaintenancereport               EnumMsgType = "AM"
+	EnumMsgTypeRequestforpositions                     EnumMsgType = "AN"
+	EnumMsgTypeRequestforpositionsack                  EnumMsgType = "AO"
+	EnumMsgTypePositionreport                          EnumMsgType = "AP"
+	EnumMsgTypeTradecapturereportrequestack            EnumMsgType = "AQ"
+	EnumMsgTypeTradecapturereportack                   EnumMsgType = "AR"
+	EnumMsgTypeAllocationreport                        EnumMsgType = "AS"
+	EnumMsgTypeAllocationreportack                     EnumMsgType = "AT"
+	EnumMsgTypeConfirmationack                         EnumMsgType = "AU"
+	EnumMsgTypeSettlementinstructionrequest            EnumMsgType = "AV"
+	EnumMsgTypeAssignmentreport                        EnumMsgType = "AW"
+	EnumMsgTypeCollateralrequest                       EnumMsgType = "AX"
+	EnumMsgTypeCollateralassignment                    EnumMsgType = "AY"
+	EnumMsgTypeCollateralresponse                      EnumMsgType = "AZ"
+	EnumMsgTypeNews                                    EnumMsgType = "B"
+	EnumMsgTypeMassquoteacknowledgement                EnumMsgType = "b"
+	EnumMsgTypeCollateralreport                        EnumMsgType = "BA"
+	EnumMsgTypeCollateralinquiry                       EnumMsgType = "BB"
+	EnumMsgTypeNetworkcounterpartysystemstatusrequest  EnumMsgType = "BC"
+	EnumMsgTypeNetworkcounterpartysystemstatusresponse EnumMsgType = "BD"
+	EnumMsgTypeUserrequest                             EnumMsgType = "BE"
+	EnumMsgTypeUserresponse                            EnumMsgType = "BF"
+	EnumMsgTypeCollateralinquiryack                    EnumMsgType = "BG"
+	EnumMsgTypeConfirmationrequest                     EnumMsgType = "BH"
+	EnumMsgTypeEmail                                   EnumMsgType = "C"
+	EnumMsgTypeSecuritydefinitionrequest               EnumMsgType = "c"
+	EnumMsgTypeSecuritydefinition                      EnumMsgType = "d"
+	EnumMsgTypeNewordersingle                          EnumMsgType = "D"
+	EnumMsgTypeSecuritystatusrequest                   EnumMsgType = "e"
+	EnumMsgTypeNeworderlist                            EnumMsgType = "E"
+	EnumMsgTypeOrdercancelrequest                      EnumMsgType = "F"
+	EnumMsgTypeSecuritystatus                          EnumMsgType = "f"
+	EnumMsgTypeOrdercancelreplacerequest               EnumMsgType = "G"
+	EnumMsgTypeTradingsessionstatusrequest             EnumMsgType = "g"
+	EnumMsgTypeOrderstatusrequest                      EnumMsgType = "H"
+	EnumMsgTypeTradingsessionstatus                    EnumMsgType = "h"
+	EnumMsgTypeMassquote                               EnumMsgType = "i"
+	EnumMsgTypeBusinessmessagereject                   EnumMsgType = "j"
+	EnumMsgTypeAllocationinstruction                   EnumMsgType = "J"
+	EnumMsgTypeBidrequest                              EnumMsgType = "k"
+	EnumMsgTypeListcancelrequest                       EnumMsgType = "K"
+	EnumMsgTypeBidresponse                             EnumMsgType = "l"
+	EnumMsgTypeListexecute                             EnumMsgType = "L"
+	EnumMsgTypeListstrikeprice                         EnumMsgType = "m"
+	EnumMsgTypeListstatusrequest                       EnumMsgType = "M"
+	EnumMsgTypeXmlnonfix                               EnumMsgType = "n"
+	EnumMsgTypeListstatus                              EnumMsgType = "N"
+	EnumMsgTypeRegistrationinstructions                EnumMsgType = "o"
+	EnumMsgTypeRegistrationinstructionsresponse        EnumMsgType = "p"
+	EnumMsgTypeAllocationinstructionack                EnumMsgType = "P"
+	EnumMsgTypeOrdermasscancelrequest                  EnumMsgType = "q"
+	EnumMsgTypeDontknowtradedk                         EnumMsgType = "Q"
+	EnumMsgTypeQuoterequest                            EnumMsgType = "R"
+	EnumMsgTypeOrdermasscancelreport                   EnumMsgType = "r"
+	EnumMsgTypeQuote                                   EnumMsgType = "S"
+	EnumMsgTypeNewordercross                           EnumMsgType = "s"
+	EnumMsgTypeSettlementinstructions                  EnumMsgType = "T"
+	EnumMsgTypeCrossordercancelreplacerequest          EnumMsgType = "t"
+	EnumMsgTypeCrossordercancelrequest                 EnumMsgType = "u"
+	EnumMsgTypeMarketdatarequest                       EnumMsgType = "V"
+	EnumMsgTypeSecuritytyperequest                     EnumMsgType = "v"
+	EnumMsgTypeSecuritytypes                           EnumMsgType = "w"
+	EnumMsgTypeMarketdatasnapshotfullrefresh           EnumMsgType = "W"
+	EnumMsgTypeSecuritylistrequest                     EnumMsgType = "x"
+	EnumMsgTypeMarketdataincrementalrefresh            EnumMsgType = "X"
+	EnumMsgTypeMarketdatarequestreject                 EnumMsgType = "Y"
+	EnumMsgTypeSecuritylist                            EnumMsgType = "y"
+	EnumMsgTypeQuotecancel                             EnumMsgType = "Z"
+	EnumMsgTypeDerivativesecuritylistrequest           EnumMsgType = "z"
 )
+
+// String returns the description of the value.
+func (v EnumMsgType) String() string {
+	switch v {
+	case EnumMsgTypeHeartbeat:
+		return "HEARTBEAT"
+	case EnumMsgTypeTestrequest:
+		return "TESTREQUEST"
+	case EnumMsgTypeResendrequest:
+		return "RESENDREQUEST"
+	case EnumMsgTypeReject:
+		return "REJECT"
+	case EnumMsgTypeSequencereset:
+		return "SEQUENCERESET"
+	case EnumMsgTypeLogout:
+		return "LOGOUT"
+	case EnumMsgTypeIoi:
+		return "IOI"
+	case EnumMsgTypeAdvertisement:
+		return "ADVERTISEMENT"
+	case EnumMsgTypeExecutionreport:
+		return "EXECUTIONREPORT"
+	case EnumMsgTypeOrdercancelreject:
+		return "ORDERCANCELREJECT"
+	case EnumMsgTypeQuotestatusrequest:
+		return "QUOTESTATUSREQUEST"
+	case EnumMsgTypeLogon:
+		return "LOGON"
+	case EnumMsgTypeDerivativesecuritylist:
+		return "DERIVATIVESECURITYLIST"
+	case EnumMsgTypeNewordermultileg:
+		return "NEWORDERMULTILEG"
+	case EnumMsgTypeMultilegordercancelreplace:
+		return "MULTILEGORDERCANCELREPLACE"
+	case EnumMsgTypeTradecapturereportrequest:
+		return "TRADECAPTUREREPORTREQUEST"
+	case EnumMsgTypeTradecapturereport:
+		return "TRADECAPTUREREPORT"
+	case EnumMsgTypeOrdermassstatusrequest:
+		return "ORDERMASSSTATUSREQUEST"
+	case EnumMsgTypeQuoterequestreject:
+		return "QUOTEREQUESTREJECT"
+	case EnumMsgTypeRfqrequest:
+		return "RFQREQUEST"
+	case EnumMsgTypeQuotestatusreport:
+		return "QUOTESTATUSREPORT"
+	case EnumMsgTypeQuoteresponse:
+		return "QUOTERESPONSE"
+	case EnumMsgTypeConfirmation:
+		return "CONFIRMATION"
+	case EnumMsgTypePositionmaintenancerequest:
+		return "POSITIONMAINTENANCEREQUEST"
+	case EnumMsgTypePositionmaintenancereport:
+		return "POSITIONMAINTENANCEREPORT"
+	case EnumMsgTypeRequestforpositions:
+		return "REQUESTFORPOSITIONS"
+	case EnumMsgTypeRequestforpositionsack:
+		return "REQUESTFORPOSITIONSACK"
+	case EnumMsgTypePositionreport:
+		return "POSITIONREPORT"
+	case EnumMsgTypeTradecapturereportrequestack:
+		return "TRADECAPTUREREPORTREQUESTACK"
+	case EnumMsgTypeTradecapturereportack:
+		return "TRADECAPTUREREPORTACK"
+	case EnumMsgTypeAllocationreport:
+		return "ALLOCATIONREPORT"
+	case EnumMsgTypeAllocationreportack:
+		return "ALLOCATIONREPORTACK"
+	case EnumMsgTypeConfirmationack:
+		return "CONFIRMATIONACK"
+	case EnumMsgTypeSettlementinstructionrequest:
+		return "SETTLEMENTINSTRUCTIONREQUEST"
+	case EnumMsgTypeAssignmentreport:
+		return "ASSIGNMENTREPORT"
+	case EnumMsgTypeCollateralrequest:
+		return "COLLATERALREQUEST"
+	case EnumMsgTypeCollateralassignment:
+		return "COLLATERALASSIGNMENT"
+	case EnumMsgTypeCollateralresponse:
+		return "COLLATERALRESPONSE"
+	case EnumMsgTypeNews:
+		return "NEWS"
+	case EnumMsgTypeMassquoteacknowledgement:
+		return "MASSQUOTEACKNOWLEDGEMENT"
+	case EnumMsgTypeCollateralreport:
+		return "COLLATERALREPORT"
+	case EnumMsgTypeCollateralinquiry:
+		return "COLLATERALINQUIRY"
+	case EnumMsgTypeNetworkcounterpartysystemstatusrequest:
+		return "NETWORKCOUNTERPARTYSYSTEMSTATUSREQUEST"
+	case EnumMsgTypeNetworkcounterpartysystemstatusresponse:
+		return "NETWORKCOUNTERPARTYSYSTEMSTATUSRESPONSE"
+	case EnumMsgTypeUserrequest:
+		return "USERREQUEST"
+	case EnumMsgTypeUserresponse:
+		return "USERRESPONSE"
+	case EnumMsgTypeCollateralinquiryack:
+		return "COLLATERALINQUIRYACK"
+	case EnumMsgTypeConfirmationrequest:
+		return "CONFIRMATIONREQUEST"
+	case EnumMsgTypeEmail:
+		return "EMAIL"
+	case EnumMsgTypeSecuritydefinitionrequest:
+		return "SECURITYDEFINITIONREQUEST"
+	case EnumMsgTypeSecuritydefinition:
+		return "SECURITYDEFINITION"
+	case EnumMsgTypeNewordersingle:
+		return "NEWORDERSINGLE"
+	case EnumMsgTypeSecuritystatusrequest:
+		return "SECURITYSTATUSREQUEST"
+	case EnumMsgTypeNeworderlist:
+		return "NEWORDERLIST"
+	case EnumMsgTypeOrdercancelrequest:
+		return "ORDERCANCELREQUEST"
+	case EnumMsgTypeSecuritystatus:
+		return "SECURITYSTATUS"
+	case EnumMsgTypeOrdercancelreplacerequest:
+		return "ORDERCANCELREPLACEREQUEST"
+	case EnumMsgTypeTradingsessionstatusrequest:
+		return "TRADINGSESSIONSTATUSREQUEST"
+	case EnumMsgTypeOrderstatusrequest:
+		return "ORDERSTATUSREQUEST"
+	case EnumMsgTypeTradingsessionstatus:
+		return "TRADINGSESSIONSTATUS"
+	case EnumMsgTypeMassquote:
+		return "MASSQUOTE"
+	case EnumMsgTypeBusinessmessagereject:
+		return "BUSINESSMESSAGEREJECT"
+	case EnumMsgTypeAllocationinstruction:
+		return "ALLOCATIONINSTRUCTION"
+	case EnumMsgTypeBidrequest:
+		return "BIDREQUEST"
+	case EnumMsgTypeListcancelrequest:
+		return "LISTCANCELREQUEST"
+	case EnumMsgTypeBidresponse:
+		return "BIDRESPONSE"
+	case EnumMsgTypeListexecute:
+		return "LISTEXECUTE"
+	case EnumMsgTypeListstrikeprice:
+		return "LISTSTRIKEPRICE"
+	case EnumMsgTypeListstatusrequest:
+		return "LISTSTATUSREQUEST"
+	case EnumMsgTypeXmlnonfix:
+		return "XMLNONFIX"
+	case EnumMsgTypeListstatus:
+		return "LISTSTATUS"
+	case EnumMsgTypeRegistrationinstructions:
+		return "REGISTRATIONINSTRUCTIONS"
+	case EnumMsgTypeRegistrationinstructionsresponse:
+		return "REGISTRATIONINSTRUCTIONSRESPONSE"
+	case EnumMsgTypeAllocationinstructionack:
+		return "ALLOCATIONINSTRUCTIONACK"
+	case EnumMsgTypeOrdermasscancelrequest:
+		return "ORDERMASSCANCELREQUEST"
+	case EnumMsgTypeDontknowtradedk:
+		return "DONTKNOWTRADEDK"
+	case EnumMsgTypeQuoterequest:
+		return "QUOTEREQUEST"
+	case EnumMsgTypeOrdermasscancelreport:
+		return "ORDERMASSCANCELREPORT"
+	case EnumMsgTypeQuote:
+		return "QUOTE"
+	case EnumMsgTypeNewordercross:
+		return "NEWORDERCROSS"
+	case EnumMsgTypeSettlementinstructions:
+		return "SETTLEMENTINSTRUCTIONS"
+	case EnumMsgTypeCrossordercancelreplacerequest:
+		return "CROSSORDERCANCELREPLACEREQUEST"
+	case EnumMsgTypeCrossordercancelrequest:
+		return "CROSSORDERCANCELREQUEST"
+	case EnumMsgTypeMarketdatarequest:
+		return "MARKETDATAREQUEST"
+	case EnumMsgTypeSecuritytyperequest:
+		return "SECURITYTYPEREQUEST"
+	case EnumMsgTypeSecuritytypes:
+		return "SECURITYTYPES"
+	case EnumMsgTypeMarketdatasnapshotfullrefresh:
+		return "MARKETDATASNAPSHOTFULLREFRESH"
+	case EnumMsgTypeSecuritylistrequest:
+		return "SECURITYLISTREQUEST"
+	case EnumMsgTypeMarketdataincrementalrefresh:
+		return "MARKETDATAINCREMENTALREFRESH"
+	case EnumMsgTypeMarketdatarequestreject:
+		return "MARKETDATAREQUESTREJECT"
+	case EnumMsgTypeSecuritylist:
+		return "SECURITYLIST"
+	case EnumMsgTypeQuotecancel:
+		return "QUOTECANCEL"
+	case EnumMsgTypeDerivativesecuritylistrequest:
+		return "DERIVATIVESECURITYLISTREQUEST"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumMsgType) IsValid() bool {
+	switch v {
+	case EnumMsgTypeHeartbeat,
+		EnumMsgTypeTestrequest,
+		EnumMsgTypeResendrequest,
+		EnumMsgTypeReject,
+		EnumMsgTypeSequencereset,
+		EnumMsgTypeLogout,
+		EnumMsgTypeIoi,
+		EnumMsgTypeAdvertisement,
+		EnumMsgTypeExecutionreport,
+		EnumMsgTypeOrdercancelreject,
+		EnumMsgTypeQuotestatusrequest,
+		EnumMsgTypeLogon,
+		EnumMsgTypeDerivativesecuritylist,
+		EnumMsgTypeNewordermultileg,
+		EnumMsgTypeMultilegordercancelreplace,
+		EnumMsgTypeTradecapturereportrequest,
+		EnumMsgTypeTradecapturereport,
+		EnumMsgTypeOrdermassstatusrequest,
+		EnumMsgTypeQuoterequestreject,
+		EnumMsgTypeRfqrequest,
+		EnumMsgTypeQuotestatusreport,
+		EnumMsgTypeQuoteresponse,
+		EnumMsgTypeConfirmation,
+		EnumMsgTypePositionmaintenancerequest,
+		EnumMsgTypePositionmaintenancereport,
+		EnumMsgTypeRequestforpositions,
+		EnumMsgTypeRequestforpositionsack,
+		EnumMsgTypePositionreport,
+		EnumMsgTypeTradecapturereportrequestack,
+		EnumMsgTypeTradecapturereportack,
+		EnumMsgTypeAllocationreport,
+		EnumMsgTypeAllocationreportack,
+		EnumMsgTypeConfirmationack,
+		EnumMsgTypeSettlementinstructionrequest,
+		EnumMsgTypeAssignmentreport,
+		EnumMsgTypeCollateralrequest,
+		EnumMsgTypeCollateralassignment,
+		EnumMsgTypeCollateralresponse,
+		EnumMsgTypeNews,
+		EnumMsgTypeMassquoteacknowledgement,
+		EnumMsgTypeCollateralreport,
+		EnumMsgTypeCollateralinquiry,
+		EnumMsgTypeNetworkcounterpartysystemstatusrequest,
+		EnumMsgTypeNetworkcounterpartysystemstatusresponse,
+		EnumMsgTypeUserrequest,
+		EnumMsgTypeUserresponse,
+		EnumMsgTypeCollateralinquiryack,
+		EnumMsgTypeConfirmationrequest,
+		EnumMsgTypeEmail,
+		EnumMsgTypeSecuritydefinitionrequest,
+		EnumMsgTypeSecuritydefinition,
+		EnumMsgTypeNewordersingle,
+		EnumMsgTypeSecuritystatusrequest,
+		EnumMsgTypeNeworderlist,
+		EnumMsgTypeOrdercancelrequest,
+		EnumMsgTypeSecuritystatus,
+		EnumMsgTypeOrdercancelreplacerequest,
+		EnumMsgTypeTradingsessionstatusrequest,
+		EnumMsgTypeOrderstatusrequest,
+		EnumMsgTypeTradingsessionstatus,
+		EnumMsgTypeMassquote,
+		EnumMsgTypeBusinessmessagereject,
+		EnumMsgTypeAllocationinstruction,
+		EnumMsgTypeBidrequest,
+		EnumMsgTypeListcancelrequest,
+		EnumMsgTypeBidresponse,
+		EnumMsgTypeListexecute,
+		EnumMsgTypeListstrikeprice,
+		EnumMsgTypeListstatusrequest,
+		EnumMsgTypeXmlnonfix,
+		EnumMsgTypeListstatus,
+		EnumMsgTypeRegistrationinstructions,
+		EnumMsgTypeRegistrationinstructionsresponse,
+		EnumMsgTypeAllocationinstructionack,
+		EnumMsgTypeOrdermasscancelrequest,
+		EnumMsgTypeDontknowtradedk,
+		EnumMsgTypeQuoterequest,
+		EnumMsgTypeOrdermasscancelreport,
+		EnumMsgTypeQuote,
+		EnumMsgTypeNewordercross,
+		EnumMsgTypeSettlementinstructions,
+		EnumMsgTypeCrossordercancelreplacerequest,
+		EnumMsgTypeCrossordercancelrequest,
+		EnumMsgTypeMarketdatarequest,
+		EnumMsgTypeSecuritytyperequest,
+		EnumMsgTypeSecuritytypes,
+		EnumMsgTypeMarketdatasnapshotfullrefresh,
+		EnumMsgTypeSecuritylistrequest,
+		EnumMsgTypeMarketdataincrementalrefresh,
+		EnumMsgTypeMarketdatarequestreject,
+		EnumMsgTypeSecuritylist,
+		EnumMsgTypeQuotecancel,
+		EnumMsgTypeDerivativesecuritylistrequest:
+		return true
+	}
+
+	return false
+}
@@ -2,12 +2,56 @@
 
 package fix44
 
-// Enum type EnumOpenCloseSettlFlag
+import (
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// EnumOpenCloseSettlFlag is the type of the OpenCloseSettlFlag(286) field values.
+type EnumOpenCloseSettlFlag string
+
 const (
-	EnumOpenCloseSettlFlagDailyopen                string = "0"
-	EnumOpenCloseSettlFlagSessionopen              string = "1"
-	EnumOpenCloseSettlFlagDeliverysettlement       string = "2"
-	EnumOpenCloseSettlFlagExpectedentry            string = "3"
-	EnumOpenCloseSettlFlagEntryfromprevbusinessday string = "4"
-	EnumOpenCloseSettlFlagTheoreticalprice         string = "5"
+	EnumOpenCloseSettlFlagDailyopen                EnumOpenCloseSettlFlag = "0"
+	EnumOpenCloseSettlFlagSessionopen              EnumOpenCloseSettlFlag = "1"
+	EnumOpenCloseSettlFlagDeliverysettlement       EnumOpenCloseSettlFlag = "2"
+	EnumOpenCloseSettlFlagExpectedentry            EnumOpenCloseSettlFlag = "3"
+	EnumOpenCloseSettlFlagEntryfromprevbusinessday EnumOpenCloseSettlFlag = "4"
+	EnumOpenCloseSettlFlagTheoreticalprice         EnumOpenCloseSettlFlag = "5"
 )
+
+// String returns the description of the value.
+func (v EnumOpenCloseSettlFlag) String() string {
+	switch v {
+	case EnumOpenCloseSettlFlagDailyopen:
+		return "DAILYOPEN"
+	case EnumOpenCloseSettlFlagSessionopen:
+		return "SESSIONOPEN"
+	case EnumOpenCloseSettlFlagDeliverysettlement:
+		return "DELIVERYSETTLEMENT"
+	case EnumOpenCloseSettlFlagExpectedentry:
+		return "EXPECTEDENTRY"
+	case EnumOpenCloseSettlFlagEntryfromprevbusinessday:
+		return "ENTRYFROMPREVBUSINESSDAY"
+	case EnumOpenCloseSettlFlagTheoreticalprice:
+		return "THEORETICALPRICE"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+// The value is a space-separated list, so each of its items is checked.
+func (v EnumOpenCloseSettlFlag) IsValid() bool {
+	return fix.IsValidMultipleValue(string(v), func(value string) bool {
+		switch EnumOpenCloseSettlFlag(value) {
+		case EnumOpenCloseSettlFlagDailyopen,
+			EnumOpenCloseSettlFlagSessionopen,
+			EnumOpenCloseSettlFlagDeliverysettlement,
+			EnumOpenCloseSettlFlagExpectedentry,
+			EnumOpenCloseSettlFlagEntryfromprevbusinessday,
+			EnumOpenCloseSettlFlagTheoreticalprice:
+			return true
+		}
+
+		return false
+	})
+}
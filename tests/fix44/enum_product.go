@@ -2,19 +2,77 @@
 
 package fix44
 
-// Enum type EnumProduct
+// EnumProduct is the type of the Product(460) field values.
+type EnumProduct string
+
 const (
-	EnumProductAgency      string = "1"
-	EnumProductMortgage    string = "10"
-	EnumProductMunicipal   string = "11"
-	EnumProductOther       string = "12"
-	EnumProductFinancing   string = "13"
-	EnumProductCommodity   string = "2"
-	EnumProductCorporate   string = "3"
-	EnumProductCurrency    string = "4"
-	EnumProductEquity      string = "5"
-	EnumProductGovernment  string = "6"
-	EnumProductIndex       string = "7"
-	EnumProductLoan        string = "8"
-	EnumProductMoneymarket string = "9"
+	EnumProductAgency      EnumProduct = "1"
+	EnumProductMortgage    EnumProduct = "10"
+	EnumProductMunicipal   EnumProduct = "11"
+	EnumProductOther       EnumProduct = "12"
+	EnumProductFinancing   EnumProduct = "13"
+	EnumProductCommodity   EnumProduct = "2"
+	EnumProductCorporate   EnumProduct = "3"
+	EnumProductCurrency    EnumProduct = "4"
+	EnumProductEquity      EnumProduct = "5"
+	EnumProductGovernment  EnumProduct = "6"
+	EnumProductIndex       EnumProduct = "7"
+	EnumProductLoan        EnumProduct = "8"
+	EnumProductMoneymarket EnumProduct = "9"
 )
+
+// String returns the description of the value.
+func (v EnumProduct) String() string {
+	switch v {
+	case EnumProductAgency:
+		return "AGENCY"
+	case EnumProductMortgage:
+		return "MORTGAGE"
+	case EnumProductMunicipal:
+		return "MUNICIPAL"
+	case EnumProductOther:
+		return "OTHER"
+	case EnumProductFinancing:
+		return "FINANCING"
+	case EnumProductCommodity:
+		return "COMMODITY"
+	case EnumProductCorporate:
+		return "CORPORATE"
+	case EnumProductCurrency:
+		return "CURRENCY"
+	case EnumProductEquity:
+		return "EQUITY"
+	case EnumProductGovernment:
+		return "GOVERNMENT"
+	case EnumProductIndex:
+		return "INDEX"
+	case EnumProductLoan:
+		return "LOAN"
+	case EnumProductMoneymarket:
+		return "MONEYMARKET"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumProduct) IsValid() bool {
+	switch v {
+	case EnumProductAgency,
+		EnumProductMortgage,
+		EnumProductMunicipal,
+		EnumProductOther,
+		EnumProductFinancing,
+		EnumProductCommodity,
+		EnumProductCorporate,
+		EnumProductCurrency,
+		EnumProductEquity,
+		EnumProductGovernment,
+		EnumProductIndex,
+		EnumProductLoan,
+		EnumProductMoneymarket:
+		return true
+	}
+
+	return false
+}
@@ -2,15 +2,68 @@
 
 package fix44
 
-// Enum type EnumQuoteCondition
+import (
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// EnumQuoteCondition is the type of the QuoteCondition(276) field values.
+type EnumQuoteCondition string
+
 const (
-	EnumQuoteConditionOpen       string = "A"
-	EnumQuoteConditionClosed     string = "B"
-	EnumQuoteConditionExchbest   string = "C"
-	EnumQuoteConditionConsolbest string = "D"
-	EnumQuoteConditionLocked     string = "E"
-	EnumQuoteConditionCrossed    string = "F"
-	EnumQuoteConditionDepth      string = "G"
-	EnumQuoteConditionFast       string = "H"
-	EnumQuoteConditionNonfirm    string = "I"
+	EnumQuoteConditionOpen       EnumQuoteCondition = "A"
+	EnumQuoteConditionClosed     EnumQuoteCondition = "B"
+	EnumQuoteConditionExchbest   EnumQuoteCondition = "C"
+	EnumQuoteConditionConsolbest EnumQuoteCondition = "D"
+	EnumQuoteConditionLocked     EnumQuoteCondition = "E"
+	EnumQuoteConditionCrossed    EnumQuoteCondition = "F"
+	EnumQuoteConditionDepth      EnumQuoteCondition = "G"
+	EnumQuoteConditionFast       EnumQuoteCondition = "H"
+	EnumQuoteConditionNonfirm    EnumQuoteCondition = "I"
 )
+
+// String returns the description of the value.
+func (v EnumQuoteCondition) String() string {
+	switch v {
+	case EnumQuoteConditionOpen:
+		return "OPEN"
+	case EnumQuoteConditionClosed:
+		return "CLOSED"
+	case EnumQuoteConditionExchbest:
+		return "EXCHBEST"
+	case EnumQuoteConditionConsolbest:
+		return "CONSOLBEST"
+	case EnumQuoteConditionLocked:
+		return "LOCKED"
+	case EnumQuoteConditionCrossed:
+		return "CROSSED"
+	case EnumQuoteConditionDepth:
+		return "DEPTH"
+	case EnumQuoteConditionFast:
+		return "FAST"
+	case EnumQuoteConditionNonfirm:
+		return "NONFIRM"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+// The value is a space-separated list, so each of its items is checked.
+func (v EnumQuoteCondition) IsValid() bool {
+	return fix.IsValidMultipleValue(string(v), func(value string) bool {
+		switch EnumQuoteCondition(value) {
+		case EnumQuoteConditionOpen,
+			EnumQuoteConditionClosed,
+			EnumQuoteConditionExchbest,
+			EnumQuoteConditionConsolbest,
+			EnumQuoteConditionLocked,
+			EnumQuoteConditionCrossed,
+			EnumQuoteConditionDepth,
+			EnumQuoteConditionFast,
+			EnumQuoteConditionNonfirm:
+			return true
+		}
+
+		return false
+	})
+}
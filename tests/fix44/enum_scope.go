@@ -2,9 +2,44 @@
 
 package fix44
 
-// Enum type EnumScope
+import (
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// EnumScope is the type of the Scope(546) field values.
+type EnumScope string
+
 const (
-	EnumScopeLocalmarket string = "1"
-	EnumScopeNational    string = "2"
-	EnumScopeGlobal      string = "3"
+	EnumScopeLocalmarket EnumScope = "1"
+	EnumScopeNational    EnumScope = "2"
+	EnumScopeGlobal      EnumScope = "3"
 )
+
+// String returns the description of the value.
+func (v EnumScope) String() string {
+	switch v {
+	case EnumScopeLocalmarket:
+		return "LOCALMARKET"
+	case EnumScopeNational:
+		return "NATIONAL"
+	case EnumScopeGlobal:
+		return "GLOBAL"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+// The value is a space-separated list, so each of its items is checked.
+func (v EnumScope) IsValid() bool {
+	return fix.IsValidMultipleValue(string(v), func(value string) bool {
+		switch EnumScope(value) {
+		case EnumScopeLocalmarket,
+			EnumScopeNational,
+			EnumScopeGlobal:
+			return true
+		}
+
+		return false
+	})
+}
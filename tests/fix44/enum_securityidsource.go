@@ -2,25 +2,101 @@
 
 package fix44
 
-// Enum type EnumSecurityIDSource
+// EnumSecurityIDSource is the type of the SecurityIDSource(22) field values.
+type EnumSecurityIDSource string
+
 const (
-	EnumSecurityIDSourceCusip                         string = "1"
-	EnumSecurityIDSourceSedol                         string = "2"
-	EnumSecurityIDSourceQuik                          string = "3"
-	EnumSecurityIDSourceIsin                          string = "4"
-	EnumSecurityIDSourceRic                           string = "5"
-	EnumSecurityIDSourceIsocurr                       string = "6"
-	EnumSecurityIDSourceIsocountry                    string = "7"
-	EnumSecurityIDSourceExchsymb                      string = "8"
-	EnumSecurityIDSourceCta                           string = "9"
-	EnumSecurityIDSourceBlmbrg                        string = "A"
-	EnumSecurityIDSourceWertpapier                    string = "B"
-	EnumSecurityIDSourceDutch                         string = "C"
-	EnumSecurityIDSourceValoren                       string = "D"
-	EnumSecurityIDSourceSicovam                       string = "E"
-	EnumSecurityIDSourceBelgian                       string = "F"
-	EnumSecurityIDSourceCommon                        string = "G"
-	EnumSecurityIDSourceClearinghouse                 string = "H"
-	EnumSecurityIDSourceFpml                          string = "I"
-	EnumSecurityIDSourceOptionpricereportingauthority string = "J"
+	EnumSecurityIDSourceCusip                         EnumSecurityIDSource = "1"
+	EnumSecurityIDSourceSedol                         EnumSecurityIDSource = "2"
+	EnumSecurityIDSourceQuik                          EnumSecurityIDSource = "3"
+	EnumSecurityIDSourceIsin                          EnumSecurityIDSource = "4"
+	EnumSecurityIDSourceRic                           EnumSecurityIDSource = "5"
+	EnumSecurityIDSourceIsocurr                       EnumSecurityIDSource = "6"
+	EnumSecurityIDSourceIsocountry                    EnumSecurityIDSource = "7"
+	EnumSecurityIDSourceExchsymb                      EnumSecurityIDSource = "8"
+	EnumSecurityIDSourceCta                           EnumSecurityIDSource = "9"
+	EnumSecurityIDSourceBlmbrg                        EnumSecurityIDSource = "A"
+	EnumSecurityIDSourceWertpapier                    EnumSecurityIDSource = "B"
+	EnumSecurityIDSourceDutch                         EnumSecurityIDSource = "C"
+	EnumSecurityIDSourceValoren                       EnumSecurityIDSource = "D"
+	EnumSecurityIDSourceSicovam                       EnumSecurityIDSource = "E"
+	EnumSecurityIDSourceBelgian                       EnumSecurityIDSource = "F"
+	EnumSecurityIDSourceCommon                        EnumSecurityIDSource = "G"
+	EnumSecurityIDSourceClearinghouse                 EnumSecurityIDSource = "H"
+	EnumSecurityIDSourceFpml                          EnumSecurityIDSource = "I"
+	EnumSecurityIDSourceOptionpricereportingauthority EnumSecurityIDSource = "J"
 )
+
+// String returns the description of the value.
+func (v EnumSecurityIDSource) String() string {
+	switch v {
+	case EnumSecurityIDSourceCusip:
+		return "CUSIP"
+	case EnumSecurityIDSourceSedol:
+		return "SEDOL"
+	case EnumSecurityIDSourceQuik:
+		return "QUIK"
+	case EnumSecurityIDSourceIsin:
+		return "ISIN"
+	case EnumSecurityIDSourceRic:
+		return "RIC"
+	case EnumSecurityIDSourceIsocurr:
+		return "ISOCURR"
+	case EnumSecurityIDSourceIsocountry:
+		return "ISOCOUNTRY"
+	case EnumSecurityIDSourceExchsymb:
+		return "EXCHSYMB"
+	case EnumSecurityIDSourceCta:
+		return "CTA"
+	case EnumSecurityIDSourceBlmbrg:
+		return "BLMBRG"
+	case EnumSecurityIDSourceWertpapier:
+		return "WERTPAPIER"
+	case EnumSecurityIDSourceDutch:
+		return "DUTCH"
+	case EnumSecurityIDSourceValoren:
+		return "VALOREN"
+	case EnumSecurityIDSourceSicovam:
+		return "SICOVAM"
+	case EnumSecurityIDSourceBelgian:
+		return "BELGIAN"
+	case EnumSecurityIDSourceCommon:
+		return "COMMON"
+	case EnumSecurityIDSourceClearinghouse:
+		return "CLEARINGHOUSE"
+	case EnumSecurityIDSourceFpml:
+		return "FPML"
+	case EnumSecurityIDSourceOptionpricereportingauthority:
+		return "OPTIONPRICEREPORTINGAUTHORITY"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumSecurityIDSource) IsValid() bool {
+	switch v {
+	case EnumSecurityIDSourceCusip,
+		EnumSecurityIDSourceSedol,
+		EnumSecurityIDSourceQuik,
+		EnumSecurityIDSourceIsin,
+		EnumSecurityIDSourceRic,
+		EnumSecurityIDSourceIsocurr,
+		EnumSecurityIDSourceIsocountry,
+		EnumSecurityIDSourceExchsymb,
+		EnumSecurityIDSourceCta,
+		EnumSecurityIDSourceBlmbrg,
+		EnumSecurityIDSourceWertpapier,
+		EnumSecurityIDSourceDutch,
+		EnumSecurityIDSourceValoren,
+		EnumSecurityIDSourceSicovam,
+		EnumSecurityIDSourceBelgian,
+		EnumSecurityIDSourceCommon,
+		EnumSecurityIDSourceClearinghouse,
+		EnumSecurityIDSourceFpml,
+		EnumSecurityIDSourceOptionpricereportingauthority:
+		return true
+	}
+
+	return false
+}
@@ -2,102 +2,409 @@
 
 package fix44
 
-// Enum type EnumSecurityType
+// EnumSecurityType is the type of the SecurityType(167) field values.
+type EnumSecurityType string
+
 const (
-	EnumSecurityTypeAssetbackedsecurities                    string = "ABS"
-	EnumSecurityTypeAmendedrestated                          string = "AMENDED"
-	EnumSecurityTypeOtheranticipationnotesbanganetc          string = "AN"
-	EnumSecurityTypeBankersacceptance                        string = "BA"
-	EnumSecurityTypeBanknotes                                string = "BN"
-	EnumSecurityTypeBillofexchanges                          string = "BOX"
-	EnumSecurityTypeBradybond                                string = "BRADY"
-	EnumSecurityTypeBridgeloan                               string = "BRIDGE"
-	EnumSecurityTypeBuysellback                              string = "BUYSELL"
-	EnumSecurityTypeConvertiblebond                          string = "CB"
-	EnumSecurityTypeCertificateofdeposit                     string = "CD"
-	EnumSecurityTypeCallloans                                string = "CL"
-	EnumSecurityTypeCorpmortgagebackedsecurities             string = "CMBS"
-	EnumSecurityTypeCollateralizedmortgageobligation         string = "CMO"
-	EnumSecurityTypeCertificateofobligation                  string = "COFO"
-	EnumSecurityTypeCertificateofparticipation               string = "COFP"
-	EnumSecurityTypeCorporatebond                            string = "CORP"
-	EnumSecurityTypeCommercialpaper                          string = "CP"
-	EnumSecurityTypeCorporateprivateplacement                string = "CPP"
-	EnumSecurityTypeCommonstock                              string = "CS"
-	EnumSecurityTypeDefaulted                                string = "DEFLTED"
-	EnumSecurityTypeDebtorinpossession                       string = "DINP"
-	EnumSecurityTypeDepositnotes                             string = "DN"
-	EnumSecurityTypeDualcurrency                             string = "DUAL"
-	EnumSecurityTypeEurocertificateofdeposit                 string = "EUCD"
-	EnumSecurityTypeEurocorporatebond                        string = "EUCORP"
-	EnumSecurityTypeEurocommercialpaper                      string = "EUCP"
-	EnumSecurityTypeEurosovereigns                           string = "EUSOV"
-	EnumSecurityTypeEurosupranationalcoupons                 string = "EUSUPRA"
-	EnumSecurityTypeFederalagencycoupon                      string = "FAC"
-	EnumSecurityTypeFederalagencydiscountnote                string = "FADN"
-	EnumSecurityTypeForeignexchangecontract                  string = "FOR"
-	EnumSecurityTypeForward                                  string = "FORWARD"
-	EnumSecurityTypeFuture                                   string = "FUT"
-	EnumSecurityTypeGeneralobligationbonds                   string = "GO"
-	EnumSecurityTypeIoettemortgage                           string = "IET"
-	EnumSecurityTypeLetterofcredit                           string = "LOFC"
-	EnumSecurityTypeLiquiditynote                            string = "LQN"
-	EnumSecurityTypeMatured                                  string = "MATURED"
-	EnumSecurityTypeMortgagebackedsecurities                 string = "MBS"
-	EnumSecurityTypeMutualfund                               string = "MF"
-	EnumSecurityTypeMortgageinterestonly                     string = "MIO"
-	EnumSecurityTypeMultileginstrument                       string = "MLEG"
-	EnumSecurityTypeMortgageprincipalonly                    string = "MPO"
-	EnumSecurityTypeMortgageprivateplacement                 string = "MPP"
-	EnumSecurityTypeMiscellaneouspassthrough                 string = "MPT"
-	EnumSecurityTypeMandatorytender                          string = "MT"
-	EnumSecurityTypeMediumtermnotes                          string = "MTN"
-	EnumSecurityTypeNosecuritytype                           string = "NONE"
-	EnumSecurityTypeOvernight                                string = "ONITE"
-	EnumSecurityTypeOption                                   string = "OPT"
-	EnumSecurityTypePrivateexportfunding                     string = "PEF"
-	EnumSecurityTypePfandbriefe                              string = "PFAND"
-	EnumSecurityTypePromissorynote                           string = "PN"
-	EnumSecurityTypePreferredstock                           string = "PS"
-	EnumSecurityTypePlazosfijos                              string = "PZFJ"
-	EnumSecurityTypeRevenueanticipationnote                  string = "RAN"
-	EnumSecurityTypeReplaced                                 string = "REPLACD"
-	EnumSecurityTypeRepurchase                               string = "REPO"
-	EnumSecurityTypeRetired                                  string = "RETIRED"
-	EnumSecurityTypeRevenuebonds                             string = "REV"
-	EnumSecurityTypeRevolverloan                             string = "RVLV"
-	EnumSecurityTypeRevolvertermloan                         string = "RVLVTRM"
-	EnumSecurityTypeSecuritiesloan                           string = "SECLOAN"
-	EnumSecurityTypeSecuritiespledge                         string = "SECPLEDGE"
-	EnumSecurityTypeSpecialassessment                        string = "SPCLA"
-	EnumSecurityTypeSpecialobligation                        string = "SPCLO"
-	EnumSecurityTypeSpecialtax                               string = "SPCLT"
-	EnumSecurityTypeShorttermloannote                        string = "STN"
-	EnumSecurityTypeStructurednotes                          string = "STRUCT"
-	EnumSecurityTypeUsdsupranationalcoupons                  string = "SUPRA"
-	EnumSecurityTypeSwinglinefacility                        string = "SWING"
-	EnumSecurityTypeTaxanticipationnote                      string = "TAN"
-	EnumSecurityTypeTaxallocation                            string = "TAXA"
-	EnumSecurityTypeTobeannounced                            string = "TBA"
-	EnumSecurityTypeUstreasurybill                           string = "TBILL"
-	EnumSecurityTypeUstreasurybond                           string = "TBOND"
-	EnumSecurityTypePrincipalstripofacallablebondornote      string = "TCAL"
-	EnumSecurityTypeTimedeposit                              string = "TD"
-	EnumSecurityTypeTaxexemptcommercialpaper                 string = "TECP"
-	EnumSecurityTypeTermloan                                 string = "TERM"
-	EnumSecurityTypeIntereststripfromanybondornote           string = "TINT"
-	EnumSecurityTypeTreasuryinflationprotectedsecurities     string = "TIPS"
-	EnumSecurityTypeUstreasurynote                           string = "TNOTE"
-	EnumSecurityTypePrincipalstripfromanoncallablebondornote string = "TPRN"
-	EnumSecurityTypeTaxrevenueanticipationnote               string = "TRAN"
-	EnumSecurityTypeUstreasurynotedeprecatedvalueusetnote    string = "UST"
-	EnumSecurityTypeUstreasurybilldeprecatedvalueusetbill    string = "USTB"
-	EnumSecurityTypeVariableratedemandnote                   string = "VRDN"
-	EnumSecurityTypeWarrant                                  string = "WAR"
-	EnumSecurityTypeWithdrawn                                string = "WITHDRN"
-	EnumSecurityTypeWildcardentry                            string = "WLD"
-	EnumSecurityTypeExtendedcommnote                         string = "XCN"
-	EnumSecurityTypeIndexedlinked                            string = "XLINKD"
-	EnumSecurityTypeYankeecorporatebond                      string = "YANK"
-	EnumSecurityTypeYankeecertificateofdeposit               string = "YCD"
+	EnumSecurityTypeAssetbackedsecurities                    EnumSecurityType = "ABS"
+	EnumSecurityTypeAmendedrestated                          EnumSecurityType = "AMENDED"
+	EnumSecurityTypeOtheranticipationnotesbanganetc          EnumSecurityType = "AN"
+	EnumSecurityTypeBankersacceptance                        EnumSecurityType = "BA"
+	EnumSecurityTypeBanknotes                                EnumSecurityType = "BN"
+	EnumSecurityTypeBillofexchanges                          EnumSecurityType = "BOX"
+	EnumSecurityTypeBradybond                                EnumSecurityType = "BRADY"
+	EnumSecurityTypeBridgeloan                               EnumSecurityType = "BRIDGE"
+	EnumSecurityTypeBuysellback                              EnumSecurityType = "BUYSELL"
+	EnumSecurityTypeConvertiblebond                          EnumSecurityType = "CB"
+	EnumSecurityTypeCertificateofdeposit                     EnumSecurityType = "CD"
+	EnumSecurityTypeCallloans                                EnumSecurityType = "CL"
+	EnumSecurityTypeCorpmortgagebackedsecurities             EnumSecurityType = "CMBS"
+	EnumSecurityTypeCollateralizedmortgageobligation         EnumSecurityType = "CMO"
+	EnumSecurityTypeCertificateofobligation                  EnumSecurityType = "COFO"
+	EnumSecurityTypeCertificateofparticipation               EnumSecurityType = "COFP"
+	EnumSecurityTypeCorporatebond                            EnumSecurityType = "CORP"
+	EnumSecurityTypeCommercialpaper                          EnumSecurityType = "CP"
+	EnumSecurityTypeCorporateprivateplacement                EnumSecurityType = "CPP"
+	EnumSecurityTypeCommonstock                              EnumSecurityType = "CS"
+	EnumSecurityTypeDefaulted                                EnumSecurityType = "DEFLTED"
+	EnumSecurityTypeDebtorinpossession                       EnumSecurityType = "DINP"
+	EnumSecurityTypeDepositnotes                             EnumSecurityType = "DN"
+	EnumSecurityTypeDualcurrency                             EnumSecurityType = "DUAL"
+	EnumSecurityTypeEurocertificateofdeposit                 EnumSecurityType = "EUCD"
+	EnumSecurityTypeEurocorporatebond                        EnumSecurityType = "EUCORP"
+	EnumSecurityTypeEurocommercialpaper                      EnumSecurityType = "EUCP"
+	EnumSecurityTypeEurosovereigns                           EnumSecurityType = "EUSOV"
+	EnumSecurityTypeEurosupranationalcoupons                 EnumSecurityType = "EUSUPRA"
+	EnumSecurityTypeFederalagencycoupon                      EnumSecurityType = "FAC"
+	EnumSecurityTypeFederalagencydiscountnote                EnumSecurityType = "FADN"
+	EnumSecurityTypeForeignexchangecontract                  EnumSecurityType = "FOR"
+	EnumSecurityTypeForward                                  EnumSecurityType = "FORWARD"
+	EnumSecurityTypeFuture                                   EnumSecurityType = "FUT"
+	EnumSecurityTypeGeneralobligationbonds                   EnumSecurityType = "GO"
+	EnumSecurityTypeIoettemortgage                           EnumSecurityType = "IET"
+	EnumSecurityTypeLetterofcredit                           EnumSecurityType = "LOFC"
+	EnumSecurityTypeLiquiditynote                            EnumSecurityType = "LQN"
+	EnumSecurityTypeMatured                                  EnumSecurityType = "MATURED"
+	EnumSecurityTypeMortgagebackedsecurities                 EnumSecurityType = "MBS"
+	EnumSecurityTypeMutualfund                               EnumSecurityType = "MF"
+	EnumSecurityTypeMortgageinterestonly                     EnumSecurityType = "MIO"
+	EnumSecurityTypeMultileginstrument                       EnumSecurityType = "MLEG"
+	EnumSecurityTypeMortgageprincipalonly                    EnumSecurityType = "MPO"
+	EnumSecurityTypeMortgageprivateplacement                 EnumSecurityType = "MPP"
+	EnumSecurityTypeMiscellaneouspassthrough                 EnumSecurityType = "MPT"
+	EnumSecurityTypeMandatorytender                          EnumSecurityType = "MT"
+	EnumSecurityTypeMediumtermnotes                          EnumSecurityType = "MTN"
+	EnumSecurityTypeNosecuritytype                           EnumSecurityType = "NONE"
+	EnumSecurityTypeOvernight                                EnumSecurityType = "ONITE"
+	EnumSecurityTypeOption                                   EnumSecurityType = "OPT"
+	EnumSecurityTypePrivateexportfunding                     EnumSecurityType = "PEF"
+	EnumSecurityTypePfandbriefe                              EnumSecurityType = "PFAND"
+	EnumSecurityTypePromissorynote                           EnumSecurityType = "PN"
+	EnumSecurityTypePreferredstock                           EnumSecurityType = "PS"
+	EnumSecurityTypePlazosfijos                              EnumSecurityType = "PZFJ"
+	EnumSecurityTypeRevenueanticipationnote                  EnumSecurityType = "RAN"
+	EnumSecurityTypeReplaced                                 EnumSecurityType = "REPLACD"
+	EnumSecurityTypeRepurchase                               EnumSecurityType = "REPO"
+	EnumSecurityTypeRetired                                  EnumSecurityType = "RETIRED"
+	EnumSecurityTypeRevenuebonds                             EnumSecurityType = "REV"
+	EnumSecurityTypeRevolverloan                             EnumSecurityType = "RVLV"
+	EnumSecurityTypeRevolvertermloan                         EnumSecurityType = "RVLVTRM"
+	EnumSecurityTypeSecuritiesloan                           EnumSecurityType = "SECLOAN"
+	EnumSecurityTypeSecuritiespledge                         EnumSecurityType = "SECPLEDGE"
+	EnumSecurityTypeSpecialassessment                        EnumSecurityType = "SPCLA"
+	EnumSecurityTypeSpecialobligation                        EnumSecurityType = "SPCLO"
+	EnumSecurityTypeSpecialtax                               EnumSecurityType = "SPCLT"
+	EnumSecurityTypeShorttermloannote                        EnumSecurityType = "STN"
+	EnumSecurityTypeStructurednotes                          EnumSecurityType = "STRUCT"
+	EnumSecurityTypeUsdsupranationalcoupons                  EnumSecurityType = "SUPRA"
+	EnumSecurityTypeSwinglinefacility                        EnumSecurityType = "SWING"
+	EnumSecurityTypeTaxanticipationnote                      EnumSecurityType = "TAN"
+	EnumSecurityTypeTaxallocation                            EnumSecurityType = "TAXA"
+	EnumSecurityTypeTobeannounced                            EnumSecurityType = "TBA"
+	EnumSecurityTypeUstreasurybill                           EnumSecurityType = "TBILL"
+	EnumSecurityTypeUstreasurybond                           EnumSecurityType = "TBOND"
+	EnumSecurityTypePrincipalstripofacallablebondornote      EnumSecurityType = "TCAL"
+	EnumSecurityTypeTimedeposit                              EnumSecurityType = "TD"
+	EnumSecurityTypeTaxexemptcommercialpaper                 EnumSecurityType = "TECP"
+	EnumSecurityTypeTermloan                                 EnumSecurityType = "TERM"
+	EnumSecurityTypeIntereststripfromanybondornote           EnumSecurityType = "TINT"
+	EnumSecurityTypeTreasuryinflationprotectedsecurities     EnumSecurityType = "TIPS"
+	EnumSecurityTypeUstreasurynote                           EnumSecurityType = "TNOTE"
+	EnumSecurityTypePrincipalstripfromanoncallablebondornote EnumSecurityType = "TPRN"
+	EnumSecurityTypeTaxrevenueanticipationnote               EnumSecurityType = "TRAN"
+	EnumSecurityTypeUstreasurynotedeprecatedvalueusetnote    EnumSecurityType = "UST"
+	EnumSecurityTypeUstreasurybilldeprecatedvalueusetbill    EnumSecurityType = "USTB"
+	EnumSecurityTypeVariableratedemandnote                   EnumSecurityType = "VRDN"
+	EnumSecurityTypeWarrant                                  EnumSecurityType = "WAR"
+	EnumSecurityTypeWithdrawn                                EnumSecurityType = "WITHDRN"
+	EnumSecurityTypeWildcardentry                            EnumSecurityType = "WLD"
+	EnumSecurityTypeExtendedcommnote                         EnumSecurityType = "XCN"
+	EnumSecurityTypeIndexedlinked                            EnumSecurityType = "XLINKD"
+	EnumSecurityTypeYankeecorporatebond                      EnumSecurityType = "YANK"
+	EnumSecurityTypeYankeecertificateofdeposit               EnumSecurityType = "YCD"
 )
+
+// String returns the description of the value.
+func (v EnumSecurityType) String() string {
+	switch v {
+	case EnumSecurityTypeAssetbackedsecurities:
+		return "ASSETBACKEDSECURITIES"
+	case EnumSecurityTypeAmendedrestated:
+		return "AMENDEDRESTATED"
+	case EnumSecurityTypeOtheranticipationnotesbanganetc:
+		return "OTHERANTICIPATIONNOTESBANGANETC"
+	case EnumSecurityTypeBankersacceptance:
+		return "BANKERSACCEPTANCE"
+	case EnumSecurityTypeBanknotes:
+		return "BANKNOTES"
+	case EnumSecurityTypeBillofexchanges:
+		return "BILLOFEXCHANGES"
+	case EnumSecurityTypeBradybond:
+		return "BRADYBOND"
+	case EnumSecurityTypeBridgeloan:
+		return "BRIDGELOAN"
+	case EnumSecurityTypeBuysellback:
+		return "BUYSELLBACK"
+	case EnumSecurityTypeConvertiblebond:
+		return "CONVERTIBLEBOND"
+	case EnumSecurityTypeCertificateofdeposit:
+		return "CERTIFICATEOFDEPOSIT"
+	case EnumSecurityTypeCallloans:
+		return "CALLLOANS"
+	case EnumSecurityTypeCorpmortgagebackedsecurities:
+		return "CORPMORTGAGEBACKEDSECURITIES"
+	case EnumSecurityTypeCollateralizedmortgageobligation:
+		return "COLLATERALIZEDMORTGAGEOBLIGATION"
+	case EnumSecurityTypeCertificateofobligation:
+		return "CERTIFICATEOFOBLIGATION"
+	case EnumSecurityTypeCertificateofparticipation:
+		return "CERTIFICATEOFPARTICIPATION"
+	case EnumSecurityTypeCorporatebond:
+		return "CORPORATEBOND"
+	case EnumSecurityTypeCommercialpaper:
+		return "COMMERCIALPAPER"
+	case EnumSecurityTypeCorporateprivateplacement:
+		return "CORPORATEPRIVATEPLACEMENT"
+	case EnumSecurityTypeCommonstock:
+		return "COMMONSTOCK"
+	case EnumSecurityTypeDefaulted:
+		return "DEFAULTED"
+	case EnumSecurityTypeDebtorinpossession:
+		return "DEBTORINPOSSESSION"
+	case EnumSecurityTypeDepositnotes:
+		return "DEPOSITNOTES"
+	case EnumSecurityTypeDualcurrency:
+		return "DUALCURRENCY"
+	case EnumSecurityTypeEurocertificateofdeposit:
+		return "EUROCERTIFICATEOFDEPOSIT"
+	case EnumSecurityTypeEurocorporatebond:
+		return "EUROCORPORATEBOND"
+	case EnumSecurityTypeEurocommercialpaper:
+		return "EUROCOMMERCIALPAPER"
+	case EnumSecurityTypeEurosovereigns:
+		return "EUROSOVEREIGNS"
+	case EnumSecurityTypeEurosupranationalcoupons:
+		return "EUROSUPRANATIONALCOUPONS"
+	case EnumSecurityTypeFederalagencycoupon:
+		return "FEDERALAGENCYCOUPON"
+	case EnumSecurityTypeFederalagencydiscountnote:
+		return "FEDERALAGENCYDISCOUNTNOTE"
+	case EnumSecurityTypeForeignexchangecontract:
+		return "FOREIGNEXCHANGECONTRACT"
+	case EnumSecurityTypeForward:
+		return "FORWARD"
+	case EnumSecurityTypeFuture:
+		return "FUTURE"
+	case EnumSecurityTypeGeneralobligationbonds:
+		return "GENERALOBLIGATIONBONDS"
+	case EnumSecurityTypeIoettemortgage:
+		return "IOETTEMORTGAGE"
+	case EnumSecurityTypeLetterofcredit:
+		return "LETTEROFCREDIT"
+	case EnumSecurityTypeLiquiditynote:
+		return "LIQUIDITYNOTE"
+	case EnumSecurityTypeMatured:
+		return "MATURED"
+	case EnumSecurityTypeMortgagebackedsecurities:
+		return "MORTGAGEBACKEDSECURITIES"
+	case EnumSecurityTypeMutualfund:
+		return "MUTUALFUND"
+	case EnumSecurityTypeMortgageinterestonly:
+		return "MORTGAGEINTERESTONLY"
+	case EnumSecurityTypeMultileginstrument:
+		return "MULTILEGINSTRUMENT"
+	case EnumSecurityTypeMortgageprincipalonly:
+		return "MORTGAGEPRINCIPALONLY"
+	case EnumSecurityTypeMortgageprivateplacement:
+		return "MORTGAGEPRIVATEPLACEMENT"
+	case EnumSecurityTypeMiscellaneouspassthrough:
+		return "MISCELLANEOUSPASSTHROUGH"
+	case EnumSecurityTypeMandatorytender:
+		return "MANDATORYTENDER"
+	case EnumSecurityTypeMediumtermnotes:
+		return "MEDIUMTERMNOTES"
+	case EnumSecurityTypeNosecuritytype:
+		return "NOSECURITYTYPE"
+	case EnumSecurityTypeOvernight:
+		return "OVERNIGHT"
+	case EnumSecurityTypeOption:
+		return "OPTION"
+	case EnumSecurityTypePrivateexportfunding:
+		return "PRIVATEEXPORTFUNDING"
+	case EnumSecurityTypePfandbriefe:
+		return "PFANDBRIEFE"
+	case EnumSecurityTypePromissorynote:
+		return "PROMISSORYNOTE"
+	case EnumSecurityTypePreferredstock:
+		return "PREFERREDSTOCK"
+	case EnumSecurityTypePlazosfijos:
+		return "PLAZOSFIJOS"
+	case EnumSecurityTypeRevenueanticipationnote:
+		return "REVENUEANTICIPATIONNOTE"
+	case EnumSecurityTypeReplaced:
+		return "REPLACED"
+	case EnumSecurityTypeRepurchase:
+		return "REPURCHASE"
+	case EnumSecurityTypeRetired:
+		return "RETIRED"
+	case EnumSecurityTypeRevenuebonds:
+		return "REVENUEBONDS"
+	case EnumSecurityTypeRevolverloan:
+		return "REVOLVERLOAN"
+	case EnumSecurityTypeRevolvertermloan:
+		return "REVOLVERTERMLOAN"
+	case EnumSecurityTypeSecuritiesloan:
+		return "SECURITIESLOAN"
+	case EnumSecurityTypeSecuritiespledge:
+		return "SECURITIESPLEDGE"
+	case EnumSecurityTypeSpecialassessment:
+		return "SPECIALASSESSMENT"
+	case EnumSecurityTypeSpecialobligation:
+		return "SPECIALOBLIGATION"
+	case EnumSecurityTypeSpecialtax:
+		return "SPECIALTAX"
+	case EnumSecurityTypeShorttermloannote:
+		return "SHORTTERMLOANNOTE"
+	case EnumSecurityTypeStructurednotes:
+		return "STRUCTUREDNOTES"
+	case EnumSecurityTypeUsdsupranationalcoupons:
+		return "USDSUPRANATIONALCOUPONS"
+	case EnumSecurityTypeSwinglinefacility:
+		return "SWINGLINEFACILITY"
+	case EnumSecurityTypeTaxanticipationnote:
+		return "TAXANTICIPATIONNOTE"
+	case EnumSecurityTypeTaxallocation:
+		return "TAXALLOCATION"
+	case EnumSecurityTypeTobeannounced:
+		return "TOBEANNOUNCED"
+	case EnumSecurityTypeUstreasurybill:
+		return "USTREASURYBILL"
+	case EnumSecurityTypeUstreasurybond:
+		return "USTREASURYBOND"
+	case EnumSecurityTypePrincipalstripofacallablebondornote:
+		return "PRINCIPALSTRIPOFACALLABLEBONDORNOTE"
+	case EnumSecurityTypeTimedeposit:
+		return "TIMEDEPOSIT"
+	case EnumSecurityTypeTaxexemptcommercialpaper:
+		return "TAXEXEMPTCOMMERCIALPAPER"
+	case EnumSecurityTypeTermloan:
+		return "TERMLOAN"
+	case EnumSecurityTypeIntereststripfromanybondornote:
+		return "INTERESTSTRIPFROMANYBONDORNOTE"
+	case EnumSecurityTypeTreasuryinflationprotectedsecurities:
+		return "TREASURYINFLATIONPROTECTEDSECURITIES"
+	case EnumSecurityTypeUstreasurynote:
+		return "USTREASURYNOTE"
+	case EnumSecurityTypePrincipalstripfromanoncallablebondornote:
+		return "PRINCIPALSTRIPFROMANONCALLABLEBONDORNOTE"
+	case EnumSecurityTypeTaxrevenueanticipationnote:
+		return "TAXREVENUEANTICIPATIONNOTE"
+	case EnumSecurityTypeUstreasurynotedeprecatedvalueusetnote:
+		return "USTREASURYNOTEDEPRECATEDVALUEUSETNOTE"
+	case EnumSecurityTypeUstreasurybilldeprecatedvalueusetbill:
+		return "USTREASURYBILLDEPRECATEDVALUEUSETBILL"
+	case EnumSecurityTypeVariableratedemandnote:
+		return "VARIABLERATEDEMANDNOTE"
+	case EnumSecurityTypeWarrant:
+		return "WARRANT"
+	case EnumSecurityTypeWithdrawn:
+		return "WITHDRAWN"
+	case EnumSecurityTypeWildcardentry:
+		return "WILDCARDENTRY"
+	case EnumSecurityTypeExtendedcommnote:
+		return "EXTENDEDCOMMNOTE"
+	case EnumSecurityTypeIndexedlinked:
+		return "INDEXEDLINKED"
+	case EnumSecurityTypeYankeecorporatebond:
+		return "YANKEECORPORATEBOND"
+	case EnumSecurityTypeYankeecertificateofdeposit:
+		return "YANKEECERTIFICATEOFDEPOSIT"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumSecurityType) IsValid() bool {
+	switch v {
+	case EnumSecurityTypeAssetbackedsecurities,
+		EnumSecurityTypeAmendedrestated,
+		EnumSecurityTypeOtheranticipationnotesbanganetc,
+		EnumSecurityTypeBankersacceptance,
+		EnumSecurityTypeBanknotes,
+		EnumSecurityTypeBillofexchanges,
+		EnumSecurityTypeBradybond,
+		EnumSecurityTypeBridgeloan,
+		EnumSecurityTypeBuysellback,
+		EnumSecurityTypeConvertiblebond,
+		EnumSecurityTypeCertificateofdeposit,
+		EnumSecurityTypeCallloans,
+		EnumSecurityTypeCorpmortgagebackedsecurities,
+		EnumSecurityTypeCollateralizedmortgageobligation,
+		EnumSecurityTypeCertificateofobligation,
+		EnumSecurityTypeCertificateofparticipation,
+		EnumSecurityTypeCorporatebond,
+		EnumSecurityTypeCommercialpaper,
+		EnumSecurityTypeCorporateprivateplacement,
+		EnumSecurityTypeCommonstock,
+		EnumSecurityTypeDefaulted,
+		EnumSecurityTypeDebtorinpossession,
+		EnumSecurityTypeDepositnotes,
+		EnumSecurityTypeDualcurrency,
+		EnumSecurityTypeEurocertificateofdeposit,
+		EnumSecurityTypeEurocorporatebond,
+		EnumSecurityTypeEurocommercialpaper,
+		EnumSecurityTypeEurosovereigns,
+		EnumSecurityTypeEurosupranationalcoupons,
+		EnumSecurityTypeFederalagencycoupon,
+		EnumSecurityTypeFederalagencydiscountnote,
+		EnumSecurityTypeForeignexchangecontract,
+		EnumSecurityTypeForward,
+		EnumSecurityTypeFuture,
+		EnumSecurityTypeGeneralobligationbonds,
+		EnumSecurityTypeIoettemortgage,
+		EnumSecurityTypeLetterofcredit,
+		EnumSecurityTypeLiquiditynote,
+		EnumSecurityTypeMatured,
+		EnumSecurityTypeMortgagebackedsecurities,
+		EnumSecurityTypeMutualfund,
+		EnumSecurityTypeMortgageinterestonly,
+		EnumSecurityTypeMultileginstrument,
+		EnumSecurityTypeMortgageprincipalonly,
+		EnumSecurityTypeMortgageprivateplacement,
+		EnumSecurityTypeMiscellaneouspassthrough,
+		EnumSecurityTypeMandatorytender,
+		EnumSecurityTypeMediumtermnotes,
+		EnumSecurityTypeNosecuritytype,
+		EnumSecurityTypeOvernight,
+		EnumSecurityTypeOption,
+		EnumSecurityTypePrivateexportfunding,
+		EnumSecurityTypePfandbriefe,
+		EnumSecurityTypePromissorynote,
+		EnumSecurityTypePreferredstock,
+		EnumSecurityTypePlazosfijos,
+		EnumSecurityTypeRevenueanticipationnote,
+		EnumSecurityTypeReplaced,
+		EnumSecurityTypeRepurchase,
+		EnumSecurityTypeRetired,
+		EnumSecurityTypeRevenuebonds,
+		EnumSecurityTypeRevolverloan,
+		EnumSecurityTypeRevolvertermloan,
+		EnumSecurityTypeSecuritiesloan,
+		EnumSecurityTypeSecuritiespledge,
+		EnumSecurityTypeSpecialassessment,
+		EnumSecurityTypeSpecialobligation,
+		EnumSecurityTypeSpecialtax,
+		EnumSecurityTypeShorttermloannote,
+		EnumSecurityTypeStructurednotes,
+		EnumSecurityTypeUsdsupranationalcoupons,
+		EnumSecurityTypeSwinglinefacility,
+		EnumSecurityTypeTaxanticipationnote,
+		EnumSecurityTypeTaxallocation,
+		EnumSecurityTypeTobeannounced,
+		EnumSecurityTypeUstreasurybill,
+		EnumSecurityTypeUstreasurybond,
+		EnumSecurityTypePrincipalstripofacallablebondornote,
+		EnumSecurityTypeTimedeposit,
+		EnumSecurityTypeTaxexemptcommercialpaper,
+		EnumSecurityTypeTermloan,
+		EnumSecurityTypeIntereststripfromanybondornote,
+		EnumSecurityTypeTreasuryinflationprotectedsecurities,
+		EnumSecurityTypeUstreasurynote,
+		EnumSecurityTypePrincipalstripfromanoncallablebondornote,
+		EnumSecurityTypeTaxrevenueanticipationnote,
+		EnumSecurityTypeUstreasurynotedeprecatedvalueusetnote,
+		EnumSecurityTypeUstreasurybilldeprecatedvalueusetbill,
+		EnumSecurityTypeVariableratedemandnote,
+		EnumSecurityTypeWarrant,
+		EnumSecurityTypeWithdrawn,
+		EnumSecurityTypeWildcardentry,
+		EnumSecurityTypeExtendedcommnote,
+		EnumSecurityTypeIndexedlinked,
+		EnumSecurityTypeYankeecorporatebond,
+		EnumSecurityTypeYankeecertificateofdeposit:
+		return true
+	}
+
+	return false
+}
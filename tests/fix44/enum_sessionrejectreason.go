@@ -2,25 +2,101 @@
 
 package fix44
 
-// Enum type EnumSessionRejectReason
+// EnumSessionRejectReason is the type of the SessionRejectReason(373) field values.
+type EnumSessionRejectReason string
+
 const (
-	EnumSessionRejectReasonInvalidtagnumber                               string = "0"
-	EnumSessionRejectReasonRequiredtagmissing                             string = "1"
-	EnumSessionRejectReasonSendingtimeaccuracyproblem                     string = "10"
-	EnumSessionRejectReasonInvalidmsgtype                                 string = "11"
-	EnumSessionRejectReasonXmlvalidationerror                             string = "12"
-	EnumSessionRejectReasonTagappearsmorethanonce                         string = "13"
-	EnumSessionRejectReasonTagspecifiedoutofrequiredorder                 string = "14"
-	EnumSessionRejectReasonRepeatinggroupfieldsoutoforder                 string = "15"
-	EnumSessionRejectReasonIncorrectnumingroupcountforrepeatinggroup      string = "16"
-	EnumSessionRejectReasonNondatavalueincludesfielddelimitersohcharacter string = "17"
-	EnumSessionRejectReasonTagNotDefinedForThisMessageType                string = "2"
-	EnumSessionRejectReasonUndefinedtag                                   string = "3"
-	EnumSessionRejectReasonTagspecifiedwithoutavalue                      string = "4"
-	EnumSessionRejectReasonValueisincorrectoutofrangeforthistag           string = "5"
-	EnumSessionRejectReasonIncorrectdataformatforvalue                    string = "6"
-	EnumSessionRejectReasonDecryptionproblem                              string = "7"
-	EnumSessionRejectReasonSignatureproblem                               string = "8"
-	EnumSessionRejectReasonCompidproblem                                  string = "9"
-	EnumSessionRejectReasonOther                                          string = "99"
+	EnumSessionRejectReasonInvalidtagnumber                               EnumSessionRejectReason = "0"
+	EnumSessionRejectReasonRequiredtagmissing                             EnumSessionRejectReason = "1"
+	EnumSessionRejectReasonSendingtimeaccuracyproblem                     EnumSessionRejectReason = "10"
+	EnumSessionRejectReasonInvalidmsgtype                                 EnumSessionRejectReason = "11"
+	EnumSessionRejectReasonXmlvalidationerror                             EnumSessionRejectReason = "12"
+	EnumSessionRejectReasonTagappearsmorethanonce                         EnumSessionRejectReason = "13"
+	EnumSessionRejectReasonTagspecifiedoutofrequiredorder                 EnumSessionRejectReason = "14"
+	EnumSessionRejectReasonRepeatinggroupfieldsoutoforder                 EnumSessionRejectReason = "15"
+	EnumSessionRejectReasonIncorrectnumingroupcountforrepeatinggroup      EnumSessionRejectReason = "16"
+	EnumSessionRejectReasonNondatavalueincludesfielddelimitersohcharacter EnumSessionRejectReason = "17"
+	EnumSessionRejectReasonTagNotDefinedForThisMessageType                EnumSessionRejectReason = "2"
+	EnumSessionRejectReasonUndefinedtag                                   EnumSessionRejectReason = "3"
+	EnumSessionRejectReasonTagspecifiedwithoutavalue                      EnumSessionRejectReason = "4"
+	EnumSessionRejectReasonValueisincorrectoutofrangeforthistag           EnumSessionRejectReason = "5"
+	EnumSessionRejectReasonIncorrectdataformatforvalue                    EnumSessionRejectReason = "6"
+	EnumSessionRejectReasonDecryptionproblem                              EnumSessionRejectReason = "7"
+	EnumSessionRejectReasonSignatureproblem                               EnumSessionRejectReason = "8"
+	EnumSessionRejectReasonCompidproblem                                  EnumSessionRejectReason = "9"
+	EnumSessionRejectReasonOther                                          EnumSessionRejectReason = "99"
 )
+
+// String returns the description of the value.
+func (v EnumSessionRejectReason) String() string {
+	switch v {
+	case EnumSessionRejectReasonInvalidtagnumber:
+		return "INVALIDTAGNUMBER"
+	case EnumSessionRejectReasonRequiredtagmissing:
+		return "REQUIREDTAGMISSING"
+	case EnumSessionRejectReasonSendingtimeaccuracyproblem:
+		return "SENDINGTIMEACCURACYPROBLEM"
+	case EnumSessionRejectReasonInvalidmsgtype:
+		return "INVALIDMSGTYPE"
+	case EnumSessionRejectReasonXmlvalidationerror:
+		return "XMLVALIDATIONERROR"
+	case EnumSessionRejectReasonTagappearsmorethanonce:
+		return "TAGAPPEARSMORETHANONCE"
+	case EnumSessionRejectReasonTagspecifiedoutofrequiredorder:
+		return "TAGSPECIFIEDOUTOFREQUIREDORDER"
+	case EnumSessionRejectReasonRepeatinggroupfieldsoutoforder:
+		return "REPEATINGGROUPFIELDSOUTOFORDER"
+	case EnumSessionRejectReasonIncorrectnumingroupcountforrepeatinggroup:
+		return "INCORRECTNUMINGROUPCOUNTFORREPEATINGGROUP"
+	case EnumSessionRejectReasonNondatavalueincludesfielddelimitersohcharacter:
+		return "NONDATAVALUEINCLUDESFIELDDELIMITERSOHCHARACTER"
+	case EnumSessionRejectReasonTagNotDefinedForThisMessageType:
+		return "TAG_NOT_DEFINED_FOR_THIS_MESSAGE_TYPE"
+	case EnumSessionRejectReasonUndefinedtag:
+		return "UNDEFINEDTAG"
+	case EnumSessionRejectReasonTagspecifiedwithoutavalue:
+		return "TAGSPECIFIEDWITHOUTAVALUE"
+	case EnumSessionRejectReasonValueisincorrectoutofrangeforthistag:
+		return "VALUEISINCORRECTOUTOFRANGEFORTHISTAG"
+	case EnumSessionRejectReasonIncorrectdataformatforvalue:
+		return "INCORRECTDATAFORMATFORVALUE"
+	case EnumSessionRejectReasonDecryptionproblem:
+		return "DECRYPTIONPROBLEM"
+	case EnumSessionRejectReasonSignatureproblem:
+		return "SIGNATUREPROBLEM"
+	case EnumSessionRejectReasonCompidproblem:
+		return "COMPIDPROBLEM"
+	case EnumSessionRejectReasonOther:
+		return "OTHER"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumSessionRejectReason) IsValid() bool {
+	switch v {
+	case EnumSessionRejectReasonInvalidtagnumber,
+		EnumSessionRejectReasonRequiredtagmissing,
+		EnumSessionRejectReasonSendingtimeaccuracyproblem,
+		EnumSessionRejectReasonInvalidmsgtype,
+		EnumSessionRejectReasonXmlvalidationerror,
+		EnumSessionRejectReasonTagappearsmorethanonce,
+		EnumSessionRejectReasonTagspecifiedoutofrequiredorder,
+		EnumSessionRejectReasonRepeatinggroupfieldsoutoforder,
+		EnumSessionRejectReasonIncorrectnumingroupcountforrepeatinggroup,
+		EnumSessionRejectReasonNondatavalueincludesfielddelimitersohcharacter,
+		EnumSessionRejectReasonTagNotDefinedForThisMessageType,
+		EnumSessionRejectReasonUndefinedtag,
+		EnumSessionRejectReasonTagspecifiedwithoutavalue,
+		EnumSessionRejectReasonValueisincorrectoutofrangeforthistag,
+		EnumSessionRejectReasonIncorrectdataformatforvalue,
+		EnumSessionRejectReasonDecryptionproblem,
+		EnumSessionRejectReasonSignatureproblem,
+		EnumSessionRejectReasonCompidproblem,
+		EnumSessionRejectReasonOther:
+		return true
+	}
+
+	return false
+}
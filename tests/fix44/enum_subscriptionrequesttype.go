@@ -2,9 +2,37 @@
 
 package fix44
 
-// Enum type EnumSubscriptionRequestType
+// EnumSubscriptionRequestType is the type of the SubscriptionRequestType(263) field values.
+type EnumSubscriptionRequestType string
+
 const (
-	EnumSubscriptionRequestTypeSnapshot       string = "0"
-	EnumSubscriptionRequestTypeSnapshotupdate string = "1"
-	EnumSubscriptionRequestTypeUnsubscribe    string = "2"
+	EnumSubscriptionRequestTypeSnapshot       EnumSubscriptionRequestType = "0"
+	EnumSubscriptionRequestTypeSnapshotupdate EnumSubscriptionRequestType = "1"
+	EnumSubscriptionRequestTypeUnsubscribe    EnumSubscriptionRequestType = "2"
 )
+
+// String returns the description of the value.
+func (v EnumSubscriptionRequestType) String() string {
+	switch v {
+	case EnumSubscriptionRequestTypeSnapshot:
+		return "SNAPSHOT"
+	case EnumSubscriptionRequestTypeSnapshotupdate:
+		return "SNAPSHOTUPDATE"
+	case EnumSubscriptionRequestTypeUnsubscribe:
+		return "UNSUBSCRIBE"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumSubscriptionRequestType) IsValid() bool {
+	switch v {
+	case EnumSubscriptionRequestTypeSnapshot,
+		EnumSubscriptionRequestTypeSnapshotupdate,
+		EnumSubscriptionRequestTypeUnsubscribe:
+		return true
+	}
+
+	return false
+}
@@ -2,8 +2,33 @@
 
 package fix44
 
-// Enum type EnumSymbolSfx
+// EnumSymbolSfx is the type of the SymbolSfx(65) field values.
+type EnumSymbolSfx string
+
 const (
-	EnumSymbolSfxEucplumpsuminterest string = "CD"
-	EnumSymbolSfxWhenissued          string = "WI"
+	EnumSymbolSfxEucplumpsuminterest EnumSymbolSfx = "CD"
+	EnumSymbolSfxWhenissued          EnumSymbolSfx = "WI"
 )
+
+// String returns the description of the value.
+func (v EnumSymbolSfx) String() string {
+	switch v {
+	case EnumSymbolSfxEucplumpsuminterest:
+		return "EUCPLUMPSUMINTEREST"
+	case EnumSymbolSfxWhenissued:
+		return "WHENISSUED"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumSymbolSfx) IsValid() bool {
+	switch v {
+	case EnumSymbolSfxEucplumpsuminterest,
+		EnumSymbolSfxWhenissued:
+		return true
+	}
+
+	return false
+}
@@ -2,10 +2,41 @@
 
 package fix44
 
-// Enum type EnumTickDirection
+// EnumTickDirection is the type of the TickDirection(274) field values.
+type EnumTickDirection string
+
 const (
-	EnumTickDirectionPlus      string = "0"
-	EnumTickDirectionZeroplus  string = "1"
-	EnumTickDirectionMinus     string = "2"
-	EnumTickDirectionZerominus string = "3"
+	EnumTickDirectionPlus      EnumTickDirection = "0"
+	EnumTickDirectionZeroplus  EnumTickDirection = "1"
+	EnumTickDirectionMinus     EnumTickDirection = "2"
+	EnumTickDirectionZerominus EnumTickDirection = "3"
 )
+
+// String returns the description of the value.
+func (v EnumTickDirection) String() string {
+	switch v {
+	case EnumTickDirectionPlus:
+		return "PLUS"
+	case EnumTickDirectionZeroplus:
+		return "ZEROPLUS"
+	case EnumTickDirectionMinus:
+		return "MINUS"
+	case EnumTickDirectionZerominus:
+		return "ZEROMINUS"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumTickDirection) IsValid() bool {
+	switch v {
+	case EnumTickDirectionPlus,
+		EnumTickDirectionZeroplus,
+		EnumTickDirectionMinus,
+		EnumTickDirectionZerominus:
+		return true
+	}
+
+	return false
+}
@@ -2,14 +2,57 @@
 
 package fix44
 
-// Enum type EnumTimeInForce
+// EnumTimeInForce is the type of the TimeInForce(59) field values.
+type EnumTimeInForce string
+
 const (
-	EnumTimeInForceDay               string = "0"
-	EnumTimeInForceGoodtillcancel    string = "1"
-	EnumTimeInForceAttheopening      string = "2"
-	EnumTimeInForceImmediateorcancel string = "3"
-	EnumTimeInForceFillorkill        string = "4"
-	EnumTimeInForceGoodtillcrossing  string = "5"
-	EnumTimeInForceGoodtilldate      string = "6"
-	EnumTimeInForceAttheclose        string = "7"
+	EnumTimeInForceDay               EnumTimeInForce = "0"
+	EnumTimeInForceGoodtillcancel    EnumTimeInForce = "1"
+	EnumTimeInForceAttheopening      EnumTimeInForce = "2"
+	EnumTimeInForceImmediateorcancel EnumTimeInForce = "3"
+	EnumTimeInForceFillorkill        EnumTimeInForce = "4"
+	EnumTimeInForceGoodtillcrossing  EnumTimeInForce = "5"
+	EnumTimeInForceGoodtilldate      EnumTimeInForce = "6"
+	EnumTimeInForceAttheclose        EnumTimeInForce = "7"
 )
+
+// String returns the description of the value.
+func (v EnumTimeInForce) String() string {
+	switch v {
+	case EnumTimeInForceDay:
+		return "DAY"
+	case EnumTimeInForceGoodtillcancel:
+		return "GOODTILLCANCEL"
+	case EnumTimeInForceAttheopening:
+		return "ATTHEOPENING"
+	case EnumTimeInForceImmediateorcancel:
+		return "IMMEDIATEORCANCEL"
+	case EnumTimeInForceFillorkill:
+		return "FILLORKILL"
+	case EnumTimeInForceGoodtillcrossing:
+		return "GOODTILLCROSSING"
+	case EnumTimeInForceGoodtilldate:
+		return "GOODTILLDATE"
+	case EnumTimeInForceAttheclose:
+		return "ATTHECLOSE"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+func (v EnumTimeInForce) IsValid() bool {
+	switch v {
+	case EnumTimeInForceDay,
+		EnumTimeInForceGoodtillcancel,
+		EnumTimeInForceAttheopening,
+		EnumTimeInForceImmediateorcancel,
+		EnumTimeInForceFillorkill,
+		EnumTimeInForceGoodtillcrossing,
+		EnumTimeInForceGoodtilldate,
+		EnumTimeInForceAttheclose:
+		return true
+	}
+
+	return false
+}
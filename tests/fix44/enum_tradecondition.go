@@ -2,23 +2,100 @@
 
 package fix44
 
-// Enum type EnumTradeCondition
+import (
+	"github.com/b2broker/simplefix-go/fix"
+)
+
+// EnumTradeCondition is the type of the TradeCondition(277) field values.
+type EnumTradeCondition string
+
 const (
-	EnumTradeConditionCashmkt              string = "A"
-	EnumTradeConditionAvgpx                string = "B"
-	EnumTradeConditionCashtrade            string = "C"
-	EnumTradeConditionNextdayD             string = "D"
-	EnumTradeConditionOpening              string = "E"
-	EnumTradeConditionIntraday             string = "F"
-	EnumTradeConditionRule127              string = "G"
-	EnumTradeConditionRule155              string = "H"
-	EnumTradeConditionSoldlast             string = "I"
-	EnumTradeConditionNextdayJ             string = "J"
-	EnumTradeConditionOpened               string = "K"
-	EnumTradeConditionSeller               string = "L"
-	EnumTradeConditionSold                 string = "M"
-	EnumTradeConditionStopped              string = "N"
-	EnumTradeConditionImbalancemorebuyers  string = "P"
-	EnumTradeConditionImbalancemoresellers string = "Q"
-	EnumTradeConditionOpeningprice         string = "R"
+	EnumTradeConditionCashmkt              EnumTradeCondition = "A"
+	EnumTradeConditionAvgpx                EnumTradeCondition = "B"
+	EnumTradeConditionCashtrade            EnumTradeCondition = "C"
+	EnumTradeConditionNextdayD             EnumTradeCondition = "D"
+	EnumTradeConditionOpening              EnumTradeCondition = "E"
+	EnumTradeConditionIntraday             EnumTradeCondition = "F"
+	EnumTradeConditionRule127              EnumTradeCondition = "G"
+	EnumTradeConditionRule155              EnumTradeCondition = "H"
+	EnumTradeConditionSoldlast             EnumTradeCondition = "I"
+	EnumTradeConditionNextdayJ             EnumTradeCondition = "J"
+	EnumTradeConditionOpened               EnumTradeCondition = "K"
+	EnumTradeConditionSeller               EnumTradeCondition = "L"
+	EnumTradeConditionSold                 EnumTradeCondition = "M"
+	EnumTradeConditionStopped              EnumTradeCondition = "N"
+	EnumTradeConditionImbalancemorebuyers  EnumTradeCondition = "P"
+	EnumTradeConditionImbalancemoresellers EnumTradeCondition = "Q"
+	EnumTradeConditionOpeningprice         EnumTradeCondition = "R"
 )
+
+// String returns the description of the value.
+func (v EnumTradeCondition) String() string {
+	switch v {
+	case EnumTradeConditionCashmkt:
+		return "CASHMKT"
+	case EnumTradeConditionAvgpx:
+		return "AVGPX"
+	case EnumTradeConditionCashtrade:
+		return "CASHTRADE"
+	case EnumTradeConditionNextdayD:
+		return "NEXTDAY_D"
+	case EnumTradeConditionOpening:
+		return "OPENING"
+	case EnumTradeConditionIntraday:
+		return "INTRADAY"
+	case EnumTradeConditionRule127:
+		return "RULE127"
+	case EnumTradeConditionRule155:
+		return "RULE155"
+	case EnumTradeConditionSoldlast:
+		return "SOLDLAST"
+	case EnumTradeConditionNextdayJ:
+		return "NEXTDAY_J"
+	case EnumTradeConditionOpened:
+		return "OPENED"
+	case EnumTradeConditionSeller:
+		return "SELLER"
+	case EnumTradeConditionSold:
+		return "SOLD"
+	case EnumTradeConditionStopped:
+		return "STOPPED"
+	case EnumTradeConditionImbalancemorebuyers:
+		return "IMBALANCEMOREBUYERS"
+	case EnumTradeConditionImbalancemoresellers:
+		return "IMBALANCEMORESELLERS"
+	case EnumTradeConditionOpeningprice:
+		return "OPENINGPRICE"
+	}
+
+	return string(v)
+}
+
+// IsValid returns true if the value is defined for the field.
+// The value is a space-separated list, so each of its items is checked.
+func (v EnumTradeCondition) IsValid() bool {
+	return fix.IsValidMultipleValue(string(v), func(value string) bool {
+		switch EnumTradeCondition(value) {
+		case EnumTradeConditionCashmkt,
+			EnumTradeConditionAvgpx,
+			EnumTradeConditionCashtrade,
+			EnumTradeConditionNextdayD,
+			EnumTradeConditionOpening,
+			EnumTradeConditionIntraday,
+			EnumTradeConditionRule127,
+			EnumTradeConditionRule155,
+			EnumTradeConditionSoldlast,
+			EnumTradeConditionNextdayJ,
+			EnumTradeConditionOpened,
+			EnumTradeConditionSeller,
+			EnumTradeConditionSold,
+			EnumTradeConditionStopped,
+			EnumTradeConditionImbalancemorebuyers,
+			EnumTradeConditionImbalancemoresellers,
+			EnumTradeConditionOpeningprice:
+			return true
+		}
+
+		return false
+	})
+}
@@ -13,7 +13,7 @@ type EventsGrp struct {
 func NewEventsGrp() *EventsGrp {
 	return &EventsGrp{
 		fix.NewGroup(FieldNoEvents,
-			fix.NewKeyValue(FieldEventType, &fix.String{}),
+			fix.NewKeyValue(FieldEventType, &fix.Enum[EnumEventType]{}),
 			fix.NewKeyValue(FieldEventDate, &fix.String{}),
 			fix.NewKeyValue(FieldEventPx, &fix.Float{}),
 			fix.NewKeyValue(FieldEventText, &fix.String{}),
@@ -37,13 +37,24 @@ func (group *EventsGrp) Entries() []*EventsEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *EventsGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type EventsEntry struct {
 	*fix.Component
 }
 
 func makeEventsEntry() *EventsEntry {
 	return &EventsEntry{fix.NewComponent(
-		fix.NewKeyValue(FieldEventType, &fix.String{}),
+		fix.NewKeyValue(FieldEventType, &fix.Enum[EnumEventType]{}),
 		fix.NewKeyValue(FieldEventDate, &fix.String{}),
 		fix.NewKeyValue(FieldEventPx, &fix.Float{}),
 		fix.NewKeyValue(FieldEventText, &fix.String{}),
@@ -54,13 +65,13 @@ func NewEventsEntry() *EventsEntry {
 	return makeEventsEntry()
 }
 
-func (eventsEntry *EventsEntry) EventType() string {
+func (eventsEntry *EventsEntry) EventType() EnumEventType {
 	kv := eventsEntry.Get(0)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumEventType)
 }
 
-func (eventsEntry *EventsEntry) SetEventType(eventType string) *EventsEntry {
+func (eventsEntry *EventsEntry) SetEventType(eventType EnumEventType) *EventsEntry {
 	kv := eventsEntry.Get(0).(*fix.KeyValue)
 	_ = kv.Load().Set(eventType)
 	return eventsEntry
@@ -101,3 +112,10 @@ func (eventsEntry *EventsEntry) SetEventText(eventText string) *EventsEntry {
 	_ = kv.Load().Set(eventText)
 	return eventsEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (eventsEntry *EventsEntry) Validate() error {
+	return nil
+}
@@ -2,6 +2,10 @@
 
 package fix44
 
+import (
+	"github.com/b2broker/simplefix-go/fix"
+)
+
 const (
 	FieldBeginSeqNo                           = "7"
 	FieldBeginString                          = "8"
@@ -259,3 +263,262 @@ const (
 	FieldLegContractSettlMonth                = "955"
 	FieldLegInterestAccrualDate               = "956"
 )
+
+// fieldNames are the names of the fields, which are the keys of the FIX JSON encoding.
+var fieldNames = fix.FieldNames{
+	FieldBeginSeqNo:                  "BeginSeqNo",
+	FieldBeginString:                 "BeginString",
+	FieldBodyLength:                  "BodyLength",
+	FieldCheckSum:                    "CheckSum",
+	FieldCurrency:                    "Currency",
+	FieldEndSeqNo:                    "EndSeqNo",
+	FieldExecInst:                    "ExecInst",
+	FieldSecurityIDSource:            "SecurityIDSource",
+	FieldMsgSeqNum:                   "MsgSeqNum",
+	FieldMsgType:                     "MsgType",
+	FieldNewSeqNo:                    "NewSeqNo",
+	FieldOrderID:                     "OrderID",
+	FieldPossDupFlag:                 "PossDupFlag",
+	FieldRefSeqNum:                   "RefSeqNum",
+	FieldSecurityID:                  "SecurityID",
+	FieldSenderCompID:                "SenderCompID",
+	FieldSenderSubID:                 "SenderSubID",
+	FieldSendingTime:                 "SendingTime",
+	FieldSymbol:                      "Symbol",
+	FieldTargetCompID:                "TargetCompID",
+	FieldTargetSubID:                 "TargetSubID",
+	FieldText:                        "Text",
+	FieldTimeInForce:                 "TimeInForce",
+	FieldSymbolSfx:                   "SymbolSfx",
+	FieldSignature:                   "Signature",
+	FieldSecureDataLen:               "SecureDataLen",
+	FieldSecureData:                  "SecureData",
+	FieldSignatureLength:             "SignatureLength",
+	FieldRawDataLength:               "RawDataLength",
+	FieldRawData:                     "RawData",
+	FieldPossResend:                  "PossResend",
+	FieldEncryptMethod:               "EncryptMethod",
+	FieldIssuer:                      "Issuer",
+	FieldSecurityDesc:                "SecurityDesc",
+	FieldHeartBtInt:                  "HeartBtInt",
+	FieldMinQty:                      "MinQty",
+	FieldTestReqID:                   "TestReqID",
+	FieldOnBehalfOfCompID:            "OnBehalfOfCompID",
+	FieldOnBehalfOfSubID:             "OnBehalfOfSubID",
+	FieldOrigSendingTime:             "OrigSendingTime",
+	FieldGapFillFlag:                 "GapFillFlag",
+	FieldExpireTime:                  "ExpireTime",
+	FieldDeliverToCompID:             "DeliverToCompID",
+	FieldDeliverToSubID:              "DeliverToSubID",
+	FieldResetSeqNumFlag:             "ResetSeqNumFlag",
+	FieldSenderLocationID:            "SenderLocationID",
+	FieldTargetLocationID:            "TargetLocationID",
+	FieldOnBehalfOfLocationID:        "OnBehalfOfLocationID",
+	FieldDeliverToLocationID:         "DeliverToLocationID",
+	FieldNoRelatedSym:                "NoRelatedSym",
+	FieldSecurityType:                "SecurityType",
+	FieldMaturityMonthYear:           "MaturityMonthYear",
+	FieldStrikePrice:                 "StrikePrice",
+	FieldOptAttribute:                "OptAttribute",
+	FieldSecurityExchange:            "SecurityExchange",
+	FieldXmlDataLen:                  "XmlDataLen",
+	FieldXmlData:                     "XmlData",
+	FieldCouponRate:                  "CouponRate",
+	FieldCouponPaymentDate:           "CouponPaymentDate",
+	FieldIssueDate:                   "IssueDate",
+	FieldRepurchaseTerm:              "RepurchaseTerm",
+	FieldRepurchaseRate:              "RepurchaseRate",
+	FieldFactor:                      "Factor",
+	FieldContractMultiplier:          "ContractMultiplier",
+	FieldRepoCollateralSecurityType:  "RepoCollateralSecurityType",
+	FieldRedemptionDate:              "RedemptionDate",
+	FieldUnderlyingCouponPaymentDate: "UnderlyingCouponPaymentDate",
+	FieldUnderlyingIssueDate:         "UnderlyingIssueDate",
+	FieldUnderlyingRepoCollateralSecurityType: "UnderlyingRepoCollateralSecurityType",
+	FieldUnderlyingRepurchaseTerm:             "UnderlyingRepurchaseTerm",
+	FieldUnderlyingRepurchaseRate:             "UnderlyingRepurchaseRate",
+	FieldUnderlyingFactor:                     "UnderlyingFactor",
+	FieldUnderlyingRedemptionDate:             "UnderlyingRedemptionDate",
+	FieldLegCouponPaymentDate:                 "LegCouponPaymentDate",
+	FieldLegIssueDate:                         "LegIssueDate",
+	FieldLegRepoCollateralSecurityType:        "LegRepoCollateralSecurityType",
+	FieldLegRepurchaseTerm:                    "LegRepurchaseTerm",
+	FieldLegRepurchaseRate:                    "LegRepurchaseRate",
+	FieldLegFactor:                            "LegFactor",
+	FieldLegRedemptionDate:                    "LegRedemptionDate",
+	FieldCreditRating:                         "CreditRating",
+	FieldUnderlyingCreditRating:               "UnderlyingCreditRating",
+	FieldLegCreditRating:                      "LegCreditRating",
+	FieldMDReqID:                              "MDReqID",
+	FieldSubscriptionRequestType:              "SubscriptionRequestType",
+	FieldMarketDepth:                          "MarketDepth",
+	FieldMDUpdateType:                         "MDUpdateType",
+	FieldAggregatedBook:                       "AggregatedBook",
+	FieldNoMDEntryTypes:                       "NoMDEntryTypes",
+	FieldNoMDEntries:                          "NoMDEntries",
+	FieldMDEntryType:                          "MDEntryType",
+	FieldMDEntryPx:                            "MDEntryPx",
+	FieldMDEntrySize:                          "MDEntrySize",
+	FieldMDEntryDate:                          "MDEntryDate",
+	FieldMDEntryTime:                          "MDEntryTime",
+	FieldTickDirection:                        "TickDirection",
+	FieldMDMkt:                                "MDMkt",
+	FieldQuoteCondition:                       "QuoteCondition",
+	FieldTradeCondition:                       "TradeCondition",
+	FieldMDEntryID:                            "MDEntryID",
+	FieldMDUpdateAction:                       "MDUpdateAction",
+	FieldMDEntryRefID:                         "MDEntryRefID",
+	FieldMDReqRejReason:                       "MDReqRejReason",
+	FieldMDEntryOriginator:                    "MDEntryOriginator",
+	FieldLocationID:                           "LocationID",
+	FieldDeskID:                               "DeskID",
+	FieldDeleteReason:                         "DeleteReason",
+	FieldOpenCloseSettlFlag:                   "OpenCloseSettlFlag",
+	FieldSellerDays:                           "SellerDays",
+	FieldMDEntryBuyer:                         "MDEntryBuyer",
+	FieldMDEntrySeller:                        "MDEntrySeller",
+	FieldMDEntryPositionNo:                    "MDEntryPositionNo",
+	FieldFinancialStatus:                      "FinancialStatus",
+	FieldCorporateAction:                      "CorporateAction",
+	FieldQuoteEntryID:                         "QuoteEntryID",
+	FieldUnderlyingSecurityIDSource:           "UnderlyingSecurityIDSource",
+	FieldUnderlyingIssuer:                     "UnderlyingIssuer",
+	FieldUnderlyingSecurityDesc:               "UnderlyingSecurityDesc",
+	FieldUnderlyingSecurityExchange:           "UnderlyingSecurityExchange",
+	FieldUnderlyingSecurityID:                 "UnderlyingSecurityID",
+	FieldUnderlyingSecurityType:               "UnderlyingSecurityType",
+	FieldUnderlyingSymbol:                     "UnderlyingSymbol",
+	FieldUnderlyingSymbolSfx:                  "UnderlyingSymbolSfx",
+	FieldUnderlyingMaturityMonthYear:          "UnderlyingMaturityMonthYear",
+	FieldUnderlyingStrikePrice:                "UnderlyingStrikePrice",
+	FieldUnderlyingOptAttribute:               "UnderlyingOptAttribute",
+	FieldUnderlyingCurrency:                   "UnderlyingCurrency",
+	FieldTradingSessionID:                     "TradingSessionID",
+	FieldNumberOfOrders:                       "NumberOfOrders",
+	FieldMessageEncoding:                      "MessageEncoding",
+	FieldEncodedIssuerLen:                     "EncodedIssuerLen",
+	FieldEncodedIssuer:                        "EncodedIssuer",
+	FieldEncodedSecurityDescLen:               "EncodedSecurityDescLen",
+	FieldEncodedSecurityDesc:                  "EncodedSecurityDesc",
+	FieldEncodedTextLen:                       "EncodedTextLen",
+	FieldEncodedText:                          "EncodedText",
+	FieldEncodedUnderlyingIssuerLen:           "EncodedUnderlyingIssuerLen",
+	FieldEncodedUnderlyingIssuer:              "EncodedUnderlyingIssuer",
+	FieldEncodedUnderlyingSecurityDescLen:     "EncodedUnderlyingSecurityDescLen",
+	FieldEncodedUnderlyingSecurityDesc:        "EncodedUnderlyingSecurityDesc",
+	FieldLastMsgSeqNumProcessed:               "LastMsgSeqNumProcessed",
+	FieldRefTagID:                             "RefTagID",
+	FieldRefMsgType:                           "RefMsgType",
+	FieldSessionRejectReason:                  "SessionRejectReason",
+	FieldMaxMessageSize:                       "MaxMessageSize",
+	FieldNoMsgTypes:                           "NoMsgTypes",
+	FieldMsgDirection:                         "MsgDirection",
+	FieldNoTradingSessions:                    "NoTradingSessions",
+	FieldExpireDate:                           "ExpireDate",
+	FieldUnderlyingCouponRate:                 "UnderlyingCouponRate",
+	FieldUnderlyingContractMultiplier:         "UnderlyingContractMultiplier",
+	FieldNetChgPrevDay:                        "NetChgPrevDay",
+	FieldNoSecurityAltID:                      "NoSecurityAltID",
+	FieldSecurityAltID:                        "SecurityAltID",
+	FieldSecurityAltIDSource:                  "SecurityAltIDSource",
+	FieldNoUnderlyingSecurityAltID:            "NoUnderlyingSecurityAltID",
+	FieldUnderlyingSecurityAltID:              "UnderlyingSecurityAltID",
+	FieldUnderlyingSecurityAltIDSource:        "UnderlyingSecurityAltIDSource",
+	FieldProduct:                              "Product",
+	FieldCFICode:                              "CFICode",
+	FieldUnderlyingProduct:                    "UnderlyingProduct",
+	FieldUnderlyingCFICode:                    "UnderlyingCFICode",
+	FieldTestMessageIndicator:                 "TestMessageIndicator",
+	FieldCountryOfIssue:                       "CountryOfIssue",
+	FieldStateOrProvinceOfIssue:               "StateOrProvinceOfIssue",
+	FieldLocaleOfIssue:                        "LocaleOfIssue",
+	FieldMaturityDate:                         "MaturityDate",
+	FieldUnderlyingMaturityDate:               "UnderlyingMaturityDate",
+	FieldInstrRegistry:                        "InstrRegistry",
+	FieldScope:                                "Scope",
+	FieldMDImplicitDelete:                     "MDImplicitDelete",
+	FieldUsername:                             "Username",
+	FieldPassword:                             "Password",
+	FieldNoLegs:                               "NoLegs",
+	FieldLegCurrency:                          "LegCurrency",
+	FieldUnderlyingCountryOfIssue:             "UnderlyingCountryOfIssue",
+	FieldUnderlyingStateOrProvinceOfIssue:     "UnderlyingStateOrProvinceOfIssue",
+	FieldUnderlyingLocaleOfIssue:              "UnderlyingLocaleOfIssue",
+	FieldUnderlyingInstrRegistry:              "UnderlyingInstrRegistry",
+	FieldLegCountryOfIssue:                    "LegCountryOfIssue",
+	FieldLegStateOrProvinceOfIssue:            "LegStateOrProvinceOfIssue",
+	FieldLegLocaleOfIssue:                     "LegLocaleOfIssue",
+	FieldLegInstrRegistry:                     "LegInstrRegistry",
+	FieldLegSymbol:                            "LegSymbol",
+	FieldLegSymbolSfx:                         "LegSymbolSfx",
+	FieldLegSecurityID:                        "LegSecurityID",
+	FieldLegSecurityIDSource:                  "LegSecurityIDSource",
+	FieldNoLegSecurityAltID:                   "NoLegSecurityAltID",
+	FieldLegSecurityAltID:                     "LegSecurityAltID",
+	FieldLegSecurityAltIDSource:               "LegSecurityAltIDSource",
+	FieldLegProduct:                           "LegProduct",
+	FieldLegCFICode:                           "LegCFICode",
+	FieldLegSecurityType:                      "LegSecurityType",
+	FieldLegMaturityMonthYear:                 "LegMaturityMonthYear",
+	FieldLegMaturityDate:                      "LegMaturityDate",
+	FieldLegStrikePrice:                       "LegStrikePrice",
+	FieldLegOptAttribute:                      "LegOptAttribute",
+	FieldLegContractMultiplier:                "LegContractMultiplier",
+	FieldLegCouponRate:                        "LegCouponRate",
+	FieldLegSecurityExchange:                  "LegSecurityExchange",
+	FieldLegIssuer:                            "LegIssuer",
+	FieldEncodedLegIssuerLen:                  "EncodedLegIssuerLen",
+	FieldEncodedLegIssuer:                     "EncodedLegIssuer",
+	FieldLegSecurityDesc:                      "LegSecurityDesc",
+	FieldEncodedLegSecurityDescLen:            "EncodedLegSecurityDescLen",
+	FieldEncodedLegSecurityDesc:               "EncodedLegSecurityDesc",
+	FieldLegRatioQty:                          "LegRatioQty",
+	FieldLegSide:                              "LegSide",
+	FieldTradingSessionSubID:                  "TradingSessionSubID",
+	FieldNoHops:                               "NoHops",
+	FieldHopCompID:                            "HopCompID",
+	FieldHopSendingTime:                       "HopSendingTime",
+	FieldHopRefID:                             "HopRefID",
+	FieldContractSettlMonth:                   "ContractSettlMonth",
+	FieldPool:                                 "Pool",
+	FieldNoUnderlyings:                        "NoUnderlyings",
+	FieldLegDatedDate:                         "LegDatedDate",
+	FieldLegPool:                              "LegPool",
+	FieldSecuritySubType:                      "SecuritySubType",
+	FieldUnderlyingSecuritySubType:            "UnderlyingSecuritySubType",
+	FieldLegSecuritySubType:                   "LegSecuritySubType",
+	FieldNextExpectedMsgSeqNum:                "NextExpectedMsgSeqNum",
+	FieldUnderlyingPx:                         "UnderlyingPx",
+	FieldPriceDelta:                           "PriceDelta",
+	FieldApplQueueMax:                         "ApplQueueMax",
+	FieldApplQueueDepth:                       "ApplQueueDepth",
+	FieldApplQueueResolution:                  "ApplQueueResolution",
+	FieldApplQueueAction:                      "ApplQueueAction",
+	FieldNoAltMDSource:                        "NoAltMDSource",
+	FieldAltMDSourceID:                        "AltMDSourceID",
+	FieldNoEvents:                             "NoEvents",
+	FieldEventType:                            "EventType",
+	FieldEventDate:                            "EventDate",
+	FieldEventPx:                              "EventPx",
+	FieldEventText:                            "EventText",
+	FieldDatedDate:                            "DatedDate",
+	FieldInterestAccrualDate:                  "InterestAccrualDate",
+	FieldCPProgram:                            "CPProgram",
+	FieldCPRegType:                            "CPRegType",
+	FieldUnderlyingCPProgram:                  "UnderlyingCPProgram",
+	FieldUnderlyingCPRegType:                  "UnderlyingCPRegType",
+	FieldUnderlyingQty:                        "UnderlyingQty",
+	FieldUnderlyingDirtyPrice:                 "UnderlyingDirtyPrice",
+	FieldUnderlyingEndPrice:                   "UnderlyingEndPrice",
+	FieldUnderlyingStartValue:                 "UnderlyingStartValue",
+	FieldUnderlyingCurrentValue:               "UnderlyingCurrentValue",
+	FieldUnderlyingEndValue:                   "UnderlyingEndValue",
+	FieldNoUnderlyingStips:                    "NoUnderlyingStips",
+	FieldUnderlyingStipType:                   "UnderlyingStipType",
+	FieldUnderlyingStipValue:                  "UnderlyingStipValue",
+	FieldUnderlyingStrikeCurrency:             "UnderlyingStrikeCurrency",
+	FieldLegStrikeCurrency:                    "LegStrikeCurrency",
+	FieldStrikeCurrency:                       "StrikeCurrency",
+	FieldLegContractSettlMonth:                "LegContractSettlMonth",
+	FieldLegInterestAccrualDate:               "LegInterestAccrualDate",
+}
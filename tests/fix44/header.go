@@ -329,7 +329,7 @@ func (header *Header) SetLastMsgSeqNumProcessed(lastMsgSeqNumProcessed int) *Hea
 func (header *Header) HopsGrp() *HopsGrp {
 	group := header.Get(23).(*fix.Group)
 
-	return &HopsGrp{group}
+	return &HopsGrp{Group: group}
 }
 
 func (header *Header) SetHopsGrp(noHops *HopsGrp) *Header {
@@ -342,18 +342,26 @@ func (Header) New() messages.HeaderBuilder {
 	return makeHeader()
 }
 
+func (header *Header) SetFieldMsgSeqNum(msgSeqNum int) messages.HeaderBuilder {
+	return header.SetMsgSeqNum(msgSeqNum)
+}
+
 func (header *Header) SetFieldSenderCompID(senderCompID string) messages.HeaderBuilder {
 	return header.SetSenderCompID(senderCompID)
 }
 
+func (header *Header) SetFieldSendingTime(sendingTime string) messages.HeaderBuilder {
+	return header.SetSendingTime(sendingTime)
+}
+
 func (header *Header) SetFieldTargetCompID(targetCompID string) messages.HeaderBuilder {
 	return header.SetTargetCompID(targetCompID)
 }
 
-func (header *Header) SetFieldMsgSeqNum(msgSeqNum int) messages.HeaderBuilder {
-	return header.SetMsgSeqNum(msgSeqNum)
+func (header *Header) SetFieldOrigSendingTime(origSendingTime string) messages.HeaderBuilder {
+	return header.SetOrigSendingTime(origSendingTime)
 }
 
-func (header *Header) SetFieldSendingTime(sendingTime string) messages.HeaderBuilder {
-	return header.SetSendingTime(sendingTime)
+func (header *Header) SetFieldPossDupFlag(possDupFlag bool) messages.HeaderBuilder {
+	return header.SetPossDupFlag(possDupFlag)
 }
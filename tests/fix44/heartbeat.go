@@ -46,7 +46,7 @@ func NewHeartbeat() *Heartbeat {
 func (heartbeat *Heartbeat) Header() *Header {
 	header := heartbeat.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (heartbeat *Heartbeat) HeaderBuilder() messages.HeaderBuilder {
@@ -56,7 +56,7 @@ func (heartbeat *Heartbeat) HeaderBuilder() messages.HeaderBuilder {
 func (heartbeat *Heartbeat) Trailer() *Trailer {
 	trailer := heartbeat.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 func (heartbeat *Heartbeat) TestReqID() string {
@@ -71,6 +71,35 @@ func (heartbeat *Heartbeat) SetTestReqID(testReqID string) *Heartbeat {
 	return heartbeat
 }
 
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (heartbeat *Heartbeat) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (heartbeat *Heartbeat) MarshalJSON() ([]byte, error) {
+	return heartbeat.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (heartbeat *Heartbeat) RedactedJSON() ([]byte, error) {
+	return heartbeat.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (heartbeat *Heartbeat) UnmarshalJSON(data []byte) error {
+	msg := makeHeartbeat()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*heartbeat = *msg
+
+	return nil
+}
+
 // New is a plane message constructor
 func (Heartbeat) New() messages.HeartbeatBuilder {
 	return makeHeartbeat()
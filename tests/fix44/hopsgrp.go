@@ -36,6 +36,17 @@ func (group *HopsGrp) Entries() []*HopsEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *HopsGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type HopsEntry struct {
 	*fix.Component
 }
@@ -87,3 +98,10 @@ func (hopsEntry *HopsEntry) SetHopRefID(hopRefID int) *HopsEntry {
 	_ = kv.Load().Set(hopRefID)
 	return hopsEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (hopsEntry *HopsEntry) Validate() error {
+	return nil
+}
@@ -13,13 +13,13 @@ type Instrument struct {
 func makeInstrument() *Instrument {
 	return &Instrument{fix.NewComponent(
 		fix.NewKeyValue(FieldSymbol, &fix.String{}),
-		fix.NewKeyValue(FieldSymbolSfx, &fix.String{}),
+		fix.NewKeyValue(FieldSymbolSfx, &fix.Enum[EnumSymbolSfx]{}),
 		fix.NewKeyValue(FieldSecurityID, &fix.String{}),
-		fix.NewKeyValue(FieldSecurityIDSource, &fix.String{}),
+		fix.NewKeyValue(FieldSecurityIDSource, &fix.Enum[EnumSecurityIDSource]{}),
 		NewSecurityAltIDGrp().Group,
-		fix.NewKeyValue(FieldProduct, &fix.String{}),
+		fix.NewKeyValue(FieldProduct, &fix.Enum[EnumProduct]{}),
 		fix.NewKeyValue(FieldCFICode, &fix.String{}),
-		fix.NewKeyValue(FieldSecurityType, &fix.String{}),
+		fix.NewKeyValue(FieldSecurityType, &fix.Enum[EnumSecurityType]{}),
 		fix.NewKeyValue(FieldSecuritySubType, &fix.String{}),
 		fix.NewKeyValue(FieldMaturityMonthYear, &fix.String{}),
 		fix.NewKeyValue(FieldMaturityDate, &fix.String{}),
@@ -30,7 +30,7 @@ func makeInstrument() *Instrument {
 		fix.NewKeyValue(FieldRepurchaseRate, &fix.Float{}),
 		fix.NewKeyValue(FieldFactor, &fix.Float{}),
 		fix.NewKeyValue(FieldCreditRating, &fix.String{}),
-		fix.NewKeyValue(FieldInstrRegistry, &fix.String{}),
+		fix.NewKeyValue(FieldInstrRegistry, &fix.Enum[EnumInstrRegistry]{}),
 		fix.NewKeyValue(FieldCountryOfIssue, &fix.String{}),
 		fix.NewKeyValue(FieldStateOrProvinceOfIssue, &fix.String{}),
 		fix.NewKeyValue(FieldLocaleOfIssue, &fix.String{}),
@@ -49,7 +49,7 @@ func makeInstrument() *Instrument {
 		fix.NewKeyValue(FieldEncodedSecurityDesc, &fix.String{}),
 		fix.NewKeyValue(FieldPool, &fix.String{}),
 		fix.NewKeyValue(FieldContractSettlMonth, &fix.String{}),
-		fix.NewKeyValue(FieldCPProgram, &fix.String{}),
+		fix.NewKeyValue(FieldCPProgram, &fix.Enum[EnumCPProgram]{}),
 		fix.NewKeyValue(FieldCPRegType, &fix.String{}),
 		NewEventsGrp().Group,
 		fix.NewKeyValue(FieldDatedDate, &fix.String{}),
@@ -73,13 +73,13 @@ func (instrument *Instrument) SetSymbol(symbol string) *Instrument {
 	return instrument
 }
 
-func (instrument *Instrument) SymbolSfx() string {
+func (instrument *Instrument) SymbolSfx() EnumSymbolSfx {
 	kv := instrument.Get(1)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumSymbolSfx)
 }
 
-func (instrument *Instrument) SetSymbolSfx(symbolSfx string) *Instrument {
+func (instrument *Instrument) SetSymbolSfx(symbolSfx EnumSymbolSfx) *Instrument {
 	kv := instrument.Get(1).(*fix.KeyValue)
 	_ = kv.Load().Set(symbolSfx)
 	return instrument
@@ -97,13 +97,13 @@ func (instrument *Instrument) SetSecurityID(securityID string) *Instrument {
 	return instrument
 }
 
-func (instrument *Instrument) SecurityIDSource() string {
+func (instrument *Instrument) SecurityIDSource() EnumSecurityIDSource {
 	kv := instrument.Get(3)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumSecurityIDSource)
 }
 
-func (instrument *Instrument) SetSecurityIDSource(securityIDSource string) *Instrument {
+func (instrument *Instrument) SetSecurityIDSource(securityIDSource EnumSecurityIDSource) *Instrument {
 	kv := instrument.Get(3).(*fix.KeyValue)
 	_ = kv.Load().Set(securityIDSource)
 	return instrument
@@ -112,7 +112,7 @@ func (instrument *Instrument) SetSecurityIDSource(securityIDSource string) *Inst
 func (instrument *Instrument) SecurityAltIDGrp() *SecurityAltIDGrp {
 	group := instrument.Get(4).(*fix.Group)
 
-	return &SecurityAltIDGrp{group}
+	return &SecurityAltIDGrp{Group: group}
 }
 
 func (instrument *Instrument) SetSecurityAltIDGrp(noSecurityAltID *SecurityAltIDGrp) *Instrument {
@@ -121,13 +121,13 @@ func (instrument *Instrument) SetSecurityAltIDGrp(noSecurityAltID *SecurityAltID
 	return instrument
 }
 
-func (instrument *Instrument) Product() string {
+func (instrument *Instrument) Product() EnumProduct {
 	kv := instrument.Get(5)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumProduct)
 }
 
-func (instrument *Instrument) SetProduct(product string) *Instrument {
+func (instrument *Instrument) SetProduct(product EnumProduct) *Instrument {
 	kv := instrument.Get(5).(*fix.KeyValue)
 	_ = kv.Load().Set(product)
 	return instrument
@@ -145,13 +145,13 @@ func (instrument *Instrument) SetCFICode(cFICode string) *Instrument {
 	return instrument
 }
 
-func (instrument *Instrument) SecurityType() string {
+func (instrument *Instrument) SecurityType() EnumSecurityType {
 	kv := instrument.Get(7)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumSecurityType)
 }
 
-func (instrument *Instrument) SetSecurityType(securityType string) *Instrument {
+func (instrument *Instrument) SetSecurityType(securityType EnumSecurityType) *Instrument {
 	kv := instrument.Get(7).(*fix.KeyValue)
 	_ = kv.Load().Set(securityType)
 	return instrument
@@ -277,13 +277,13 @@ func (instrument *Instrument) SetCreditRating(creditRating string) *Instrument {
 	return instrument
 }
 
-func (instrument *Instrument) InstrRegistry() string {
+func (instrument *Instrument) InstrRegistry() EnumInstrRegistry {
 	kv := instrument.Get(18)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumInstrRegistry)
 }
 
-func (instrument *Instrument) SetInstrRegistry(instrRegistry string) *Instrument {
+func (instrument *Instrument) SetInstrRegistry(instrRegistry EnumInstrRegistry) *Instrument {
 	kv := instrument.Get(18).(*fix.KeyValue)
 	_ = kv.Load().Set(instrRegistry)
 	return instrument
@@ -505,13 +505,13 @@ func (instrument *Instrument) SetContractSettlMonth(contractSettlMonth string) *
 	return instrument
 }
 
-func (instrument *Instrument) CPProgram() string {
+func (instrument *Instrument) CPProgram() EnumCPProgram {
 	kv := instrument.Get(37)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumCPProgram)
 }
 
-func (instrument *Instrument) SetCPProgram(cPProgram string) *Instrument {
+func (instrument *Instrument) SetCPProgram(cPProgram EnumCPProgram) *Instrument {
 	kv := instrument.Get(37).(*fix.KeyValue)
 	_ = kv.Load().Set(cPProgram)
 	return instrument
@@ -532,7 +532,7 @@ func (instrument *Instrument) SetCPRegType(cPRegType string) *Instrument {
 func (instrument *Instrument) EventsGrp() *EventsGrp {
 	group := instrument.Get(39).(*fix.Group)
 
-	return &EventsGrp{group}
+	return &EventsGrp{Group: group}
 }
 
 func (instrument *Instrument) SetEventsGrp(noEvents *EventsGrp) *Instrument {
@@ -564,3 +564,18 @@ func (instrument *Instrument) SetInterestAccrualDate(interestAccrualDate string)
 	_ = kv.Load().Set(interestAccrualDate)
 	return instrument
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (instrument *Instrument) Validate() error {
+	if err := instrument.SecurityAltIDGrp().Validate(); err != nil {
+		return err
+	}
+
+	if err := instrument.EventsGrp().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -112,7 +112,7 @@ func (instrumentLeg *InstrumentLeg) SetLegSecurityIDSource(legSecurityIDSource s
 func (instrumentLeg *InstrumentLeg) LegSecurityAltIDGrp() *LegSecurityAltIDGrp {
 	group := instrumentLeg.Get(4).(*fix.Group)
 
-	return &LegSecurityAltIDGrp{group}
+	return &LegSecurityAltIDGrp{Group: group}
 }
 
 func (instrumentLeg *InstrumentLeg) SetLegSecurityAltIDGrp(noLegSecurityAltID *LegSecurityAltIDGrp) *InstrumentLeg {
@@ -564,3 +564,14 @@ func (instrumentLeg *InstrumentLeg) SetLegInterestAccrualDate(legInterestAccrual
 	_ = kv.Load().Set(legInterestAccrualDate)
 	return instrumentLeg
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (instrumentLeg *InstrumentLeg) Validate() error {
+	if err := instrumentLeg.LegSecurityAltIDGrp().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -35,6 +35,17 @@ func (group *LegSecurityAltIDGrp) Entries() []*LegSecurityAltIDEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *LegSecurityAltIDGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type LegSecurityAltIDEntry struct {
 	*fix.Component
 }
@@ -73,3 +84,10 @@ func (legSecurityAltIDEntry *LegSecurityAltIDEntry) SetLegSecurityAltIDSource(le
 	_ = kv.Load().Set(legSecurityAltIDSource)
 	return legSecurityAltIDEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (legSecurityAltIDEntry *LegSecurityAltIDEntry) Validate() error {
+	return nil
+}
@@ -34,6 +34,17 @@ func (group *LegsGrp) Entries() []*LegsEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *LegsGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type LegsEntry struct {
 	*fix.Component
 }
@@ -51,7 +62,7 @@ func NewLegsEntry() *LegsEntry {
 func (legsEntry *LegsEntry) InstrumentLeg() *InstrumentLeg {
 	component := legsEntry.Get(0).(*fix.Component)
 
-	return &InstrumentLeg{component}
+	return &InstrumentLeg{Component: component}
 }
 
 func (legsEntry *LegsEntry) SetInstrumentLeg(instrumentLeg *InstrumentLeg) *LegsEntry {
@@ -59,3 +70,16 @@ func (legsEntry *LegsEntry) SetInstrumentLeg(instrumentLeg *InstrumentLeg) *Legs
 
 	return legsEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (legsEntry *LegsEntry) Validate() error {
+	if fix.IsSet(legsEntry.Get(0)) {
+		if err := legsEntry.InstrumentLeg().Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -17,7 +17,7 @@ func makeLogon() *Logon {
 	msg := &Logon{
 		Message: fix.NewMessage(FieldBeginString, FieldBodyLength, FieldCheckSum, FieldMsgType, beginString, MsgTypeLogon).
 			SetBody(
-				fix.NewKeyValue(FieldEncryptMethod, &fix.String{}),
+				fix.NewKeyValue(FieldEncryptMethod, &fix.Enum[EnumEncryptMethod]{}),
 				fix.NewKeyValue(FieldHeartBtInt, &fix.Int{}),
 				fix.NewKeyValue(FieldRawDataLength, &fix.Int{}),
 				fix.NewKeyValue(FieldRawData, &fix.String{}),
@@ -37,7 +37,7 @@ func makeLogon() *Logon {
 	return msg
 }
 
-func CreateLogon(encryptMethod string, heartBtInt int) *Logon {
+func CreateLogon(encryptMethod EnumEncryptMethod, heartBtInt int) *Logon {
 	msg := makeLogon().
 		SetEncryptMethod(encryptMethod).
 		SetHeartBtInt(heartBtInt)
@@ -58,7 +58,7 @@ func NewLogon() *Logon {
 func (logon *Logon) Header() *Header {
 	header := logon.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (logon *Logon) HeaderBuilder() messages.HeaderBuilder {
@@ -68,16 +68,16 @@ func (logon *Logon) HeaderBuilder() messages.HeaderBuilder {
 func (logon *Logon) Trailer() *Trailer {
 	trailer := logon.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
-func (logon *Logon) EncryptMethod() string {
+func (logon *Logon) EncryptMethod() EnumEncryptMethod {
 	kv := logon.Get(0)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumEncryptMethod)
 }
 
-func (logon *Logon) SetEncryptMethod(encryptMethod string) *Logon {
+func (logon *Logon) SetEncryptMethod(encryptMethod EnumEncryptMethod) *Logon {
 	kv := logon.Get(0).(*fix.KeyValue)
 	_ = kv.Load().Set(encryptMethod)
 	return logon
@@ -158,7 +158,7 @@ func (logon *Logon) SetMaxMessageSize(maxMessageSize int) *Logon {
 func (logon *Logon) MsgTypesGrp() *MsgTypesGrp {
 	group := logon.Get(7).(*fix.Group)
 
-	return &MsgTypesGrp{group}
+	return &MsgTypesGrp{Group: group}
 }
 
 func (logon *Logon) SetMsgTypesGrp(noMsgTypes *MsgTypesGrp) *Logon {
@@ -203,6 +203,47 @@ func (logon *Logon) SetPassword(password string) *Logon {
 	return logon
 }
 
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (logon *Logon) Validate() error {
+	if !fix.IsSet(logon.Get(0)) {
+		return fix.NewValidationError(FieldEncryptMethod, "EncryptMethod", "")
+	}
+
+	if !fix.IsSet(logon.Get(1)) {
+		return fix.NewValidationError(FieldHeartBtInt, "HeartBtInt", "")
+	}
+
+	if err := logon.MsgTypesGrp().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (logon *Logon) MarshalJSON() ([]byte, error) {
+	return logon.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (logon *Logon) RedactedJSON() ([]byte, error) {
+	return logon.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (logon *Logon) UnmarshalJSON(data []byte) error {
+	msg := makeLogon()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*logon = *msg
+
+	return nil
+}
+
 // New is a plane message constructor
 func (Logon) New() messages.LogonBuilder {
 	return makeLogon()
@@ -218,7 +259,11 @@ func (logon *Logon) SetFieldHeartBtInt(heartBtInt int) messages.LogonBuilder {
 }
 
 func (logon *Logon) SetFieldEncryptMethod(encryptMethod string) messages.LogonBuilder {
-	return logon.SetEncryptMethod(encryptMethod)
+	return logon.SetEncryptMethod(EnumEncryptMethod(encryptMethod))
+}
+
+func (logon *Logon) FieldEncryptMethod() string {
+	return string(logon.EncryptMethod())
 }
 
 func (logon *Logon) SetFieldPassword(password string) messages.LogonBuilder {
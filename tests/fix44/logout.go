@@ -48,7 +48,7 @@ func NewLogout() *Logout {
 func (logout *Logout) Header() *Header {
 	header := logout.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (logout *Logout) HeaderBuilder() messages.HeaderBuilder {
@@ -58,7 +58,7 @@ func (logout *Logout) HeaderBuilder() messages.HeaderBuilder {
 func (logout *Logout) Trailer() *Trailer {
 	trailer := logout.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 func (logout *Logout) Text() string {
@@ -97,6 +97,35 @@ func (logout *Logout) SetEncodedText(encodedText string) *Logout {
 	return logout
 }
 
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (logout *Logout) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (logout *Logout) MarshalJSON() ([]byte, error) {
+	return logout.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (logout *Logout) RedactedJSON() ([]byte, error) {
+	return logout.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (logout *Logout) UnmarshalJSON(data []byte) error {
+	msg := makeLogout()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*logout = *msg
+
+	return nil
+}
+
 // New is a plane message constructor
 func (Logout) New() messages.LogoutBuilder {
 	return makeLogout()
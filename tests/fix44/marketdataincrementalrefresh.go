@@ -20,7 +20,7 @@ func makeMarketDataIncrementalRefresh() *MarketDataIncrementalRefresh {
 				fix.NewKeyValue(FieldMDReqID, &fix.String{}),
 				NewMDEntriesGrp().Group,
 				fix.NewKeyValue(FieldApplQueueDepth, &fix.Int{}),
-				fix.NewKeyValue(FieldApplQueueResolution, &fix.String{}),
+				fix.NewKeyValue(FieldApplQueueResolution, &fix.Enum[EnumApplQueueResolution]{}),
 			),
 	}
 
@@ -50,7 +50,7 @@ func NewMarketDataIncrementalRefresh() *MarketDataIncrementalRefresh {
 func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) Header() *Header {
 	header := marketDataIncrementalRefresh.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) HeaderBuilder() messages.HeaderBuilder {
@@ -60,7 +60,7 @@ func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) HeaderBuilder(
 func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) Trailer() *Trailer {
 	trailer := marketDataIncrementalRefresh.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) MDReqID() string {
@@ -78,7 +78,7 @@ func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) SetMDReqID(mDR
 func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) MDEntriesGrp() *MDEntriesGrp {
 	group := marketDataIncrementalRefresh.Get(1).(*fix.Group)
 
-	return &MDEntriesGrp{group}
+	return &MDEntriesGrp{Group: group}
 }
 
 func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) SetMDEntriesGrp(noMDEntries *MDEntriesGrp) *MarketDataIncrementalRefresh {
@@ -99,14 +99,51 @@ func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) SetApplQueueDe
 	return marketDataIncrementalRefresh
 }
 
-func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) ApplQueueResolution() string {
+func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) ApplQueueResolution() EnumApplQueueResolution {
 	kv := marketDataIncrementalRefresh.Get(3)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumApplQueueResolution)
 }
 
-func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) SetApplQueueResolution(applQueueResolution string) *MarketDataIncrementalRefresh {
+func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) SetApplQueueResolution(applQueueResolution EnumApplQueueResolution) *MarketDataIncrementalRefresh {
 	kv := marketDataIncrementalRefresh.Get(3).(*fix.KeyValue)
 	_ = kv.Load().Set(applQueueResolution)
 	return marketDataIncrementalRefresh
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) Validate() error {
+	if !fix.IsSet(marketDataIncrementalRefresh.Get(1)) {
+		return fix.NewValidationError(FieldNoMDEntries, "NoMDEntries", "")
+	}
+
+	if err := marketDataIncrementalRefresh.MDEntriesGrp().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) MarshalJSON() ([]byte, error) {
+	return marketDataIncrementalRefresh.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) RedactedJSON() ([]byte, error) {
+	return marketDataIncrementalRefresh.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (marketDataIncrementalRefresh *MarketDataIncrementalRefresh) UnmarshalJSON(data []byte) error {
+	msg := makeMarketDataIncrementalRefresh()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*marketDataIncrementalRefresh = *msg
+
+	return nil
+}
@@ -18,12 +18,12 @@ func makeMarketDataRequest() *MarketDataRequest {
 		Message: fix.NewMessage(FieldBeginString, FieldBodyLength, FieldCheckSum, FieldMsgType, beginString, MsgTypeMarketDataRequest).
 			SetBody(
 				fix.NewKeyValue(FieldMDReqID, &fix.String{}),
-				fix.NewKeyValue(FieldSubscriptionRequestType, &fix.String{}),
+				fix.NewKeyValue(FieldSubscriptionRequestType, &fix.Enum[EnumSubscriptionRequestType]{}),
 				fix.NewKeyValue(FieldMarketDepth, &fix.Int{}),
-				fix.NewKeyValue(FieldMDUpdateType, &fix.String{}),
+				fix.NewKeyValue(FieldMDUpdateType, &fix.Enum[EnumMDUpdateType]{}),
 				fix.NewKeyValue(FieldAggregatedBook, &fix.Bool{}),
-				fix.NewKeyValue(FieldOpenCloseSettlFlag, &fix.String{}),
-				fix.NewKeyValue(FieldScope, &fix.String{}),
+				fix.NewKeyValue(FieldOpenCloseSettlFlag, &fix.Enum[EnumOpenCloseSettlFlag]{}),
+				fix.NewKeyValue(FieldScope, &fix.Enum[EnumScope]{}),
 				fix.NewKeyValue(FieldMDImplicitDelete, &fix.Bool{}),
 				NewMDEntryTypesGrp().Group,
 				NewRelatedSymGrp().Group,
@@ -36,7 +36,7 @@ func makeMarketDataRequest() *MarketDataRequest {
 	return msg
 }
 
-func CreateMarketDataRequest(mDReqID string, subscriptionRequestType string, marketDepth int, noMDEntryTypes *MDEntryTypesGrp, noRelatedSym *RelatedSymGrp) *MarketDataRequest {
+func CreateMarketDataRequest(mDReqID string, subscriptionRequestType EnumSubscriptionRequestType, marketDepth int, noMDEntryTypes *MDEntryTypesGrp, noRelatedSym *RelatedSymGrp) *MarketDataRequest {
 	msg := makeMarketDataRequest().
 		SetMDReqID(mDReqID).
 		SetSubscriptionRequestType(subscriptionRequestType).
@@ -60,7 +60,7 @@ func NewMarketDataRequest() *MarketDataRequest {
 func (marketDataRequest *MarketDataRequest) Header() *Header {
 	header := marketDataRequest.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (marketDataRequest *MarketDataRequest) HeaderBuilder() messages.HeaderBuilder {
@@ -70,7 +70,7 @@ func (marketDataRequest *MarketDataRequest) HeaderBuilder() messages.HeaderBuild
 func (marketDataRequest *MarketDataRequest) Trailer() *Trailer {
 	trailer := marketDataRequest.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 func (marketDataRequest *MarketDataRequest) MDReqID() string {
@@ -85,13 +85,13 @@ func (marketDataRequest *MarketDataRequest) SetMDReqID(mDReqID string) *MarketDa
 	return marketDataRequest
 }
 
-func (marketDataRequest *MarketDataRequest) SubscriptionRequestType() string {
+func (marketDataRequest *MarketDataRequest) SubscriptionRequestType() EnumSubscriptionRequestType {
 	kv := marketDataRequest.Get(1)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumSubscriptionRequestType)
 }
 
-func (marketDataRequest *MarketDataRequest) SetSubscriptionRequestType(subscriptionRequestType string) *MarketDataRequest {
+func (marketDataRequest *MarketDataRequest) SetSubscriptionRequestType(subscriptionRequestType EnumSubscriptionRequestType) *MarketDataRequest {
 	kv := marketDataRequest.Get(1).(*fix.KeyValue)
 	_ = kv.Load().Set(subscriptionRequestType)
 	return marketDataRequest
@@ -109,13 +109,13 @@ func (marketDataRequest *MarketDataRequest) SetMarketDepth(marketDepth int) *Mar
 	return marketDataRequest
 }
 
-func (marketDataRequest *MarketDataRequest) MDUpdateType() string {
+func (marketDataRequest *MarketDataRequest) MDUpdateType() EnumMDUpdateType {
 	kv := marketDataRequest.Get(3)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumMDUpdateType)
 }
 
-func (marketDataRequest *MarketDataRequest) SetMDUpdateType(mDUpdateType string) *MarketDataRequest {
+func (marketDataRequest *MarketDataRequest) SetMDUpdateType(mDUpdateType EnumMDUpdateType) *MarketDataRequest {
 	kv := marketDataRequest.Get(3).(*fix.KeyValue)
 	_ = kv.Load().Set(mDUpdateType)
 	return marketDataRequest
@@ -133,25 +133,25 @@ func (marketDataRequest *MarketDataRequest) SetAggregatedBook(aggregatedBook boo
 	return marketDataRequest
 }
 
-func (marketDataRequest *MarketDataRequest) OpenCloseSettlFlag() string {
+func (marketDataRequest *MarketDataRequest) OpenCloseSettlFlag() EnumOpenCloseSettlFlag {
 	kv := marketDataRequest.Get(5)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumOpenCloseSettlFlag)
 }
 
-func (marketDataRequest *MarketDataRequest) SetOpenCloseSettlFlag(openCloseSettlFlag string) *MarketDataRequest {
+func (marketDataRequest *MarketDataRequest) SetOpenCloseSettlFlag(openCloseSettlFlag EnumOpenCloseSettlFlag) *MarketDataRequest {
 	kv := marketDataRequest.Get(5).(*fix.KeyValue)
 	_ = kv.Load().Set(openCloseSettlFlag)
 	return marketDataRequest
 }
 
-func (marketDataRequest *MarketDataRequest) Scope() string {
+func (marketDataRequest *MarketDataRequest) Scope() EnumScope {
 	kv := marketDataRequest.Get(6)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumScope)
 }
 
-func (marketDataRequest *MarketDataRequest) SetScope(scope string) *MarketDataRequest {
+func (marketDataRequest *MarketDataRequest) SetScope(scope EnumScope) *MarketDataRequest {
 	kv := marketDataRequest.Get(6).(*fix.KeyValue)
 	_ = kv.Load().Set(scope)
 	return marketDataRequest
@@ -172,7 +172,7 @@ func (marketDataRequest *MarketDataRequest) SetMDImplicitDelete(mDImplicitDelete
 func (marketDataRequest *MarketDataRequest) MDEntryTypesGrp() *MDEntryTypesGrp {
 	group := marketDataRequest.Get(8).(*fix.Group)
 
-	return &MDEntryTypesGrp{group}
+	return &MDEntryTypesGrp{Group: group}
 }
 
 func (marketDataRequest *MarketDataRequest) SetMDEntryTypesGrp(noMDEntryTypes *MDEntryTypesGrp) *MarketDataRequest {
@@ -184,7 +184,7 @@ func (marketDataRequest *MarketDataRequest) SetMDEntryTypesGrp(noMDEntryTypes *M
 func (marketDataRequest *MarketDataRequest) RelatedSymGrp() *RelatedSymGrp {
 	group := marketDataRequest.Get(9).(*fix.Group)
 
-	return &RelatedSymGrp{group}
+	return &RelatedSymGrp{Group: group}
 }
 
 func (marketDataRequest *MarketDataRequest) SetRelatedSymGrp(noRelatedSym *RelatedSymGrp) *MarketDataRequest {
@@ -193,6 +193,67 @@ func (marketDataRequest *MarketDataRequest) SetRelatedSymGrp(noRelatedSym *Relat
 	return marketDataRequest
 }
 
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (marketDataRequest *MarketDataRequest) Validate() error {
+	if !fix.IsSet(marketDataRequest.Get(0)) {
+		return fix.NewValidationError(FieldMDReqID, "MDReqID", "")
+	}
+
+	if !fix.IsSet(marketDataRequest.Get(1)) {
+		return fix.NewValidationError(FieldSubscriptionRequestType, "SubscriptionRequestType", "")
+	}
+
+	if !fix.IsSet(marketDataRequest.Get(2)) {
+		return fix.NewValidationError(FieldMarketDepth, "MarketDepth", "")
+	}
+
+	if fix.FieldValue(marketDataRequest.Get(1)) == "1" && !fix.IsSet(marketDataRequest.Get(3)) {
+		return fix.NewValidationError(FieldMDUpdateType, "MDUpdateType", "SubscriptionRequestType == ^SnapshotUpdate")
+	}
+
+	if !fix.IsSet(marketDataRequest.Get(8)) {
+		return fix.NewValidationError(FieldNoMDEntryTypes, "NoMDEntryTypes", "")
+	}
+
+	if err := marketDataRequest.MDEntryTypesGrp().Validate(); err != nil {
+		return err
+	}
+
+	if !fix.IsSet(marketDataRequest.Get(9)) {
+		return fix.NewValidationError(FieldNoRelatedSym, "NoRelatedSym", "")
+	}
+
+	if err := marketDataRequest.RelatedSymGrp().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (marketDataRequest *MarketDataRequest) MarshalJSON() ([]byte, error) {
+	return marketDataRequest.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (marketDataRequest *MarketDataRequest) RedactedJSON() ([]byte, error) {
+	return marketDataRequest.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (marketDataRequest *MarketDataRequest) UnmarshalJSON(data []byte) error {
+	msg := makeMarketDataRequest()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*marketDataRequest = *msg
+
+	return nil
+}
+
 // New is a plane message constructor
 func (MarketDataRequest) New() messages.MarketDataRequestBuilder {
 	return makeMarketDataRequest()
@@ -18,7 +18,7 @@ func makeMarketDataRequestReject() *MarketDataRequestReject {
 		Message: fix.NewMessage(FieldBeginString, FieldBodyLength, FieldCheckSum, FieldMsgType, beginString, MsgTypeMarketDataRequestReject).
 			SetBody(
 				fix.NewKeyValue(FieldMDReqID, &fix.String{}),
-				fix.NewKeyValue(FieldMDReqRejReason, &fix.String{}),
+				fix.NewKeyValue(FieldMDReqRejReason, &fix.Enum[EnumMDReqRejReason]{}),
 				NewAltMDSourceGrp().Group,
 				fix.NewKeyValue(FieldText, &fix.String{}),
 				fix.NewKeyValue(FieldEncodedTextLen, &fix.Int{}),
@@ -52,7 +52,7 @@ func NewMarketDataRequestReject() *MarketDataRequestReject {
 func (marketDataRequestReject *MarketDataRequestReject) Header() *Header {
 	header := marketDataRequestReject.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (marketDataRequestReject *MarketDataRequestReject) HeaderBuilder() messages.HeaderBuilder {
@@ -62,7 +62,7 @@ func (marketDataRequestReject *MarketDataRequestReject) HeaderBuilder() messages
 func (marketDataRequestReject *MarketDataRequestReject) Trailer() *Trailer {
 	trailer := marketDataRequestReject.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 func (marketDataRequestReject *MarketDataRequestReject) MDReqID() string {
@@ -77,13 +77,13 @@ func (marketDataRequestReject *MarketDataRequestReject) SetMDReqID(mDReqID strin
 	return marketDataRequestReject
 }
 
-func (marketDataRequestReject *MarketDataRequestReject) MDReqRejReason() string {
+func (marketDataRequestReject *MarketDataRequestReject) MDReqRejReason() EnumMDReqRejReason {
 	kv := marketDataRequestReject.Get(1)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumMDReqRejReason)
 }
 
-func (marketDataRequestReject *MarketDataRequestReject) SetMDReqRejReason(mDReqRejReason string) *MarketDataRequestReject {
+func (marketDataRequestReject *MarketDataRequestReject) SetMDReqRejReason(mDReqRejReason EnumMDReqRejReason) *MarketDataRequestReject {
 	kv := marketDataRequestReject.Get(1).(*fix.KeyValue)
 	_ = kv.Load().Set(mDReqRejReason)
 	return marketDataRequestReject
@@ -92,7 +92,7 @@ func (marketDataRequestReject *MarketDataRequestReject) SetMDReqRejReason(mDReqR
 func (marketDataRequestReject *MarketDataRequestReject) AltMDSourceGrp() *AltMDSourceGrp {
 	group := marketDataRequestReject.Get(2).(*fix.Group)
 
-	return &AltMDSourceGrp{group}
+	return &AltMDSourceGrp{Group: group}
 }
 
 func (marketDataRequestReject *MarketDataRequestReject) SetAltMDSourceGrp(noAltMDSource *AltMDSourceGrp) *MarketDataRequestReject {
@@ -136,3 +136,40 @@ func (marketDataRequestReject *MarketDataRequestReject) SetEncodedText(encodedTe
 	_ = kv.Load().Set(encodedText)
 	return marketDataRequestReject
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (marketDataRequestReject *MarketDataRequestReject) Validate() error {
+	if !fix.IsSet(marketDataRequestReject.Get(0)) {
+		return fix.NewValidationError(FieldMDReqID, "MDReqID", "")
+	}
+
+	if err := marketDataRequestReject.AltMDSourceGrp().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (marketDataRequestReject *MarketDataRequestReject) MarshalJSON() ([]byte, error) {
+	return marketDataRequestReject.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (marketDataRequestReject *MarketDataRequestReject) RedactedJSON() ([]byte, error) {
+	return marketDataRequestReject.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (marketDataRequestReject *MarketDataRequestReject) UnmarshalJSON(data []byte) error {
+	msg := makeMarketDataRequestReject()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*marketDataRequestReject = *msg
+
+	return nil
+}
@@ -21,12 +21,12 @@ func makeMarketDataSnapshotFullRefresh() *MarketDataSnapshotFullRefresh {
 				makeInstrument().Component,
 				NewUnderlyingsGrp().Group,
 				NewLegsGrp().Group,
-				fix.NewKeyValue(FieldFinancialStatus, &fix.String{}),
-				fix.NewKeyValue(FieldCorporateAction, &fix.String{}),
+				fix.NewKeyValue(FieldFinancialStatus, &fix.Enum[EnumFinancialStatus]{}),
+				fix.NewKeyValue(FieldCorporateAction, &fix.Enum[EnumCorporateAction]{}),
 				fix.NewKeyValue(FieldNetChgPrevDay, &fix.Float{}),
 				NewMDEntriesGrp().Group,
 				fix.NewKeyValue(FieldApplQueueDepth, &fix.Int{}),
-				fix.NewKeyValue(FieldApplQueueResolution, &fix.String{}),
+				fix.NewKeyValue(FieldApplQueueResolution, &fix.Enum[EnumApplQueueResolution]{}),
 			),
 	}
 
@@ -57,7 +57,7 @@ func NewMarketDataSnapshotFullRefresh() *MarketDataSnapshotFullRefresh {
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) Header() *Header {
 	header := marketDataSnapshotFullRefresh.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) HeaderBuilder() messages.HeaderBuilder {
@@ -67,7 +67,7 @@ func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) HeaderBuilde
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) Trailer() *Trailer {
 	trailer := marketDataSnapshotFullRefresh.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) MDReqID() string {
@@ -85,7 +85,7 @@ func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetMDReqID(m
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) Instrument() *Instrument {
 	component := marketDataSnapshotFullRefresh.Get(1).(*fix.Component)
 
-	return &Instrument{component}
+	return &Instrument{Component: component}
 }
 
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetInstrument(instrument *Instrument) *MarketDataSnapshotFullRefresh {
@@ -97,7 +97,7 @@ func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetInstrumen
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) UnderlyingsGrp() *UnderlyingsGrp {
 	group := marketDataSnapshotFullRefresh.Get(2).(*fix.Group)
 
-	return &UnderlyingsGrp{group}
+	return &UnderlyingsGrp{Group: group}
 }
 
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetUnderlyingsGrp(noUnderlyings *UnderlyingsGrp) *MarketDataSnapshotFullRefresh {
@@ -109,7 +109,7 @@ func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetUnderlyin
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) LegsGrp() *LegsGrp {
 	group := marketDataSnapshotFullRefresh.Get(3).(*fix.Group)
 
-	return &LegsGrp{group}
+	return &LegsGrp{Group: group}
 }
 
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetLegsGrp(noLegs *LegsGrp) *MarketDataSnapshotFullRefresh {
@@ -118,25 +118,25 @@ func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetLegsGrp(n
 	return marketDataSnapshotFullRefresh
 }
 
-func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) FinancialStatus() string {
+func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) FinancialStatus() EnumFinancialStatus {
 	kv := marketDataSnapshotFullRefresh.Get(4)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumFinancialStatus)
 }
 
-func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetFinancialStatus(financialStatus string) *MarketDataSnapshotFullRefresh {
+func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetFinancialStatus(financialStatus EnumFinancialStatus) *MarketDataSnapshotFullRefresh {
 	kv := marketDataSnapshotFullRefresh.Get(4).(*fix.KeyValue)
 	_ = kv.Load().Set(financialStatus)
 	return marketDataSnapshotFullRefresh
 }
 
-func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) CorporateAction() string {
+func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) CorporateAction() EnumCorporateAction {
 	kv := marketDataSnapshotFullRefresh.Get(5)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumCorporateAction)
 }
 
-func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetCorporateAction(corporateAction string) *MarketDataSnapshotFullRefresh {
+func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetCorporateAction(corporateAction EnumCorporateAction) *MarketDataSnapshotFullRefresh {
 	kv := marketDataSnapshotFullRefresh.Get(5).(*fix.KeyValue)
 	_ = kv.Load().Set(corporateAction)
 	return marketDataSnapshotFullRefresh
@@ -157,7 +157,7 @@ func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetNetChgPre
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) MDEntriesGrp() *MDEntriesGrp {
 	group := marketDataSnapshotFullRefresh.Get(7).(*fix.Group)
 
-	return &MDEntriesGrp{group}
+	return &MDEntriesGrp{Group: group}
 }
 
 func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetMDEntriesGrp(noMDEntries *MDEntriesGrp) *MarketDataSnapshotFullRefresh {
@@ -178,14 +178,67 @@ func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetApplQueue
 	return marketDataSnapshotFullRefresh
 }
 
-func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) ApplQueueResolution() string {
+func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) ApplQueueResolution() EnumApplQueueResolution {
 	kv := marketDataSnapshotFullRefresh.Get(9)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumApplQueueResolution)
 }
 
-func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetApplQueueResolution(applQueueResolution string) *MarketDataSnapshotFullRefresh {
+func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) SetApplQueueResolution(applQueueResolution EnumApplQueueResolution) *MarketDataSnapshotFullRefresh {
 	kv := marketDataSnapshotFullRefresh.Get(9).(*fix.KeyValue)
 	_ = kv.Load().Set(applQueueResolution)
 	return marketDataSnapshotFullRefresh
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) Validate() error {
+	if !fix.IsSet(marketDataSnapshotFullRefresh.Get(1)) {
+		return fix.NewValidationError("", "Instrument", "")
+	}
+
+	if err := marketDataSnapshotFullRefresh.Instrument().Validate(); err != nil {
+		return err
+	}
+
+	if err := marketDataSnapshotFullRefresh.UnderlyingsGrp().Validate(); err != nil {
+		return err
+	}
+
+	if err := marketDataSnapshotFullRefresh.LegsGrp().Validate(); err != nil {
+		return err
+	}
+
+	if !fix.IsSet(marketDataSnapshotFullRefresh.Get(7)) {
+		return fix.NewValidationError(FieldNoMDEntries, "NoMDEntries", "")
+	}
+
+	if err := marketDataSnapshotFullRefresh.MDEntriesGrp().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) MarshalJSON() ([]byte, error) {
+	return marketDataSnapshotFullRefresh.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) RedactedJSON() ([]byte, error) {
+	return marketDataSnapshotFullRefresh.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (marketDataSnapshotFullRefresh *MarketDataSnapshotFullRefresh) UnmarshalJSON(data []byte) error {
+	msg := makeMarketDataSnapshotFullRefresh()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*marketDataSnapshotFullRefresh = *msg
+
+	return nil
+}
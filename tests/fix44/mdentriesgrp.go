@@ -13,36 +13,36 @@ type MDEntriesGrp struct {
 func NewMDEntriesGrp() *MDEntriesGrp {
 	return &MDEntriesGrp{
 		fix.NewGroup(FieldNoMDEntries,
-			fix.NewKeyValue(FieldMDUpdateAction, &fix.String{}),
-			fix.NewKeyValue(FieldDeleteReason, &fix.String{}),
-			fix.NewKeyValue(FieldMDEntryType, &fix.String{}),
+			fix.NewKeyValue(FieldMDUpdateAction, &fix.Enum[EnumMDUpdateAction]{}),
+			fix.NewKeyValue(FieldDeleteReason, &fix.Enum[EnumDeleteReason]{}),
+			fix.NewKeyValue(FieldMDEntryType, &fix.Enum[EnumMDEntryType]{}),
 			fix.NewKeyValue(FieldMDEntryID, &fix.String{}),
 			fix.NewKeyValue(FieldMDEntryRefID, &fix.String{}),
 			makeInstrument().Component,
 			NewUnderlyingsGrp().Group,
 			NewLegsGrp().Group,
-			fix.NewKeyValue(FieldFinancialStatus, &fix.String{}),
-			fix.NewKeyValue(FieldCorporateAction, &fix.String{}),
+			fix.NewKeyValue(FieldFinancialStatus, &fix.Enum[EnumFinancialStatus]{}),
+			fix.NewKeyValue(FieldCorporateAction, &fix.Enum[EnumCorporateAction]{}),
 			fix.NewKeyValue(FieldMDEntryPx, &fix.Float{}),
 			fix.NewKeyValue(FieldCurrency, &fix.String{}),
 			fix.NewKeyValue(FieldMDEntrySize, &fix.Float{}),
 			fix.NewKeyValue(FieldMDEntryDate, &fix.String{}),
 			fix.NewKeyValue(FieldMDEntryTime, &fix.String{}),
-			fix.NewKeyValue(FieldTickDirection, &fix.String{}),
+			fix.NewKeyValue(FieldTickDirection, &fix.Enum[EnumTickDirection]{}),
 			fix.NewKeyValue(FieldMDMkt, &fix.String{}),
 			fix.NewKeyValue(FieldTradingSessionID, &fix.String{}),
 			fix.NewKeyValue(FieldTradingSessionSubID, &fix.String{}),
-			fix.NewKeyValue(FieldQuoteCondition, &fix.String{}),
-			fix.NewKeyValue(FieldTradeCondition, &fix.String{}),
+			fix.NewKeyValue(FieldQuoteCondition, &fix.Enum[EnumQuoteCondition]{}),
+			fix.NewKeyValue(FieldTradeCondition, &fix.Enum[EnumTradeCondition]{}),
 			fix.NewKeyValue(FieldMDEntryOriginator, &fix.String{}),
 			fix.NewKeyValue(FieldLocationID, &fix.String{}),
 			fix.NewKeyValue(FieldDeskID, &fix.String{}),
-			fix.NewKeyValue(FieldOpenCloseSettlFlag, &fix.String{}),
-			fix.NewKeyValue(FieldTimeInForce, &fix.String{}),
+			fix.NewKeyValue(FieldOpenCloseSettlFlag, &fix.Enum[EnumOpenCloseSettlFlag]{}),
+			fix.NewKeyValue(FieldTimeInForce, &fix.Enum[EnumTimeInForce]{}),
 			fix.NewKeyValue(FieldExpireDate, &fix.String{}),
 			fix.NewKeyValue(FieldExpireTime, &fix.String{}),
 			fix.NewKeyValue(FieldMinQty, &fix.Float{}),
-			fix.NewKeyValue(FieldExecInst, &fix.String{}),
+			fix.NewKeyValue(FieldExecInst, &fix.Enum[EnumExecInst]{}),
 			fix.NewKeyValue(FieldSellerDays, &fix.Int{}),
 			fix.NewKeyValue(FieldOrderID, &fix.String{}),
 			fix.NewKeyValue(FieldQuoteEntryID, &fix.String{}),
@@ -50,7 +50,7 @@ func NewMDEntriesGrp() *MDEntriesGrp {
 			fix.NewKeyValue(FieldMDEntrySeller, &fix.String{}),
 			fix.NewKeyValue(FieldNumberOfOrders, &fix.Int{}),
 			fix.NewKeyValue(FieldMDEntryPositionNo, &fix.Int{}),
-			fix.NewKeyValue(FieldScope, &fix.String{}),
+			fix.NewKeyValue(FieldScope, &fix.Enum[EnumScope]{}),
 			fix.NewKeyValue(FieldPriceDelta, &fix.Float{}),
 			fix.NewKeyValue(FieldNetChgPrevDay, &fix.Float{}),
 			fix.NewKeyValue(FieldText, &fix.String{}),
@@ -76,42 +76,53 @@ func (group *MDEntriesGrp) Entries() []*MDEntriesEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *MDEntriesGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type MDEntriesEntry struct {
 	*fix.Component
 }
 
 func makeMDEntriesEntry() *MDEntriesEntry {
 	return &MDEntriesEntry{fix.NewComponent(
-		fix.NewKeyValue(FieldMDUpdateAction, &fix.String{}),
-		fix.NewKeyValue(FieldDeleteReason, &fix.String{}),
-		fix.NewKeyValue(FieldMDEntryType, &fix.String{}),
+		fix.NewKeyValue(FieldMDUpdateAction, &fix.Enum[EnumMDUpdateAction]{}),
+		fix.NewKeyValue(FieldDeleteReason, &fix.Enum[EnumDeleteReason]{}),
+		fix.NewKeyValue(FieldMDEntryType, &fix.Enum[EnumMDEntryType]{}),
 		fix.NewKeyValue(FieldMDEntryID, &fix.String{}),
 		fix.NewKeyValue(FieldMDEntryRefID, &fix.String{}),
 		makeInstrument().Component,
 		NewUnderlyingsGrp().Group,
 		NewLegsGrp().Group,
-		fix.NewKeyValue(FieldFinancialStatus, &fix.String{}),
-		fix.NewKeyValue(FieldCorporateAction, &fix.String{}),
+		fix.NewKeyValue(FieldFinancialStatus, &fix.Enum[EnumFinancialStatus]{}),
+		fix.NewKeyValue(FieldCorporateAction, &fix.Enum[EnumCorporateAction]{}),
 		fix.NewKeyValue(FieldMDEntryPx, &fix.Float{}),
 		fix.NewKeyValue(FieldCurrency, &fix.String{}),
 		fix.NewKeyValue(FieldMDEntrySize, &fix.Float{}),
 		fix.NewKeyValue(FieldMDEntryDate, &fix.String{}),
 		fix.NewKeyValue(FieldMDEntryTime, &fix.String{}),
-		fix.NewKeyValue(FieldTickDirection, &fix.String{}),
+		fix.NewKeyValue(FieldTickDirection, &fix.Enum[EnumTickDirection]{}),
 		fix.NewKeyValue(FieldMDMkt, &fix.String{}),
 		fix.NewKeyValue(FieldTradingSessionID, &fix.String{}),
 		fix.NewKeyValue(FieldTradingSessionSubID, &fix.String{}),
-		fix.NewKeyValue(FieldQuoteCondition, &fix.String{}),
-		fix.NewKeyValue(FieldTradeCondition, &fix.String{}),
+		fix.NewKeyValue(FieldQuoteCondition, &fix.Enum[EnumQuoteCondition]{}),
+		fix.NewKeyValue(FieldTradeCondition, &fix.Enum[EnumTradeCondition]{}),
 		fix.NewKeyValue(FieldMDEntryOriginator, &fix.String{}),
 		fix.NewKeyValue(FieldLocationID, &fix.String{}),
 		fix.NewKeyValue(FieldDeskID, &fix.String{}),
-		fix.NewKeyValue(FieldOpenCloseSettlFlag, &fix.String{}),
-		fix.NewKeyValue(FieldTimeInForce, &fix.String{}),
+		fix.NewKeyValue(FieldOpenCloseSettlFlag, &fix.Enum[EnumOpenCloseSettlFlag]{}),
+		fix.NewKeyValue(FieldTimeInForce, &fix.Enum[EnumTimeInForce]{}),
 		fix.NewKeyValue(FieldExpireDate, &fix.String{}),
 		fix.NewKeyValue(FieldExpireTime, &fix.String{}),
 		fix.NewKeyValue(FieldMinQty, &fix.Float{}),
-		fix.NewKeyValue(FieldExecInst, &fix.String{}),
+		fix.NewKeyValue(FieldExecInst, &fix.Enum[EnumExecInst]{}),
 		fix.NewKeyValue(FieldSellerDays, &fix.Int{}),
 		fix.NewKeyValue(FieldOrderID, &fix.String{}),
 		fix.NewKeyValue(FieldQuoteEntryID, &fix.String{}),
@@ -119,7 +130,7 @@ func makeMDEntriesEntry() *MDEntriesEntry {
 		fix.NewKeyValue(FieldMDEntrySeller, &fix.String{}),
 		fix.NewKeyValue(FieldNumberOfOrders, &fix.Int{}),
 		fix.NewKeyValue(FieldMDEntryPositionNo, &fix.Int{}),
-		fix.NewKeyValue(FieldScope, &fix.String{}),
+		fix.NewKeyValue(FieldScope, &fix.Enum[EnumScope]{}),
 		fix.NewKeyValue(FieldPriceDelta, &fix.Float{}),
 		fix.NewKeyValue(FieldNetChgPrevDay, &fix.Float{}),
 		fix.NewKeyValue(FieldText, &fix.String{}),
@@ -132,37 +143,37 @@ func NewMDEntriesEntry() *MDEntriesEntry {
 	return makeMDEntriesEntry()
 }
 
-func (mDEntriesEntry *MDEntriesEntry) MDUpdateAction() string {
+func (mDEntriesEntry *MDEntriesEntry) MDUpdateAction() EnumMDUpdateAction {
 	kv := mDEntriesEntry.Get(0)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumMDUpdateAction)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetMDUpdateAction(mDUpdateAction string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetMDUpdateAction(mDUpdateAction EnumMDUpdateAction) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(0).(*fix.KeyValue)
 	_ = kv.Load().Set(mDUpdateAction)
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) DeleteReason() string {
+func (mDEntriesEntry *MDEntriesEntry) DeleteReason() EnumDeleteReason {
 	kv := mDEntriesEntry.Get(1)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumDeleteReason)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetDeleteReason(deleteReason string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetDeleteReason(deleteReason EnumDeleteReason) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(1).(*fix.KeyValue)
 	_ = kv.Load().Set(deleteReason)
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) MDEntryType() string {
+func (mDEntriesEntry *MDEntriesEntry) MDEntryType() EnumMDEntryType {
 	kv := mDEntriesEntry.Get(2)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumMDEntryType)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetMDEntryType(mDEntryType string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetMDEntryType(mDEntryType EnumMDEntryType) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(2).(*fix.KeyValue)
 	_ = kv.Load().Set(mDEntryType)
 	return mDEntriesEntry
@@ -195,7 +206,7 @@ func (mDEntriesEntry *MDEntriesEntry) SetMDEntryRefID(mDEntryRefID string) *MDEn
 func (mDEntriesEntry *MDEntriesEntry) Instrument() *Instrument {
 	component := mDEntriesEntry.Get(5).(*fix.Component)
 
-	return &Instrument{component}
+	return &Instrument{Component: component}
 }
 
 func (mDEntriesEntry *MDEntriesEntry) SetInstrument(instrument *Instrument) *MDEntriesEntry {
@@ -207,7 +218,7 @@ func (mDEntriesEntry *MDEntriesEntry) SetInstrument(instrument *Instrument) *MDE
 func (mDEntriesEntry *MDEntriesEntry) UnderlyingsGrp() *UnderlyingsGrp {
 	group := mDEntriesEntry.Get(6).(*fix.Group)
 
-	return &UnderlyingsGrp{group}
+	return &UnderlyingsGrp{Group: group}
 }
 
 func (mDEntriesEntry *MDEntriesEntry) SetUnderlyingsGrp(noUnderlyings *UnderlyingsGrp) *MDEntriesEntry {
@@ -219,7 +230,7 @@ func (mDEntriesEntry *MDEntriesEntry) SetUnderlyingsGrp(noUnderlyings *Underlyin
 func (mDEntriesEntry *MDEntriesEntry) LegsGrp() *LegsGrp {
 	group := mDEntriesEntry.Get(7).(*fix.Group)
 
-	return &LegsGrp{group}
+	return &LegsGrp{Group: group}
 }
 
 func (mDEntriesEntry *MDEntriesEntry) SetLegsGrp(noLegs *LegsGrp) *MDEntriesEntry {
@@ -228,25 +239,25 @@ func (mDEntriesEntry *MDEntriesEntry) SetLegsGrp(noLegs *LegsGrp) *MDEntriesEntr
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) FinancialStatus() string {
+func (mDEntriesEntry *MDEntriesEntry) FinancialStatus() EnumFinancialStatus {
 	kv := mDEntriesEntry.Get(8)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumFinancialStatus)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetFinancialStatus(financialStatus string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetFinancialStatus(financialStatus EnumFinancialStatus) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(8).(*fix.KeyValue)
 	_ = kv.Load().Set(financialStatus)
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) CorporateAction() string {
+func (mDEntriesEntry *MDEntriesEntry) CorporateAction() EnumCorporateAction {
 	kv := mDEntriesEntry.Get(9)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumCorporateAction)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetCorporateAction(corporateAction string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetCorporateAction(corporateAction EnumCorporateAction) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(9).(*fix.KeyValue)
 	_ = kv.Load().Set(corporateAction)
 	return mDEntriesEntry
@@ -312,13 +323,13 @@ func (mDEntriesEntry *MDEntriesEntry) SetMDEntryTime(mDEntryTime string) *MDEntr
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) TickDirection() string {
+func (mDEntriesEntry *MDEntriesEntry) TickDirection() EnumTickDirection {
 	kv := mDEntriesEntry.Get(15)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumTickDirection)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetTickDirection(tickDirection string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetTickDirection(tickDirection EnumTickDirection) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(15).(*fix.KeyValue)
 	_ = kv.Load().Set(tickDirection)
 	return mDEntriesEntry
@@ -360,25 +371,25 @@ func (mDEntriesEntry *MDEntriesEntry) SetTradingSessionSubID(tradingSessionSubID
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) QuoteCondition() string {
+func (mDEntriesEntry *MDEntriesEntry) QuoteCondition() EnumQuoteCondition {
 	kv := mDEntriesEntry.Get(19)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumQuoteCondition)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetQuoteCondition(quoteCondition string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetQuoteCondition(quoteCondition EnumQuoteCondition) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(19).(*fix.KeyValue)
 	_ = kv.Load().Set(quoteCondition)
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) TradeCondition() string {
+func (mDEntriesEntry *MDEntriesEntry) TradeCondition() EnumTradeCondition {
 	kv := mDEntriesEntry.Get(20)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumTradeCondition)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetTradeCondition(tradeCondition string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetTradeCondition(tradeCondition EnumTradeCondition) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(20).(*fix.KeyValue)
 	_ = kv.Load().Set(tradeCondition)
 	return mDEntriesEntry
@@ -420,25 +431,25 @@ func (mDEntriesEntry *MDEntriesEntry) SetDeskID(deskID string) *MDEntriesEntry {
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) OpenCloseSettlFlag() string {
+func (mDEntriesEntry *MDEntriesEntry) OpenCloseSettlFlag() EnumOpenCloseSettlFlag {
 	kv := mDEntriesEntry.Get(24)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumOpenCloseSettlFlag)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetOpenCloseSettlFlag(openCloseSettlFlag string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetOpenCloseSettlFlag(openCloseSettlFlag EnumOpenCloseSettlFlag) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(24).(*fix.KeyValue)
 	_ = kv.Load().Set(openCloseSettlFlag)
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) TimeInForce() string {
+func (mDEntriesEntry *MDEntriesEntry) TimeInForce() EnumTimeInForce {
 	kv := mDEntriesEntry.Get(25)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumTimeInForce)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetTimeInForce(timeInForce string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetTimeInForce(timeInForce EnumTimeInForce) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(25).(*fix.KeyValue)
 	_ = kv.Load().Set(timeInForce)
 	return mDEntriesEntry
@@ -480,13 +491,13 @@ func (mDEntriesEntry *MDEntriesEntry) SetMinQty(minQty float64) *MDEntriesEntry
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) ExecInst() string {
+func (mDEntriesEntry *MDEntriesEntry) ExecInst() EnumExecInst {
 	kv := mDEntriesEntry.Get(29)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumExecInst)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetExecInst(execInst string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetExecInst(execInst EnumExecInst) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(29).(*fix.KeyValue)
 	_ = kv.Load().Set(execInst)
 	return mDEntriesEntry
@@ -576,13 +587,13 @@ func (mDEntriesEntry *MDEntriesEntry) SetMDEntryPositionNo(mDEntryPositionNo int
 	return mDEntriesEntry
 }
 
-func (mDEntriesEntry *MDEntriesEntry) Scope() string {
+func (mDEntriesEntry *MDEntriesEntry) Scope() EnumScope {
 	kv := mDEntriesEntry.Get(37)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumScope)
 }
 
-func (mDEntriesEntry *MDEntriesEntry) SetScope(scope string) *MDEntriesEntry {
+func (mDEntriesEntry *MDEntriesEntry) SetScope(scope EnumScope) *MDEntriesEntry {
 	kv := mDEntriesEntry.Get(37).(*fix.KeyValue)
 	_ = kv.Load().Set(scope)
 	return mDEntriesEntry
@@ -647,3 +658,28 @@ func (mDEntriesEntry *MDEntriesEntry) SetEncodedText(encodedText string) *MDEntr
 	_ = kv.Load().Set(encodedText)
 	return mDEntriesEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (mDEntriesEntry *MDEntriesEntry) Validate() error {
+	if !fix.IsSet(mDEntriesEntry.Get(0)) {
+		return fix.NewValidationError(FieldMDUpdateAction, "MDUpdateAction", "")
+	}
+
+	if fix.IsSet(mDEntriesEntry.Get(5)) {
+		if err := mDEntriesEntry.Instrument().Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := mDEntriesEntry.UnderlyingsGrp().Validate(); err != nil {
+		return err
+	}
+
+	if err := mDEntriesEntry.LegsGrp().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
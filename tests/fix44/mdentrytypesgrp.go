@@ -13,7 +13,7 @@ type MDEntryTypesGrp struct {
 func NewMDEntryTypesGrp() *MDEntryTypesGrp {
 	return &MDEntryTypesGrp{
 		fix.NewGroup(FieldNoMDEntryTypes,
-			fix.NewKeyValue(FieldMDEntryType, &fix.String{}),
+			fix.NewKeyValue(FieldMDEntryType, &fix.Enum[EnumMDEntryType]{}),
 		),
 	}
 }
@@ -34,13 +34,24 @@ func (group *MDEntryTypesGrp) Entries() []*MDEntryTypesEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *MDEntryTypesGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type MDEntryTypesEntry struct {
 	*fix.Component
 }
 
 func makeMDEntryTypesEntry() *MDEntryTypesEntry {
 	return &MDEntryTypesEntry{fix.NewComponent(
-		fix.NewKeyValue(FieldMDEntryType, &fix.String{}),
+		fix.NewKeyValue(FieldMDEntryType, &fix.Enum[EnumMDEntryType]{}),
 	)}
 }
 
@@ -48,14 +59,25 @@ func NewMDEntryTypesEntry() *MDEntryTypesEntry {
 	return makeMDEntryTypesEntry()
 }
 
-func (mDEntryTypesEntry *MDEntryTypesEntry) MDEntryType() string {
+func (mDEntryTypesEntry *MDEntryTypesEntry) MDEntryType() EnumMDEntryType {
 	kv := mDEntryTypesEntry.Get(0)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumMDEntryType)
 }
 
-func (mDEntryTypesEntry *MDEntryTypesEntry) SetMDEntryType(mDEntryType string) *MDEntryTypesEntry {
+func (mDEntryTypesEntry *MDEntryTypesEntry) SetMDEntryType(mDEntryType EnumMDEntryType) *MDEntryTypesEntry {
 	kv := mDEntryTypesEntry.Get(0).(*fix.KeyValue)
 	_ = kv.Load().Set(mDEntryType)
 	return mDEntryTypesEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (mDEntryTypesEntry *MDEntryTypesEntry) Validate() error {
+	if !fix.IsSet(mDEntryTypesEntry.Get(0)) {
+		return fix.NewValidationError(FieldMDEntryType, "MDEntryType", "")
+	}
+
+	return nil
+}
@@ -14,7 +14,7 @@ func NewMsgTypesGrp() *MsgTypesGrp {
 	return &MsgTypesGrp{
 		fix.NewGroup(FieldNoMsgTypes,
 			fix.NewKeyValue(FieldRefMsgType, &fix.String{}),
-			fix.NewKeyValue(FieldMsgDirection, &fix.String{}),
+			fix.NewKeyValue(FieldMsgDirection, &fix.Enum[EnumMsgDirection]{}),
 		),
 	}
 }
@@ -35,6 +35,17 @@ func (group *MsgTypesGrp) Entries() []*MsgTypesEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *MsgTypesGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type MsgTypesEntry struct {
 	*fix.Component
 }
@@ -42,7 +53,7 @@ type MsgTypesEntry struct {
 func makeMsgTypesEntry() *MsgTypesEntry {
 	return &MsgTypesEntry{fix.NewComponent(
 		fix.NewKeyValue(FieldRefMsgType, &fix.String{}),
-		fix.NewKeyValue(FieldMsgDirection, &fix.String{}),
+		fix.NewKeyValue(FieldMsgDirection, &fix.Enum[EnumMsgDirection]{}),
 	)}
 }
 
@@ -62,14 +73,21 @@ func (msgTypesEntry *MsgTypesEntry) SetRefMsgType(refMsgType string) *MsgTypesEn
 	return msgTypesEntry
 }
 
-func (msgTypesEntry *MsgTypesEntry) MsgDirection() string {
+func (msgTypesEntry *MsgTypesEntry) MsgDirection() EnumMsgDirection {
 	kv := msgTypesEntry.Get(1)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumMsgDirection)
 }
 
-func (msgTypesEntry *MsgTypesEntry) SetMsgDirection(msgDirection string) *MsgTypesEntry {
+func (msgTypesEntry *MsgTypesEntry) SetMsgDirection(msgDirection EnumMsgDirection) *MsgTypesEntry {
 	kv := msgTypesEntry.Get(1).(*fix.KeyValue)
 	_ = kv.Load().Set(msgDirection)
 	return msgTypesEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (msgTypesEntry *MsgTypesEntry) Validate() error {
+	return nil
+}
@@ -20,7 +20,7 @@ func makeReject() *Reject {
 				fix.NewKeyValue(FieldRefSeqNum, &fix.Int{}),
 				fix.NewKeyValue(FieldRefTagID, &fix.Int{}),
 				fix.NewKeyValue(FieldRefMsgType, &fix.String{}),
-				fix.NewKeyValue(FieldSessionRejectReason, &fix.String{}),
+				fix.NewKeyValue(FieldSessionRejectReason, &fix.Enum[EnumSessionRejectReason]{}),
 				fix.NewKeyValue(FieldText, &fix.String{}),
 				fix.NewKeyValue(FieldEncodedTextLen, &fix.Int{}),
 				fix.NewKeyValue(FieldEncodedText, &fix.String{}),
@@ -53,7 +53,7 @@ func NewReject() *Reject {
 func (reject *Reject) Header() *Header {
 	header := reject.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (reject *Reject) HeaderBuilder() messages.HeaderBuilder {
@@ -63,7 +63,7 @@ func (reject *Reject) HeaderBuilder() messages.HeaderBuilder {
 func (reject *Reject) Trailer() *Trailer {
 	trailer := reject.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 func (reject *Reject) RefSeqNum() int {
@@ -102,13 +102,13 @@ func (reject *Reject) SetRefMsgType(refMsgType string) *Reject {
 	return reject
 }
 
-func (reject *Reject) SessionRejectReason() string {
+func (reject *Reject) SessionRejectReason() EnumSessionRejectReason {
 	kv := reject.Get(3)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumSessionRejectReason)
 }
 
-func (reject *Reject) SetSessionRejectReason(sessionRejectReason string) *Reject {
+func (reject *Reject) SetSessionRejectReason(sessionRejectReason EnumSessionRejectReason) *Reject {
 	kv := reject.Get(3).(*fix.KeyValue)
 	_ = kv.Load().Set(sessionRejectReason)
 	return reject
@@ -150,6 +150,39 @@ func (reject *Reject) SetEncodedText(encodedText string) *Reject {
 	return reject
 }
 
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (reject *Reject) Validate() error {
+	if !fix.IsSet(reject.Get(0)) {
+		return fix.NewValidationError(FieldRefSeqNum, "RefSeqNum", "")
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (reject *Reject) MarshalJSON() ([]byte, error) {
+	return reject.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (reject *Reject) RedactedJSON() ([]byte, error) {
+	return reject.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (reject *Reject) UnmarshalJSON(data []byte) error {
+	msg := makeReject()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*reject = *msg
+
+	return nil
+}
+
 // New is a plane message constructor
 func (Reject) New() messages.RejectBuilder {
 	return makeReject()
@@ -161,7 +194,11 @@ func (Reject) Build() messages.RejectBuilder {
 }
 
 func (reject *Reject) SetFieldSessionRejectReason(sessionRejectReason string) messages.RejectBuilder {
-	return reject.SetSessionRejectReason(sessionRejectReason)
+	return reject.SetSessionRejectReason(EnumSessionRejectReason(sessionRejectReason))
+}
+
+func (reject *Reject) FieldSessionRejectReason() string {
+	return string(reject.SessionRejectReason())
 }
 
 func (reject *Reject) SetFieldRefSeqNum(refSeqNum int) messages.RejectBuilder {
@@ -171,3 +208,11 @@ func (reject *Reject) SetFieldRefSeqNum(refSeqNum int) messages.RejectBuilder {
 func (reject *Reject) SetFieldRefTagID(refTagID int) messages.RejectBuilder {
 	return reject.SetRefTagID(refTagID)
 }
+
+func (reject *Reject) SetFieldRefMsgType(refMsgType string) messages.RejectBuilder {
+	return reject.SetRefMsgType(refMsgType)
+}
+
+func (reject *Reject) SetFieldText(text string) messages.RejectBuilder {
+	return reject.SetText(text)
+}
@@ -17,7 +17,7 @@ func NewRelatedSymGrp() *RelatedSymGrp {
 			NewUnderlyingsGrp().Group,
 			NewLegsGrp().Group,
 			NewTradingSessionsGrp().Group,
-			fix.NewKeyValue(FieldApplQueueAction, &fix.String{}),
+			fix.NewKeyValue(FieldApplQueueAction, &fix.Enum[EnumApplQueueAction]{}),
 			fix.NewKeyValue(FieldApplQueueMax, &fix.Int{}),
 		),
 	}
@@ -39,6 +39,17 @@ func (group *RelatedSymGrp) Entries() []*RelatedSymEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *RelatedSymGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type RelatedSymEntry struct {
 	*fix.Component
 }
@@ -49,7 +60,7 @@ func makeRelatedSymEntry() *RelatedSymEntry {
 		NewUnderlyingsGrp().Group,
 		NewLegsGrp().Group,
 		NewTradingSessionsGrp().Group,
-		fix.NewKeyValue(FieldApplQueueAction, &fix.String{}),
+		fix.NewKeyValue(FieldApplQueueAction, &fix.Enum[EnumApplQueueAction]{}),
 		fix.NewKeyValue(FieldApplQueueMax, &fix.Int{}),
 	)}
 }
@@ -61,7 +72,7 @@ func NewRelatedSymEntry() *RelatedSymEntry {
 func (relatedSymEntry *RelatedSymEntry) Instrument() *Instrument {
 	component := relatedSymEntry.Get(0).(*fix.Component)
 
-	return &Instrument{component}
+	return &Instrument{Component: component}
 }
 
 func (relatedSymEntry *RelatedSymEntry) SetInstrument(instrument *Instrument) *RelatedSymEntry {
@@ -73,7 +84,7 @@ func (relatedSymEntry *RelatedSymEntry) SetInstrument(instrument *Instrument) *R
 func (relatedSymEntry *RelatedSymEntry) UnderlyingsGrp() *UnderlyingsGrp {
 	group := relatedSymEntry.Get(1).(*fix.Group)
 
-	return &UnderlyingsGrp{group}
+	return &UnderlyingsGrp{Group: group}
 }
 
 func (relatedSymEntry *RelatedSymEntry) SetUnderlyingsGrp(noUnderlyings *UnderlyingsGrp) *RelatedSymEntry {
@@ -85,7 +96,7 @@ func (relatedSymEntry *RelatedSymEntry) SetUnderlyingsGrp(noUnderlyings *Underly
 func (relatedSymEntry *RelatedSymEntry) LegsGrp() *LegsGrp {
 	group := relatedSymEntry.Get(2).(*fix.Group)
 
-	return &LegsGrp{group}
+	return &LegsGrp{Group: group}
 }
 
 func (relatedSymEntry *RelatedSymEntry) SetLegsGrp(noLegs *LegsGrp) *RelatedSymEntry {
@@ -97,7 +108,7 @@ func (relatedSymEntry *RelatedSymEntry) SetLegsGrp(noLegs *LegsGrp) *RelatedSymE
 func (relatedSymEntry *RelatedSymEntry) TradingSessionsGrp() *TradingSessionsGrp {
 	group := relatedSymEntry.Get(3).(*fix.Group)
 
-	return &TradingSessionsGrp{group}
+	return &TradingSessionsGrp{Group: group}
 }
 
 func (relatedSymEntry *RelatedSymEntry) SetTradingSessionsGrp(noTradingSessions *TradingSessionsGrp) *RelatedSymEntry {
@@ -106,13 +117,13 @@ func (relatedSymEntry *RelatedSymEntry) SetTradingSessionsGrp(noTradingSessions
 	return relatedSymEntry
 }
 
-func (relatedSymEntry *RelatedSymEntry) ApplQueueAction() string {
+func (relatedSymEntry *RelatedSymEntry) ApplQueueAction() EnumApplQueueAction {
 	kv := relatedSymEntry.Get(4)
 	v := kv.(*fix.KeyValue).Load().Value()
-	return v.(string)
+	return v.(EnumApplQueueAction)
 }
 
-func (relatedSymEntry *RelatedSymEntry) SetApplQueueAction(applQueueAction string) *RelatedSymEntry {
+func (relatedSymEntry *RelatedSymEntry) SetApplQueueAction(applQueueAction EnumApplQueueAction) *RelatedSymEntry {
 	kv := relatedSymEntry.Get(4).(*fix.KeyValue)
 	_ = kv.Load().Set(applQueueAction)
 	return relatedSymEntry
@@ -129,3 +140,30 @@ func (relatedSymEntry *RelatedSymEntry) SetApplQueueMax(applQueueMax int) *Relat
 	_ = kv.Load().Set(applQueueMax)
 	return relatedSymEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (relatedSymEntry *RelatedSymEntry) Validate() error {
+	if !fix.IsSet(relatedSymEntry.Get(0)) {
+		return fix.NewValidationError("", "Instrument", "")
+	}
+
+	if err := relatedSymEntry.Instrument().Validate(); err != nil {
+		return err
+	}
+
+	if err := relatedSymEntry.UnderlyingsGrp().Validate(); err != nil {
+		return err
+	}
+
+	if err := relatedSymEntry.LegsGrp().Validate(); err != nil {
+		return err
+	}
+
+	if err := relatedSymEntry.TradingSessionsGrp().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -49,7 +49,7 @@ func NewResendRequest() *ResendRequest {
 func (resendRequest *ResendRequest) Header() *Header {
 	header := resendRequest.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (resendRequest *ResendRequest) HeaderBuilder() messages.HeaderBuilder {
@@ -59,7 +59,7 @@ func (resendRequest *ResendRequest) HeaderBuilder() messages.HeaderBuilder {
 func (resendRequest *ResendRequest) Trailer() *Trailer {
 	trailer := resendRequest.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 func (resendRequest *ResendRequest) BeginSeqNo() int {
@@ -86,6 +86,43 @@ func (resendRequest *ResendRequest) SetEndSeqNo(endSeqNo int) *ResendRequest {
 	return resendRequest
 }
 
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (resendRequest *ResendRequest) Validate() error {
+	if !fix.IsSet(resendRequest.Get(0)) {
+		return fix.NewValidationError(FieldBeginSeqNo, "BeginSeqNo", "")
+	}
+
+	if !fix.IsSet(resendRequest.Get(1)) {
+		return fix.NewValidationError(FieldEndSeqNo, "EndSeqNo", "")
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (resendRequest *ResendRequest) MarshalJSON() ([]byte, error) {
+	return resendRequest.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (resendRequest *ResendRequest) RedactedJSON() ([]byte, error) {
+	return resendRequest.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (resendRequest *ResendRequest) UnmarshalJSON(data []byte) error {
+	msg := makeResendRequest()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*resendRequest = *msg
+
+	return nil
+}
+
 // New is a plane message constructor
 func (ResendRequest) New() messages.ResendRequestBuilder {
 	return makeResendRequest()
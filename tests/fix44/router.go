@@ -0,0 +1,87 @@
+// Code generated by fixgen. DO NOT EDIT.
+
+package fix44
+
+import (
+	"context"
+	simplefixgo "github.com/b2broker/simplefix-go"
+)
+
+// Router unmarshals the incoming messages and passes them to the typed handlers.
+type Router struct {
+	*simplefixgo.MessageRouter
+}
+
+// NewRouter creates a Router attached to a handler, e.g. *simplefixgo.DefaultHandler.
+// The messages are unmarshalled by the session, which also rejects the ones that could not be handled.
+func NewRouter(handler simplefixgo.IncomingHandler, session simplefixgo.RouterSession) *Router {
+	return &Router{
+		MessageRouter: simplefixgo.NewMessageRouter(handler, session, nil),
+	}
+}
+
+// OnHeartbeat registers a handler of the incoming Heartbeat message.
+func (r *Router) OnHeartbeat(handle func(ctx context.Context, msg *Heartbeat) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeHeartbeat, NewHeartbeat, handle)
+	return r
+}
+
+// OnTestRequest registers a handler of the incoming TestRequest message.
+func (r *Router) OnTestRequest(handle func(ctx context.Context, msg *TestRequest) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeTestRequest, NewTestRequest, handle)
+	return r
+}
+
+// OnResendRequest registers a handler of the incoming ResendRequest message.
+func (r *Router) OnResendRequest(handle func(ctx context.Context, msg *ResendRequest) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeResendRequest, NewResendRequest, handle)
+	return r
+}
+
+// OnReject registers a handler of the incoming Reject message.
+func (r *Router) OnReject(handle func(ctx context.Context, msg *Reject) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeReject, NewReject, handle)
+	return r
+}
+
+// OnSequenceReset registers a handler of the incoming SequenceReset message.
+func (r *Router) OnSequenceReset(handle func(ctx context.Context, msg *SequenceReset) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeSequenceReset, NewSequenceReset, handle)
+	return r
+}
+
+// OnLogout registers a handler of the incoming Logout message.
+func (r *Router) OnLogout(handle func(ctx context.Context, msg *Logout) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeLogout, NewLogout, handle)
+	return r
+}
+
+// OnLogon registers a handler of the incoming Logon message.
+func (r *Router) OnLogon(handle func(ctx context.Context, msg *Logon) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeLogon, NewLogon, handle)
+	return r
+}
+
+// OnMarketDataRequest registers a handler of the incoming MarketDataRequest message.
+func (r *Router) OnMarketDataRequest(handle func(ctx context.Context, msg *MarketDataRequest) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeMarketDataRequest, NewMarketDataRequest, handle)
+	return r
+}
+
+// OnMarketDataSnapshotFullRefresh registers a handler of the incoming MarketDataSnapshotFullRefresh message.
+func (r *Router) OnMarketDataSnapshotFullRefresh(handle func(ctx context.Context, msg *MarketDataSnapshotFullRefresh) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeMarketDataSnapshotFullRefresh, NewMarketDataSnapshotFullRefresh, handle)
+	return r
+}
+
+// OnMarketDataIncrementalRefresh registers a handler of the incoming MarketDataIncrementalRefresh message.
+func (r *Router) OnMarketDataIncrementalRefresh(handle func(ctx context.Context, msg *MarketDataIncrementalRefresh) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeMarketDataIncrementalRefresh, NewMarketDataIncrementalRefresh, handle)
+	return r
+}
+
+// OnMarketDataRequestReject registers a handler of the incoming MarketDataRequestReject message.
+func (r *Router) OnMarketDataRequestReject(handle func(ctx context.Context, msg *MarketDataRequestReject) error) *Router {
+	simplefixgo.Route(r.MessageRouter, MsgTypeMarketDataRequestReject, NewMarketDataRequestReject, handle)
+	return r
+}
@@ -35,6 +35,17 @@ func (group *SecurityAltIDGrp) Entries() []*SecurityAltIDEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *SecurityAltIDGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type SecurityAltIDEntry struct {
 	*fix.Component
 }
@@ -73,3 +84,10 @@ func (securityAltIDEntry *SecurityAltIDEntry) SetSecurityAltIDSource(securityAlt
 	_ = kv.Load().Set(securityAltIDSource)
 	return securityAltIDEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (securityAltIDEntry *SecurityAltIDEntry) Validate() error {
+	return nil
+}
@@ -48,7 +48,7 @@ func NewSequenceReset() *SequenceReset {
 func (sequenceReset *SequenceReset) Header() *Header {
 	header := sequenceReset.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (sequenceReset *SequenceReset) HeaderBuilder() messages.HeaderBuilder {
@@ -58,7 +58,7 @@ func (sequenceReset *SequenceReset) HeaderBuilder() messages.HeaderBuilder {
 func (sequenceReset *SequenceReset) Trailer() *Trailer {
 	trailer := sequenceReset.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 func (sequenceReset *SequenceReset) GapFillFlag() bool {
@@ -85,6 +85,39 @@ func (sequenceReset *SequenceReset) SetNewSeqNo(newSeqNo int) *SequenceReset {
 	return sequenceReset
 }
 
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (sequenceReset *SequenceReset) Validate() error {
+	if !fix.IsSet(sequenceReset.Get(1)) {
+		return fix.NewValidationError(FieldNewSeqNo, "NewSeqNo", "")
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (sequenceReset *SequenceReset) MarshalJSON() ([]byte, error) {
+	return sequenceReset.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (sequenceReset *SequenceReset) RedactedJSON() ([]byte, error) {
+	return sequenceReset.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (sequenceReset *SequenceReset) UnmarshalJSON(data []byte) error {
+	msg := makeSequenceReset()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*sequenceReset = *msg
+
+	return nil
+}
+
 // New is a plane message constructor
 func (SequenceReset) New() messages.SequenceResetBuilder {
 	return makeSequenceReset()
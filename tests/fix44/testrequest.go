@@ -47,7 +47,7 @@ func NewTestRequest() *TestRequest {
 func (testRequest *TestRequest) Header() *Header {
 	header := testRequest.Message.Header()
 
-	return &Header{header}
+	return &Header{Component: header}
 }
 
 func (testRequest *TestRequest) HeaderBuilder() messages.HeaderBuilder {
@@ -57,7 +57,7 @@ func (testRequest *TestRequest) HeaderBuilder() messages.HeaderBuilder {
 func (testRequest *TestRequest) Trailer() *Trailer {
 	trailer := testRequest.Message.Trailer()
 
-	return &Trailer{trailer}
+	return &Trailer{Component: trailer}
 }
 
 func (testRequest *TestRequest) TestReqID() string {
@@ -72,6 +72,39 @@ func (testRequest *TestRequest) SetTestReqID(testReqID string) *TestRequest {
 	return testRequest
 }
 
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (testRequest *TestRequest) Validate() error {
+	if !fix.IsSet(testRequest.Get(0)) {
+		return fix.NewValidationError(FieldTestReqID, "TestReqID", "")
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the message in the FIX JSON encoding.
+func (testRequest *TestRequest) MarshalJSON() ([]byte, error) {
+	return testRequest.Message.ToJSON(fieldNames)
+}
+
+// RedactedJSON returns the message in the FIX JSON encoding with the values of the redacted fields hidden.
+func (testRequest *TestRequest) RedactedJSON() ([]byte, error) {
+	return testRequest.Message.ToRedactedJSON(fieldNames)
+}
+
+// UnmarshalJSON sets the message from the FIX JSON encoding, the fields unknown to the message are ignored.
+func (testRequest *TestRequest) UnmarshalJSON(data []byte) error {
+	msg := makeTestRequest()
+	if err := msg.FromJSON(data, fieldNames); err != nil {
+		return err
+	}
+
+	*testRequest = *msg
+
+	return nil
+}
+
 // New is a plane message constructor
 func (TestRequest) New() messages.TestRequestBuilder {
 	return makeTestRequest()
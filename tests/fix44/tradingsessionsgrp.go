@@ -35,6 +35,17 @@ func (group *TradingSessionsGrp) Entries() []*TradingSessionsEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *TradingSessionsGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type TradingSessionsEntry struct {
 	*fix.Component
 }
@@ -73,3 +84,10 @@ func (tradingSessionsEntry *TradingSessionsEntry) SetTradingSessionSubID(trading
 	_ = kv.Load().Set(tradingSessionSubID)
 	return tradingSessionsEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (tradingSessionsEntry *TradingSessionsEntry) Validate() error {
+	return nil
+}
@@ -116,7 +116,7 @@ func (underlyingInstrument *UnderlyingInstrument) SetUnderlyingSecurityIDSource(
 func (underlyingInstrument *UnderlyingInstrument) UnderlyingSecurityAltIDGrp() *UnderlyingSecurityAltIDGrp {
 	group := underlyingInstrument.Get(4).(*fix.Group)
 
-	return &UnderlyingSecurityAltIDGrp{group}
+	return &UnderlyingSecurityAltIDGrp{Group: group}
 }
 
 func (underlyingInstrument *UnderlyingInstrument) SetUnderlyingSecurityAltIDGrp(noUnderlyingSecurityAltID *UnderlyingSecurityAltIDGrp) *UnderlyingInstrument {
@@ -608,7 +608,7 @@ func (underlyingInstrument *UnderlyingInstrument) SetUnderlyingEndValue(underlyi
 func (underlyingInstrument *UnderlyingInstrument) UnderlyingStipulations() *UnderlyingStipulations {
 	component := underlyingInstrument.Get(45).(*fix.Component)
 
-	return &UnderlyingStipulations{component}
+	return &UnderlyingStipulations{Component: component}
 }
 
 func (underlyingInstrument *UnderlyingInstrument) SetUnderlyingStipulations(underlyingStipulations *UnderlyingStipulations) *UnderlyingInstrument {
@@ -616,3 +616,20 @@ func (underlyingInstrument *UnderlyingInstrument) SetUnderlyingStipulations(unde
 
 	return underlyingInstrument
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (underlyingInstrument *UnderlyingInstrument) Validate() error {
+	if err := underlyingInstrument.UnderlyingSecurityAltIDGrp().Validate(); err != nil {
+		return err
+	}
+
+	if fix.IsSet(underlyingInstrument.Get(45)) {
+		if err := underlyingInstrument.UnderlyingStipulations().Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -35,6 +35,17 @@ func (group *UnderlyingSecurityAltIDGrp) Entries() []*UnderlyingSecurityAltIDEnt
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *UnderlyingSecurityAltIDGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type UnderlyingSecurityAltIDEntry struct {
 	*fix.Component
 }
@@ -73,3 +84,10 @@ func (underlyingSecurityAltIDEntry *UnderlyingSecurityAltIDEntry) SetUnderlyingS
 	_ = kv.Load().Set(underlyingSecurityAltIDSource)
 	return underlyingSecurityAltIDEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (underlyingSecurityAltIDEntry *UnderlyingSecurityAltIDEntry) Validate() error {
+	return nil
+}
@@ -34,6 +34,17 @@ func (group *UnderlyingsGrp) Entries() []*UnderlyingsEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *UnderlyingsGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type UnderlyingsEntry struct {
 	*fix.Component
 }
@@ -51,7 +62,7 @@ func NewUnderlyingsEntry() *UnderlyingsEntry {
 func (underlyingsEntry *UnderlyingsEntry) UnderlyingInstrument() *UnderlyingInstrument {
 	component := underlyingsEntry.Get(0).(*fix.Component)
 
-	return &UnderlyingInstrument{component}
+	return &UnderlyingInstrument{Component: component}
 }
 
 func (underlyingsEntry *UnderlyingsEntry) SetUnderlyingInstrument(underlyingInstrument *UnderlyingInstrument) *UnderlyingsEntry {
@@ -59,3 +70,16 @@ func (underlyingsEntry *UnderlyingsEntry) SetUnderlyingInstrument(underlyingInst
 
 	return underlyingsEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (underlyingsEntry *UnderlyingsEntry) Validate() error {
+	if fix.IsSet(underlyingsEntry.Get(0)) {
+		if err := underlyingsEntry.UnderlyingInstrument().Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -35,6 +35,17 @@ func (group *UnderlyingStipsGrp) Entries() []*UnderlyingStipsEntry {
 	return items
 }
 
+// Validate checks the entries of the group.
+func (group *UnderlyingStipsGrp) Validate() error {
+	for _, entry := range group.Entries() {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type UnderlyingStipsEntry struct {
 	*fix.Component
 }
@@ -73,3 +84,10 @@ func (underlyingStipsEntry *UnderlyingStipsEntry) SetUnderlyingStipValue(underly
 	_ = kv.Load().Set(underlyingStipValue)
 	return underlyingStipsEntry
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (underlyingStipsEntry *UnderlyingStipsEntry) Validate() error {
+	return nil
+}
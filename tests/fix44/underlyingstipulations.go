@@ -23,7 +23,7 @@ func NewUnderlyingStipulations() *UnderlyingStipulations {
 func (underlyingStipulations *UnderlyingStipulations) UnderlyingStipsGrp() *UnderlyingStipsGrp {
 	group := underlyingStipulations.Get(0).(*fix.Group)
 
-	return &UnderlyingStipsGrp{group}
+	return &UnderlyingStipsGrp{Group: group}
 }
 
 func (underlyingStipulations *UnderlyingStipulations) SetUnderlyingStipsGrp(noUnderlyingStips *UnderlyingStipsGrp) *UnderlyingStipulations {
@@ -31,3 +31,14 @@ func (underlyingStipulations *UnderlyingStipulations) SetUnderlyingStipsGrp(noUn
 
 	return underlyingStipulations
 }
+
+// Validate checks that the required fields, groups and components are set, including the ones
+// of the nested components and group entries, and that the conditionally required ones are set
+// when their conditions are met.
+func (underlyingStipulations *UnderlyingStipulations) Validate() error {
+	if err := underlyingStipulations.UnderlyingStipsGrp().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	fixgen "github.com/b2broker/simplefix-go/tests/fix44"
+)
+
+func TestMessageJSON(t *testing.T) {
+	request := fixgen.CreateMarketDataRequest("req", fixgen.EnumSubscriptionRequestTypeSnapshotupdate, 1,
+		fixgen.NewMDEntryTypesGrp().
+			AddEntry(fixgen.NewMDEntryTypesEntry().SetMDEntryType(fixgen.EnumMDEntryTypeBid)).
+			AddEntry(fixgen.NewMDEntryTypesEntry().SetMDEntryType(fixgen.EnumMDEntryTypeOffer)),
+		fixgen.NewRelatedSymGrp().AddEntry(fixgen.NewRelatedSymEntry().SetInstrument(fixgen.NewInstrument().SetSymbol("BTC/USD"))),
+	)
+	request.HeaderBuilder().
+		SetFieldSenderCompID("sender").
+		SetFieldTargetCompID("target").
+		SetFieldMsgSeqNum(1).
+		SetFieldSendingTime("20210706-19:06:12.838")
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"Header":{"BeginString":"FIX.4.4","MsgType":"V","SenderCompID":"sender","TargetCompID":"target",` +
+		`"MsgSeqNum":"1","SendingTime":"20210706-19:06:12.838"},"Body":{"MDReqID":"req","SubscriptionRequestType":"1",` +
+		`"MarketDepth":"1","NoMDEntryTypes":[{"MDEntryType":"0"},{"MDEntryType":"1"}],` +
+		`"NoRelatedSym":[{"Symbol":"BTC/USD"}]},"Trailer":{}}`
+	if string(data) != expected {
+		t.Fatalf("unexpected JSON: %s", data)
+	}
+
+	var decoded fixgen.MarketDataRequest
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries := decoded.MDEntryTypesGrp().Entries()
+	if decoded.MDReqID() != "req" || len(entries) != 2 || entries[1].MDEntryType() != fixgen.EnumMDEntryTypeOffer ||
+		decoded.RelatedSymGrp().Entries()[0].Instrument().Symbol() != "BTC/USD" ||
+		decoded.Header().SenderCompID() != "sender" {
+		t.Fatalf("unexpected message: %s", decoded.String())
+	}
+
+	original, _ := request.ToBytes()
+	restored, _ := decoded.ToBytes()
+	if string(original) != string(restored) {
+		t.Fatalf("unexpected message: %s", restored)
+	}
+
+	var heartbeat fixgen.Heartbeat
+	if err = json.Unmarshal(data, &heartbeat); err == nil {
+		t.Fatalf("the message of another type is decoded")
+	}
+}
+
+func TestMessageRedactedJSON(t *testing.T) {
+	logon := fixgen.CreateLogon(fixgen.EnumEncryptMethodNoneother, 30).SetPassword("secret")
+
+	data, err := logon.RedactedJSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.Contains(string(data), `"Password":"***"`) || strings.Contains(string(data), "secret") {
+		t.Fatalf("the password is not redacted: %s", data)
+	}
+
+	if data, err = json.Marshal(logon); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.Contains(string(data), `"Password":"secret"`) {
+		t.Fatalf("unexpected JSON: %s", data)
+	}
+}
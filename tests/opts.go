@@ -24,19 +24,24 @@ var pseudoGeneratedOpts = session.Opts{
 		EncryptedMethod: mustConvToInt(fixgen.FieldEncryptMethod),
 	},
 	AllowedEncryptedMethods: map[string]struct{}{
-		fixgen.EnumEncryptMethodNoneother: {},
+		string(fixgen.EnumEncryptMethodNoneother): {},
 	},
 	SessionErrorCodes: &messages.SessionErrorCodes{
-		InvalidTagNumber:   mustConvToInt(fixgen.EnumSessionRejectReasonInvalidtagnumber),
-		RequiredTagMissing: mustConvToInt(fixgen.EnumSessionRejectReasonRequiredtagmissing),
-		//TagNotDefinedForMessageType: mustConvToInt(fixgen.EnumSessionRejectReasonTagNotDefinedForThisMessageType),
-		UndefinedTag:             mustConvToInt(fixgen.EnumSessionRejectReasonUndefinedtag),
-		TagSpecialWithoutValue:   mustConvToInt(fixgen.EnumSessionRejectReasonTagspecifiedwithoutavalue),
-		IncorrectValue:           mustConvToInt(fixgen.EnumSessionRejectReasonValueisincorrectoutofrangeforthistag),
-		IncorrectDataFormatValue: mustConvToInt(fixgen.EnumSessionRejectReasonIncorrectdataformatforvalue),
-		DecryptionProblem:        mustConvToInt(fixgen.EnumSessionRejectReasonDecryptionproblem),
-		SignatureProblem:         mustConvToInt(fixgen.EnumSessionRejectReasonSignatureproblem),
-		CompIDProblem:            mustConvToInt(fixgen.EnumSessionRejectReasonCompidproblem),
-		Other:                    mustConvToInt(fixgen.EnumSessionRejectReasonOther),
+		InvalidTagNumber:            mustConvToInt(fixgen.EnumSessionRejectReasonInvalidtagnumber),
+		RequiredTagMissing:          mustConvToInt(fixgen.EnumSessionRejectReasonRequiredtagmissing),
+		TagNotDefinedForMessageType: mustConvToInt(fixgen.EnumSessionRejectReasonTagNotDefinedForThisMessageType),
+		UndefinedTag:                mustConvToInt(fixgen.EnumSessionRejectReasonUndefinedtag),
+		TagSpecialWithoutValue:      mustConvToInt(fixgen.EnumSessionRejectReasonTagspecifiedwithoutavalue),
+		IncorrectValue:              mustConvToInt(fixgen.EnumSessionRejectReasonValueisincorrectoutofrangeforthistag),
+		IncorrectDataFormatValue:    mustConvToInt(fixgen.EnumSessionRejectReasonIncorrectdataformatforvalue),
+		DecryptionProblem:           mustConvToInt(fixgen.EnumSessionRejectReasonDecryptionproblem),
+		SignatureProblem:            mustConvToInt(fixgen.EnumSessionRejectReasonSignatureproblem),
+		CompIDProblem:               mustConvToInt(fixgen.EnumSessionRejectReasonCompidproblem),
+		TagAppearsMoreThanOnce:      mustConvToInt(fixgen.EnumSessionRejectReasonTagappearsmorethanonce),
+		IncorrectNumInGroupCount:    mustConvToInt(fixgen.EnumSessionRejectReasonIncorrectnumingroupcountforrepeatinggroup),
+		InvalidMsgType:              mustConvToInt(fixgen.EnumSessionRejectReasonInvalidmsgtype),
+		TagSpecifiedOutOfOrder:      mustConvToInt(fixgen.EnumSessionRejectReasonTagspecifiedoutofrequiredorder),
+		GroupFieldsOutOfOrder:       mustConvToInt(fixgen.EnumSessionRejectReasonRepeatinggroupfieldsoutoforder),
+		Other:                       mustConvToInt(fixgen.EnumSessionRejectReasonOther),
 	},
 }
@@ -0,0 +1,171 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	simplefixgo "github.com/b2broker/simplefix-go"
+	"github.com/b2broker/simplefix-go/fix"
+	"github.com/b2broker/simplefix-go/fix/encoding"
+	"github.com/b2broker/simplefix-go/session/messages"
+	fixgen "github.com/b2broker/simplefix-go/tests/fix44"
+)
+
+type rejection struct {
+	msg []byte
+	err error
+}
+
+type mockRouterSession struct {
+	sent     chan messages.Message
+	rejected chan rejection
+}
+
+func newMockRouterSession() *mockRouterSession {
+	return &mockRouterSession{
+		sent:     make(chan messages.Message, 10),
+		rejected: make(chan rejection, 10),
+	}
+}
+
+func (r *mockRouterSession) Unmarshal(msg messages.Builder, data []byte) error {
+	return encoding.Unmarshal(msg, data)
+}
+
+func (r *mockRouterSession) Send(msg messages.Message) error {
+	r.sent <- msg
+	return nil
+}
+
+func (r *mockRouterSession) RejectMessageWithError(msg []byte, err error) {
+	r.rejected <- rejection{msg: msg, err: err}
+}
+
+func runRouterHandler(t *testing.T) *simplefixgo.DefaultHandler {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	handler := simplefixgo.NewAcceptorHandler(ctx, fixgen.FieldMsgType, 10)
+	handler.SetIntegrityCheck(false)
+	go func() {
+		_ = handler.Run()
+	}()
+
+	return handler
+}
+
+func makeTestRequest(t *testing.T, seqNum int, testReqID string) []byte {
+	msg := fixgen.CreateTestRequest(testReqID)
+	msg.HeaderBuilder().
+		SetFieldSenderCompID("sender").
+		SetFieldTargetCompID("target").
+		SetFieldMsgSeqNum(seqNum).
+		SetFieldSendingTime(time.Now().UTC().Format(fix.TimeLayout))
+
+	data, err := msg.ToBytes()
+	if err != nil {
+		t.Fatalf("could not marshal the message: %s", err)
+	}
+
+	return data
+}
+
+func TestRouter(t *testing.T) {
+	handler := runRouterHandler(t)
+	session := newMockRouterSession()
+
+	received := make(chan *fixgen.TestRequest, 10)
+	handlerErr := errors.New("could not process")
+
+	fixgen.NewRouter(handler, session).
+		OnTestRequest(func(ctx context.Context, msg *fixgen.TestRequest) error {
+			received <- msg
+			return nil
+		}).
+		OnTestRequest(func(ctx context.Context, msg *fixgen.TestRequest) error {
+			received <- msg
+			if msg.TestReqID() == "fail" {
+				return handlerErr
+			}
+			return nil
+		})
+
+	handler.ServeIncoming(makeTestRequest(t, 1, "ok"))
+
+	first, second := <-received, <-received
+	if first != second {
+		t.Fatalf("the message is unmarshalled for each handler")
+	}
+	if first.TestReqID() != "ok" || first.Header().MsgSeqNum() != 1 {
+		t.Fatalf("unexpected message: %s", first.TestReqID())
+	}
+
+	handler.ServeIncoming(makeTestRequest(t, 2, "fail"))
+	<-received
+	<-received
+
+	select {
+	case r := <-session.rejected:
+		if !errors.Is(r.err, handlerErr) {
+			t.Fatalf("unexpected error: %v", r.err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("the message is not rejected")
+	}
+
+	invalid := bytes.Replace(makeTestRequest(t, 3, "invalid"), []byte("\x01112="), []byte("\x019999=1\x01112="), 1)
+	handler.ServeIncoming(invalid)
+
+	select {
+	case r := <-session.rejected:
+		if r.err == nil || !bytes.Equal(r.msg, invalid) {
+			t.Fatalf("unexpected rejection: %v", r.err)
+		}
+	case msg := <-received:
+		t.Fatalf("the invalid message is handled: %s", msg.TestReqID())
+	case <-time.After(time.Second):
+		t.Fatalf("the invalid message is not rejected")
+	}
+}
+
+func TestRouterBusinessReject(t *testing.T) {
+	handler := runRouterHandler(t)
+	session := newMockRouterSession()
+
+	rejects := make(chan simplefixgo.BusinessReject, 1)
+	router := simplefixgo.NewMessageRouter(handler, session, func(reject simplefixgo.BusinessReject) messages.Message {
+		rejects <- reject
+		return fixgen.NewHeartbeat()
+	})
+
+	simplefixgo.Route(router, fixgen.MsgTypeTestRequest, fixgen.NewTestRequest,
+		func(ctx context.Context, msg *fixgen.TestRequest) error {
+			return simplefixgo.NewBusinessRejectError(4, msg.TestReqID(), errors.New("not available"))
+		},
+	)
+
+	handler.ServeIncoming(makeTestRequest(t, 5, "ref"))
+
+	select {
+	case reject := <-rejects:
+		expected := simplefixgo.BusinessReject{
+			RefSeqNum:  5,
+			RefMsgType: fixgen.MsgTypeTestRequest,
+			RefID:      "ref",
+			Reason:     4,
+			Text:       "not available",
+		}
+		if reject != expected {
+			t.Fatalf("unexpected reject: %+v", reject)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("the business reject is not made")
+	}
+
+	if msg := <-session.sent; msg.MsgType() != fixgen.MsgTypeHeartbeat {
+		t.Fatalf("unexpected message: %s", msg.MsgType())
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"strconv"
 )
 
-func mustConvToInt(s string) int {
-	i, err := strconv.Atoi(s)
+func mustConvToInt[T ~string](s T) int {
+	i, err := strconv.Atoi(string(s))
 	if err != nil {
 		panic(err)
 	}
@@ -23,6 +23,12 @@ const (
 
 	// EventLogout occurs upon receiving the Logout message.
 	EventLogout
+
+	// EventGarbled occurs when an incoming message with an invalid BodyLength or CheckSum is discarded.
+	EventGarbled
+
+	// EventGarbledLimit occurs when the limit of garbled messages is reached, right before the handler is stopped.
+	EventGarbledLimit
 )
 
 // EventHandlerFunc is called when an event occurs.